@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	"github.com/redskyops/redskyops-controller/api/v1beta1"
 	"k8s.io/apimachinery/pkg/conversion"
 	conv "sigs.k8s.io/controller-runtime/pkg/conversion"
@@ -51,7 +53,7 @@ func Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(in *ExperimentSpe
 }
 
 func Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *v1beta1.ExperimentSpec, out *ExperimentSpec, s conversion.Scope) error {
-	// Rename `TrialTemplate` to `Template`
+	// Rename `TrialTemplate` to `Template`, the `StoppingCriteria`, `PatchRollback` and `Promotion` are dropped
 	if err := Convert_v1beta1_TrialTemplateSpec_To_v1alpha1_TrialTemplateSpec(&in.TrialTemplate, &out.Template, s); err != nil {
 		return err
 	}
@@ -59,3 +61,47 @@ func Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *v1beta1.Exper
 	// Continue
 	return autoConvert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in, out, s)
 }
+
+func Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in *v1beta1.ExperimentStatus, out *ExperimentStatus, s conversion.Scope) error {
+	// Only the phase and active trial count existed, the `SucceededTrials`, `FailedTrials`, `AbandonedTrials`,
+	// `ConsecutiveFailures`, `TrialsWithoutImprovement`, `TrialsCountedUntil`, `BaselineCreated`, `BestTrial`, `PromotedTrial`, `Reason` and `Conditions` are dropped
+
+	// Continue
+	return autoConvert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in, out, s)
+}
+
+func Convert_v1alpha1_Parameter_To_v1beta1_Parameter(in *Parameter, out *v1beta1.Parameter, s conversion.Scope) error {
+	// Integer bounds are stored using `Int64OrString`
+	out.Min = v1beta1.FromInt64(in.Min)
+	out.Max = v1beta1.FromInt64(in.Max)
+
+	// Continue
+	return autoConvert_v1alpha1_Parameter_To_v1beta1_Parameter(in, out, s)
+}
+
+func Convert_v1beta1_Parameter_To_v1alpha1_Parameter(in *v1beta1.Parameter, out *Parameter, s conversion.Scope) error {
+	// Only integer parameters can be represented, the `Type`, `Values` and `Baseline` are dropped
+	if pt := in.GetType(); pt != v1beta1.ParameterTypeInteger {
+		return fmt.Errorf("unable to convert %s parameter '%s', only integer parameters are supported", pt, in.Name)
+	}
+	out.Min = in.Min.IntVal
+	out.Max = in.Max.IntVal
+
+	// Continue
+	return autoConvert_v1beta1_Parameter_To_v1alpha1_Parameter(in, out, s)
+}
+
+func Convert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in *v1beta1.PatchTemplate, out *PatchTemplate, s conversion.Scope) error {
+	// Only direct references can be represented, the `Selector` is dropped
+
+	// Continue
+	return autoConvert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in, out, s)
+}
+
+func Convert_v1beta1_Metric_To_v1alpha1_Metric(in *v1beta1.Metric, out *Metric, s conversion.Scope) error {
+	// Metric constraints, range queries, pod targets and log containers cannot be represented, the `Optimize`, `Min`,
+	// `Max`, `Step`, `Reducer`, `TargetType`, `Aggregation` and `Container` are dropped
+
+	// Continue
+	return autoConvert_v1beta1_Metric_To_v1alpha1_Metric(in, out, s)
+}
@@ -187,6 +187,9 @@ type ExperimentStatus struct {
 
 // Experiment is the Schema for the experiments API
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Experiment status"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeededTrials",description="Succeeded trials"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedTrials",description="Failed trials"
+// +kubebuilder:printcolumn:name="Best",type="string",JSONPath=".status.bestTrial.name",description="Best trial"
 type Experiment struct {
 	metav1.TypeMeta `json:",inline"`
 	// Standard object metadata
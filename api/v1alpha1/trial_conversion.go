@@ -17,8 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/redskyops/redskyops-controller/api/v1beta1"
 	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	conv "sigs.k8s.io/controller-runtime/pkg/conversion"
 )
 
@@ -114,13 +118,76 @@ func Convert_v1beta1_TrialSpec_To_v1alpha1_TrialSpec(in *v1beta1.TrialSpec, out
 	// Rename `JobTemplate` to `Template`
 	out.Template = in.JobTemplate
 
+	// NOTE: The `RetryPolicy`, `LogRetention`, `Results` and `SetupLifecycle` fields do not exist in v1alpha1 and are dropped
+
 	// Continue
 	return autoConvert_v1beta1_TrialSpec_To_v1alpha1_TrialSpec(in, out, s)
 }
 
+func Convert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(in *v1beta1.PatchOperation, out *PatchOperation, s conversion.Scope) error {
+	// NOTE: The `RollbackData` field does not exist in v1alpha1 and is dropped
+
+	// Continue
+	return autoConvert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(in, out, s)
+}
+
+func Convert_v1beta1_SetupTask_To_v1alpha1_SetupTask(in *v1beta1.SetupTask, out *SetupTask, s conversion.Scope) error {
+	// Only Helm setup tasks can be represented, the `Manifests`, `Kustomize`, `Command`, `Args` and `DeleteArgs` are dropped
+
+	// Continue
+	return autoConvert_v1beta1_SetupTask_To_v1alpha1_SetupTask(in, out, s)
+}
+
+func Convert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(in *v1beta1.HelmValueSource, out *HelmValueSource, s conversion.Scope) error {
+	// NOTE: The `FieldRef` and `SecretKeyRef` fields do not exist in v1alpha1 and are dropped
+
+	// Continue
+	return autoConvert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(in, out, s)
+}
+
+func Convert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(in *v1beta1.HelmValuesFromSource, out *HelmValuesFromSource, s conversion.Scope) error {
+	// NOTE: The `Secret` field does not exist in v1alpha1 and is dropped
+
+	// Continue
+	return autoConvert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(in, out, s)
+}
+
+func Convert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(in *v1beta1.ParameterSelector, out *ParameterSelector, s conversion.Scope) error {
+	// NOTE: The `Percent`, `Scale` and `Delta` fields do not exist in v1alpha1 and are dropped
+
+	// Continue
+	return autoConvert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(in, out, s)
+}
+
 func Convert_v1beta1_TrialStatus_To_v1alpha1_TrialStatus(in *v1beta1.TrialStatus, out *TrialStatus, s conversion.Scope) error {
 	// NOTE: Generation skips this function, but we handle the incompatible change in the `Trial` conversion
+	// NOTE: The `LogTail` field does not exist in v1alpha1 and is dropped
 
 	// Continue
 	return autoConvert_v1beta1_TrialStatus_To_v1alpha1_TrialStatus(in, out, s)
 }
+
+func Convert_v1alpha1_Assignment_To_v1beta1_Assignment(in *Assignment, out *v1beta1.Assignment, s conversion.Scope) error {
+	// Integer values are stored using `Int64OrString`
+	out.Value = v1beta1.FromInt64(in.Value)
+
+	// Continue
+	return autoConvert_v1alpha1_Assignment_To_v1beta1_Assignment(in, out, s)
+}
+
+func Convert_v1beta1_Assignment_To_v1alpha1_Assignment(in *v1beta1.Assignment, out *Assignment, s conversion.Scope) error {
+	// Only integer values can be represented, double and categorical values must not be silently changed
+	switch in.Value.Type {
+	case intstr.Int:
+		out.Value = in.Value.IntVal
+	default:
+		v, err := strconv.ParseInt(in.Value.StrVal, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to convert assignment '%s' with value '%s', only integer values are supported", in.Name, in.Value.StrVal)
+		}
+		out.Value = v
+	}
+
+	// Continue
+	return autoConvert_v1beta1_Assignment_To_v1alpha1_Assignment(in, out, s)
+}
@@ -101,6 +101,10 @@ func TestTrial_ConvertRoundTrip(t *testing.T) {
 							Name:  "tp2",
 							Value: 2,
 						},
+						{
+							Name:  "tp3",
+							Value: 1 << 40,
+						},
 					},
 				},
 				Status: TrialStatus{
@@ -293,3 +297,85 @@ func TestTrial_ConvertRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestTrial_ConvertFromNonInteger(t *testing.T) {
+	cases := []struct {
+		desc     string
+		value    v1beta1.Int64OrString
+		expected int64
+		err      bool
+	}{
+		{
+			desc:     "integer",
+			value:    v1beta1.FromInt64(1 << 40),
+			expected: 1 << 40,
+		},
+		{
+			desc:     "integer string",
+			value:    v1beta1.FromString("10"),
+			expected: 10,
+		},
+		{
+			desc:  "double",
+			value: v1beta1.FromString("0.5"),
+			err:   true,
+		},
+		{
+			desc:  "categorical",
+			value: v1beta1.FromString("red"),
+			err:   true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			hub := &v1beta1.Trial{Spec: v1beta1.TrialSpec{Assignments: []v1beta1.Assignment{{Name: "tp", Value: c.value}}}}
+			src := &Trial{}
+			err := src.ConvertFrom(hub)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) && assert.Len(t, src.Spec.Assignments, 1) {
+				assert.Equal(t, c.expected, src.Spec.Assignments[0].Value)
+			}
+		})
+	}
+}
+
+func TestExperiment_ConvertFromNonInteger(t *testing.T) {
+	cases := []struct {
+		desc      string
+		parameter v1beta1.Parameter
+		err       bool
+	}{
+		{
+			desc:      "integer",
+			parameter: v1beta1.Parameter{Name: "one", Min: v1beta1.FromInt64(1), Max: v1beta1.FromInt64(1 << 40)},
+		},
+		{
+			desc:      "double",
+			parameter: v1beta1.Parameter{Name: "one", Min: v1beta1.FromString("0.1"), Max: v1beta1.FromString("0.5")},
+			err:       true,
+		},
+		{
+			desc:      "categorical",
+			parameter: v1beta1.Parameter{Name: "one", Values: []string{"red", "green"}},
+			err:       true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			hub := &v1beta1.Experiment{Spec: v1beta1.ExperimentSpec{Parameters: []v1beta1.Parameter{c.parameter}}}
+			src := &Experiment{}
+			err := src.ConvertFrom(hub)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) && assert.Len(t, src.Spec.Parameters, 1) {
+				assert.Equal(t, c.parameter.Min.IntVal, src.Spec.Parameters[0].Min)
+				assert.Equal(t, c.parameter.Max.IntVal, src.Spec.Parameters[0].Max)
+			}
+		})
+	}
+}
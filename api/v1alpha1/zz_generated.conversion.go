@@ -35,16 +35,6 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
-	if err := s.AddGeneratedConversionFunc((*Assignment)(nil), (*v1beta1.Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Assignment_To_v1beta1_Assignment(a.(*Assignment), b.(*v1beta1.Assignment), scope)
-	}); err != nil {
-		return err
-	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.Assignment)(nil), (*Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_Assignment_To_v1alpha1_Assignment(a.(*v1beta1.Assignment), b.(*Assignment), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ConfigMapHelmValuesFromSource)(nil), (*v1beta1.ConfigMapHelmValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConfigMapHelmValuesFromSource_To_v1beta1_ConfigMapHelmValuesFromSource(a.(*ConfigMapHelmValuesFromSource), b.(*v1beta1.ConfigMapHelmValuesFromSource), scope)
 	}); err != nil {
@@ -90,11 +80,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.ExperimentStatus)(nil), (*ExperimentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(a.(*v1beta1.ExperimentStatus), b.(*ExperimentStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*HelmValue)(nil), (*v1beta1.HelmValue)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmValue_To_v1beta1_HelmValue(a.(*HelmValue), b.(*v1beta1.HelmValue), scope)
 	}); err != nil {
@@ -110,31 +95,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.HelmValueSource)(nil), (*HelmValueSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(a.(*v1beta1.HelmValueSource), b.(*HelmValueSource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*HelmValuesFromSource)(nil), (*v1beta1.HelmValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmValuesFromSource_To_v1beta1_HelmValuesFromSource(a.(*HelmValuesFromSource), b.(*v1beta1.HelmValuesFromSource), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.HelmValuesFromSource)(nil), (*HelmValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(a.(*v1beta1.HelmValuesFromSource), b.(*HelmValuesFromSource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Metric)(nil), (*v1beta1.Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Metric_To_v1beta1_Metric(a.(*Metric), b.(*v1beta1.Metric), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.Metric)(nil), (*Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_Metric_To_v1alpha1_Metric(a.(*v1beta1.Metric), b.(*Metric), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateSpec)(nil), (*v1beta1.NamespaceTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamespaceTemplateSpec_To_v1beta1_NamespaceTemplateSpec(a.(*NamespaceTemplateSpec), b.(*v1beta1.NamespaceTemplateSpec), scope)
 	}); err != nil {
@@ -165,36 +135,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Parameter)(nil), (*v1beta1.Parameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Parameter_To_v1beta1_Parameter(a.(*Parameter), b.(*v1beta1.Parameter), scope)
-	}); err != nil {
-		return err
-	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.Parameter)(nil), (*Parameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_Parameter_To_v1alpha1_Parameter(a.(*v1beta1.Parameter), b.(*Parameter), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ParameterSelector)(nil), (*v1beta1.ParameterSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ParameterSelector_To_v1beta1_ParameterSelector(a.(*ParameterSelector), b.(*v1beta1.ParameterSelector), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.ParameterSelector)(nil), (*ParameterSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(a.(*v1beta1.ParameterSelector), b.(*ParameterSelector), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PatchOperation)(nil), (*v1beta1.PatchOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PatchOperation_To_v1beta1_PatchOperation(a.(*PatchOperation), b.(*v1beta1.PatchOperation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.PatchOperation)(nil), (*PatchOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(a.(*v1beta1.PatchOperation), b.(*PatchOperation), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PatchReadinessGate)(nil), (*v1beta1.PatchReadinessGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PatchReadinessGate_To_v1beta1_PatchReadinessGate(a.(*PatchReadinessGate), b.(*v1beta1.PatchReadinessGate), scope)
 	}); err != nil {
@@ -210,11 +160,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.PatchTemplate)(nil), (*PatchTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(a.(*v1beta1.PatchTemplate), b.(*PatchTemplate), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ReadinessCheck)(nil), (*v1beta1.ReadinessCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ReadinessCheck_To_v1beta1_ReadinessCheck(a.(*ReadinessCheck), b.(*v1beta1.ReadinessCheck), scope)
 	}); err != nil {
@@ -230,11 +175,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.SetupTask)(nil), (*SetupTask)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_SetupTask_To_v1alpha1_SetupTask(a.(*v1beta1.SetupTask), b.(*SetupTask), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SumConstraint)(nil), (*v1beta1.SumConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SumConstraint_To_v1beta1_SumConstraint(a.(*SumConstraint), b.(*v1beta1.SumConstraint), scope)
 	}); err != nil {
@@ -310,11 +250,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*Assignment)(nil), (*v1beta1.Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Assignment_To_v1beta1_Assignment(a.(*Assignment), b.(*v1beta1.Assignment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*ExperimentSpec)(nil), (*v1beta1.ExperimentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(a.(*ExperimentSpec), b.(*v1beta1.ExperimentSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*Parameter)(nil), (*v1beta1.Parameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Parameter_To_v1beta1_Parameter(a.(*Parameter), b.(*v1beta1.Parameter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*TrialSpec)(nil), (*v1beta1.TrialSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TrialSpec_To_v1beta1_TrialSpec(a.(*TrialSpec), b.(*v1beta1.TrialSpec), scope)
 	}); err != nil {
@@ -325,11 +275,61 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.Assignment)(nil), (*Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Assignment_To_v1alpha1_Assignment(a.(*v1beta1.Assignment), b.(*Assignment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta1.ExperimentSpec)(nil), (*ExperimentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(a.(*v1beta1.ExperimentSpec), b.(*ExperimentSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.ExperimentStatus)(nil), (*ExperimentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(a.(*v1beta1.ExperimentStatus), b.(*ExperimentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.HelmValueSource)(nil), (*HelmValueSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(a.(*v1beta1.HelmValueSource), b.(*HelmValueSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.HelmValuesFromSource)(nil), (*HelmValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(a.(*v1beta1.HelmValuesFromSource), b.(*HelmValuesFromSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.Metric)(nil), (*Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Metric_To_v1alpha1_Metric(a.(*v1beta1.Metric), b.(*Metric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.Parameter)(nil), (*Parameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Parameter_To_v1alpha1_Parameter(a.(*v1beta1.Parameter), b.(*Parameter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.ParameterSelector)(nil), (*ParameterSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(a.(*v1beta1.ParameterSelector), b.(*ParameterSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.PatchOperation)(nil), (*PatchOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(a.(*v1beta1.PatchOperation), b.(*PatchOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.PatchTemplate)(nil), (*PatchTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(a.(*v1beta1.PatchTemplate), b.(*PatchTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.SetupTask)(nil), (*SetupTask)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SetupTask_To_v1alpha1_SetupTask(a.(*v1beta1.SetupTask), b.(*SetupTask), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta1.TrialSpec)(nil), (*TrialSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_TrialSpec_To_v1alpha1_TrialSpec(a.(*v1beta1.TrialSpec), b.(*TrialSpec), scope)
 	}); err != nil {
@@ -350,26 +350,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 
 func autoConvert_v1alpha1_Assignment_To_v1beta1_Assignment(in *Assignment, out *v1beta1.Assignment, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Value = in.Value
+	// WARNING: in.Value requires manual conversion: inconvertible types (int64 vs k8s.io/apimachinery/pkg/util/intstr.IntOrString)
 	return nil
 }
 
-// Convert_v1alpha1_Assignment_To_v1beta1_Assignment is an autogenerated conversion function.
-func Convert_v1alpha1_Assignment_To_v1beta1_Assignment(in *Assignment, out *v1beta1.Assignment, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Assignment_To_v1beta1_Assignment(in, out, s)
-}
-
 func autoConvert_v1beta1_Assignment_To_v1alpha1_Assignment(in *v1beta1.Assignment, out *Assignment, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Value = in.Value
+	// WARNING: in.Value requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs int64)
 	return nil
 }
 
-// Convert_v1beta1_Assignment_To_v1alpha1_Assignment is an autogenerated conversion function.
-func Convert_v1beta1_Assignment_To_v1alpha1_Assignment(in *v1beta1.Assignment, out *Assignment, s conversion.Scope) error {
-	return autoConvert_v1beta1_Assignment_To_v1alpha1_Assignment(in, out, s)
-}
-
 func autoConvert_v1alpha1_ConfigMapHelmValuesFromSource_To_v1beta1_ConfigMapHelmValuesFromSource(in *ConfigMapHelmValuesFromSource, out *v1beta1.ConfigMapHelmValuesFromSource, s conversion.Scope) error {
 	out.LocalObjectReference = in.LocalObjectReference
 	return nil
@@ -649,6 +639,7 @@ func autoConvert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *v1beta1.E
 	} else {
 		out.Patches = nil
 	}
+	// WARNING: in.PatchRollback requires manual conversion: does not exist in peer-type
 	out.NamespaceSelector = in.NamespaceSelector
 	if in.NamespaceTemplate != nil {
 		in, out := &in.NamespaceTemplate, &out.NamespaceTemplate
@@ -661,6 +652,8 @@ func autoConvert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *v1beta1.E
 	}
 	out.Selector = in.Selector
 	// WARNING: in.TrialTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.StoppingCriteria requires manual conversion: does not exist in peer-type
+	// WARNING: in.Promotion requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -678,14 +671,20 @@ func Convert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(in *Experimen
 func autoConvert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in *v1beta1.ExperimentStatus, out *ExperimentStatus, s conversion.Scope) error {
 	out.Phase = in.Phase
 	out.ActiveTrials = in.ActiveTrials
+	// WARNING: in.SucceededTrials requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailedTrials requires manual conversion: does not exist in peer-type
+	// WARNING: in.AbandonedTrials requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConsecutiveFailures requires manual conversion: does not exist in peer-type
+	// WARNING: in.TrialsWithoutImprovement requires manual conversion: does not exist in peer-type
+	// WARNING: in.TrialsCountedUntil requires manual conversion: does not exist in peer-type
+	// WARNING: in.BaselineCreated requires manual conversion: does not exist in peer-type
+	// WARNING: in.BestTrial requires manual conversion: does not exist in peer-type
+	// WARNING: in.PromotedTrial requires manual conversion: does not exist in peer-type
+	// WARNING: in.Reason requires manual conversion: does not exist in peer-type
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus is an autogenerated conversion function.
-func Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in *v1beta1.ExperimentStatus, out *ExperimentStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_HelmValue_To_v1beta1_HelmValue(in *HelmValue, out *v1beta1.HelmValue, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ForceString = in.ForceString
@@ -756,14 +755,11 @@ func autoConvert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(in *v1beta1
 	} else {
 		out.ParameterRef = nil
 	}
+	// WARNING: in.FieldRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecretKeyRef requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource is an autogenerated conversion function.
-func Convert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(in *v1beta1.HelmValueSource, out *HelmValueSource, s conversion.Scope) error {
-	return autoConvert_v1beta1_HelmValueSource_To_v1alpha1_HelmValueSource(in, out, s)
-}
-
 func autoConvert_v1alpha1_HelmValuesFromSource_To_v1beta1_HelmValuesFromSource(in *HelmValuesFromSource, out *v1beta1.HelmValuesFromSource, s conversion.Scope) error {
 	if in.ConfigMap != nil {
 		in, out := &in.ConfigMap, &out.ConfigMap
@@ -792,14 +788,10 @@ func autoConvert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(i
 	} else {
 		out.ConfigMap = nil
 	}
+	// WARNING: in.Secret requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource is an autogenerated conversion function.
-func Convert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(in *v1beta1.HelmValuesFromSource, out *HelmValuesFromSource, s conversion.Scope) error {
-	return autoConvert_v1beta1_HelmValuesFromSource_To_v1alpha1_HelmValuesFromSource(in, out, s)
-}
-
 func autoConvert_v1alpha1_Metric_To_v1beta1_Metric(in *Metric, out *v1beta1.Metric, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Minimize = in.Minimize
@@ -821,21 +813,24 @@ func Convert_v1alpha1_Metric_To_v1beta1_Metric(in *Metric, out *v1beta1.Metric,
 func autoConvert_v1beta1_Metric_To_v1alpha1_Metric(in *v1beta1.Metric, out *Metric, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Minimize = in.Minimize
+	// WARNING: in.Optimize requires manual conversion: does not exist in peer-type
+	// WARNING: in.Min requires manual conversion: does not exist in peer-type
+	// WARNING: in.Max requires manual conversion: does not exist in peer-type
 	out.Type = MetricType(in.Type)
 	out.Query = in.Query
 	out.ErrorQuery = in.ErrorQuery
+	// WARNING: in.Step requires manual conversion: does not exist in peer-type
+	// WARNING: in.Reducer requires manual conversion: does not exist in peer-type
+	// WARNING: in.Container requires manual conversion: does not exist in peer-type
 	out.Scheme = in.Scheme
 	out.Selector = in.Selector
+	// WARNING: in.TargetType requires manual conversion: does not exist in peer-type
+	// WARNING: in.Aggregation requires manual conversion: does not exist in peer-type
 	out.Port = in.Port
 	out.Path = in.Path
 	return nil
 }
 
-// Convert_v1beta1_Metric_To_v1alpha1_Metric is an autogenerated conversion function.
-func Convert_v1beta1_Metric_To_v1alpha1_Metric(in *v1beta1.Metric, out *Metric, s conversion.Scope) error {
-	return autoConvert_v1beta1_Metric_To_v1alpha1_Metric(in, out, s)
-}
-
 func autoConvert_v1alpha1_NamespaceTemplateSpec_To_v1beta1_NamespaceTemplateSpec(in *NamespaceTemplateSpec, out *v1beta1.NamespaceTemplateSpec, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Spec = in.Spec
@@ -904,28 +899,21 @@ func Convert_v1beta1_OrderConstraint_To_v1alpha1_OrderConstraint(in *v1beta1.Ord
 
 func autoConvert_v1alpha1_Parameter_To_v1beta1_Parameter(in *Parameter, out *v1beta1.Parameter, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Min = in.Min
-	out.Max = in.Max
+	// WARNING: in.Min requires manual conversion: inconvertible types (int64 vs k8s.io/apimachinery/pkg/util/intstr.IntOrString)
+	// WARNING: in.Max requires manual conversion: inconvertible types (int64 vs k8s.io/apimachinery/pkg/util/intstr.IntOrString)
 	return nil
 }
 
-// Convert_v1alpha1_Parameter_To_v1beta1_Parameter is an autogenerated conversion function.
-func Convert_v1alpha1_Parameter_To_v1beta1_Parameter(in *Parameter, out *v1beta1.Parameter, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Parameter_To_v1beta1_Parameter(in, out, s)
-}
-
 func autoConvert_v1beta1_Parameter_To_v1alpha1_Parameter(in *v1beta1.Parameter, out *Parameter, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Min = in.Min
-	out.Max = in.Max
+	// WARNING: in.Type requires manual conversion: does not exist in peer-type
+	// WARNING: in.Min requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs int64)
+	// WARNING: in.Max requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs int64)
+	// WARNING: in.Values requires manual conversion: does not exist in peer-type
+	// WARNING: in.Baseline requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_Parameter_To_v1alpha1_Parameter is an autogenerated conversion function.
-func Convert_v1beta1_Parameter_To_v1alpha1_Parameter(in *v1beta1.Parameter, out *Parameter, s conversion.Scope) error {
-	return autoConvert_v1beta1_Parameter_To_v1alpha1_Parameter(in, out, s)
-}
-
 func autoConvert_v1alpha1_ParameterSelector_To_v1beta1_ParameterSelector(in *ParameterSelector, out *v1beta1.ParameterSelector, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -938,14 +926,12 @@ func Convert_v1alpha1_ParameterSelector_To_v1beta1_ParameterSelector(in *Paramet
 
 func autoConvert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(in *v1beta1.ParameterSelector, out *ParameterSelector, s conversion.Scope) error {
 	out.Name = in.Name
+	// WARNING: in.Percent requires manual conversion: does not exist in peer-type
+	// WARNING: in.Scale requires manual conversion: does not exist in peer-type
+	// WARNING: in.Delta requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector is an autogenerated conversion function.
-func Convert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(in *v1beta1.ParameterSelector, out *ParameterSelector, s conversion.Scope) error {
-	return autoConvert_v1beta1_ParameterSelector_To_v1alpha1_ParameterSelector(in, out, s)
-}
-
 func autoConvert_v1alpha1_PatchOperation_To_v1beta1_PatchOperation(in *PatchOperation, out *v1beta1.PatchOperation, s conversion.Scope) error {
 	out.TargetRef = in.TargetRef
 	out.PatchType = types.PatchType(in.PatchType)
@@ -968,14 +954,10 @@ func autoConvert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(in *v1beta1.P
 		return err
 	}
 	out.AttemptsRemaining = in.AttemptsRemaining
+	// WARNING: in.RollbackData requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation is an autogenerated conversion function.
-func Convert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(in *v1beta1.PatchOperation, out *PatchOperation, s conversion.Scope) error {
-	return autoConvert_v1beta1_PatchOperation_To_v1alpha1_PatchOperation(in, out, s)
-}
-
 func autoConvert_v1alpha1_PatchReadinessGate_To_v1beta1_PatchReadinessGate(in *PatchReadinessGate, out *v1beta1.PatchReadinessGate, s conversion.Scope) error {
 	out.ConditionType = in.ConditionType
 	return nil
@@ -1023,6 +1005,7 @@ func autoConvert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in *v1beta1.Pat
 	out.Type = PatchType(in.Type)
 	out.Patch = in.Patch
 	out.TargetRef = in.TargetRef
+	// WARNING: in.Selector requires manual conversion: does not exist in peer-type
 	if in.ReadinessGates != nil {
 		in, out := &in.ReadinessGates, &out.ReadinessGates
 		*out = make([]PatchReadinessGate, len(*in))
@@ -1037,11 +1020,6 @@ func autoConvert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in *v1beta1.Pat
 	return nil
 }
 
-// Convert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate is an autogenerated conversion function.
-func Convert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in *v1beta1.PatchTemplate, out *PatchTemplate, s conversion.Scope) error {
-	return autoConvert_v1beta1_PatchTemplate_To_v1alpha1_PatchTemplate(in, out, s)
-}
-
 func autoConvert_v1alpha1_ReadinessCheck_To_v1beta1_ReadinessCheck(in *ReadinessCheck, out *v1beta1.ReadinessCheck, s conversion.Scope) error {
 	out.TargetRef = in.TargetRef
 	out.Selector = in.Selector
@@ -1142,14 +1120,14 @@ func autoConvert_v1beta1_SetupTask_To_v1alpha1_SetupTask(in *v1beta1.SetupTask,
 	} else {
 		out.HelmValuesFrom = nil
 	}
+	// WARNING: in.Manifests requires manual conversion: does not exist in peer-type
+	// WARNING: in.Kustomize requires manual conversion: does not exist in peer-type
+	// WARNING: in.Command requires manual conversion: does not exist in peer-type
+	// WARNING: in.Args requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeleteArgs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_SetupTask_To_v1alpha1_SetupTask is an autogenerated conversion function.
-func Convert_v1beta1_SetupTask_To_v1alpha1_SetupTask(in *v1beta1.SetupTask, out *SetupTask, s conversion.Scope) error {
-	return autoConvert_v1beta1_SetupTask_To_v1alpha1_SetupTask(in, out, s)
-}
-
 func autoConvert_v1alpha1_SumConstraint_To_v1beta1_SumConstraint(in *SumConstraint, out *v1beta1.SumConstraint, s conversion.Scope) error {
 	out.Bound = in.Bound
 	out.IsUpperBound = in.IsUpperBound
@@ -1437,6 +1415,9 @@ func autoConvert_v1beta1_TrialSpec_To_v1alpha1_TrialSpec(in *v1beta1.TrialSpec,
 	} else {
 		out.ReadinessGates = nil
 	}
+	// WARNING: in.RetryPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.LogRetention requires manual conversion: does not exist in peer-type
+	// WARNING: in.Results requires manual conversion: does not exist in peer-type
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
 		*out = make([]Value, len(*in))
@@ -1463,6 +1444,7 @@ func autoConvert_v1beta1_TrialSpec_To_v1alpha1_TrialSpec(in *v1beta1.TrialSpec,
 	out.SetupServiceAccountName = in.SetupServiceAccountName
 	out.SetupDefaultClusterRole = in.SetupDefaultClusterRole
 	out.SetupDefaultRules = in.SetupDefaultRules
+	// WARNING: in.SetupLifecycle requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1510,6 +1492,7 @@ func autoConvert_v1beta1_TrialStatus_To_v1alpha1_TrialStatus(in *v1beta1.TrialSt
 	}
 	// WARNING: in.PatchOperations requires manual conversion: does not exist in peer-type
 	// WARNING: in.ReadinessChecks requires manual conversion: does not exist in peer-type
+	// WARNING: in.LogTail requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Replicas returns the effective replica (trial) count for the experiment
@@ -42,6 +43,21 @@ func (in *Experiment) SetReplicas(r int) {
 	}
 }
 
+// Default sets the default values for any unspecified fields of the experiment
+func (in *Experiment) Default() {
+	if in.Spec.Replicas == nil {
+		in.SetReplicas(1)
+	}
+
+	for i := range in.Spec.Metrics {
+		if in.Spec.Metrics[i].Type == "" {
+			in.Spec.Metrics[i].Type = MetricLocal
+		}
+	}
+
+	in.Spec.TrialTemplate.Spec.Default()
+}
+
 // TrialSelector returns a label selector for matching trials associated with the experiment
 func (in *Experiment) TrialSelector() *metav1.LabelSelector {
 	if in.Spec.Selector != nil {
@@ -54,3 +70,40 @@ func (in *Experiment) TrialSelector() *metav1.LabelSelector {
 		},
 	}
 }
+
+// GetType returns the effective type of the parameter
+func (in *Parameter) GetType() ParameterType {
+	switch {
+	case in.Type != "":
+		return in.Type
+	case len(in.Values) > 0:
+		return ParameterTypeCategorical
+	case in.Min.Type == intstr.String || in.Max.Type == intstr.String:
+		return ParameterTypeDouble
+	default:
+		return ParameterTypeInteger
+	}
+}
+
+// IsOptimized checks to see if the metric should be used as an objective of the experiment
+func (in *Metric) IsOptimized() bool {
+	return in.Optimize == nil || *in.Optimize
+}
+
+// BaselineAssignments returns the assignments for the baseline trial, or nil if any parameter does not specify a
+// baseline value
+func (in *Experiment) BaselineAssignments() []Assignment {
+	if len(in.Spec.Parameters) == 0 {
+		return nil
+	}
+
+	assignments := make([]Assignment, 0, len(in.Spec.Parameters))
+	for i := range in.Spec.Parameters {
+		p := &in.Spec.Parameters[i]
+		if p.Baseline == nil {
+			return nil
+		}
+		assignments = append(assignments, Assignment{Name: p.Name, Value: *p.Baseline})
+	}
+	return assignments
+}
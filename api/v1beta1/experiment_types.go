@@ -31,14 +31,34 @@ type Optimization struct {
 	Value string `json:"value"`
 }
 
+// ParameterType represents the allowable types of parameters
+type ParameterType string
+
+const (
+	// ParameterTypeInteger parameters take integer values between the inclusive minimum and maximum
+	ParameterTypeInteger ParameterType = "int"
+	// ParameterTypeDouble parameters take floating point values between the inclusive minimum and maximum
+	ParameterTypeDouble ParameterType = "double"
+	// ParameterTypeCategorical parameters take one of an explicit list of string values
+	ParameterTypeCategorical ParameterType = "categorical"
+)
+
 // Parameter represents the domain of a single component of the experiment search space
 type Parameter struct {
 	// The name of the parameter
 	Name string `json:"name"`
-	// The inclusive minimum value of the parameter
-	Min int64 `json:"min,omitempty"`
-	// The inclusive maximum value of the parameter
-	Max int64 `json:"max,omitempty"`
+	// The type of the parameter, one of: int|double|categorical, default: categorical if values are specified, double
+	// if either bound is a string, otherwise int
+	Type ParameterType `json:"type,omitempty"`
+	// The inclusive minimum value of the parameter, floating point values must be specified as strings
+	Min Int64OrString `json:"min,omitempty"`
+	// The inclusive maximum value of the parameter, floating point values must be specified as strings
+	Max Int64OrString `json:"max,omitempty"`
+	// The list of allowed values for a categorical parameter
+	Values []string `json:"values,omitempty"`
+	// The value of the parameter used by the baseline trial, when all parameters specify a baseline the first trial
+	// of the experiment will use the baseline values
+	Baseline *Int64OrString `json:"baseline,omitempty"`
 }
 
 // Constraint represents a constraint to the domain of the parameters
@@ -87,12 +107,62 @@ const (
 	// MetricPods metrics are similar to local metrics, however the list of pods in the trial namespace matched by the selector
 	// is also available.
 	MetricPods MetricType = "pods"
-	// MetricPrometheus metrics issue PromQL queries to a matched service. Queries MUST evaluate to a scalar value.
+	// MetricPrometheus metrics issue PromQL queries to a matched service. Queries MUST evaluate to a scalar value (or a single series for range queries).
 	MetricPrometheus MetricType = "prometheus"
 	// MetricDatadog metrics issue queries to the Datadog service. Requires API and application key configuration.
 	MetricDatadog MetricType = "datadog"
 	// MetricJSONPath metrics fetch a JSON resource from the matched service. Queries are JSON path expression evaluated against the resource.
 	MetricJSONPath MetricType = "jsonpath"
+	// MetricLogs metrics are extracted from the logs of the trial run container. Queries are either a regular expression
+	// (the first capture group is used as the value) or a JSON path expression (with curly braces) evaluated against each
+	// line of JSON output; when there are multiple matches, the last one is used.
+	MetricLogs MetricType = "logs"
+	// MetricResults metrics are extracted from the results file collected from the trial run job. Queries are JSON path
+	// expressions evaluated against the results file.
+	MetricResults MetricType = "results"
+)
+
+// MetricReducer represents the allowable functions for reducing a series of metric samples to a single value
+type MetricReducer string
+
+const (
+	// MetricReducerMean reduces a series to the arithmetic mean of the samples
+	MetricReducerMean MetricReducer = "mean"
+	// MetricReducerMax reduces a series to the largest sample
+	MetricReducerMax MetricReducer = "max"
+	// MetricReducerMin reduces a series to the smallest sample
+	MetricReducerMin MetricReducer = "min"
+	// MetricReducerP50 reduces a series to the 50th percentile (median) of the samples
+	MetricReducerP50 MetricReducer = "p50"
+	// MetricReducerP95 reduces a series to the 95th percentile of the samples
+	MetricReducerP95 MetricReducer = "p95"
+	// MetricReducerP99 reduces a series to the 99th percentile of the samples
+	MetricReducerP99 MetricReducer = "p99"
+	// MetricReducerLast reduces a series to the most recent sample
+	MetricReducerLast MetricReducer = "last"
+)
+
+// MetricTargetType represents the allowable types of objects matched by a metric selector
+type MetricTargetType string
+
+const (
+	// MetricTargetService metrics are collected from the cluster IP of the matched services
+	MetricTargetService MetricTargetType = "service"
+	// MetricTargetPods metrics are collected from the pod IP of the matched pods, this allows collection from pods
+	// behind a headless service
+	MetricTargetPods MetricTargetType = "pods"
+)
+
+// MetricAggregation represents the allowable functions for combining metric values collected from multiple targets
+type MetricAggregation string
+
+const (
+	// MetricAggregationSum combines values using the sum of the individual values
+	MetricAggregationSum MetricAggregation = "sum"
+	// MetricAggregationAvg combines values using the arithmetic mean of the individual values
+	MetricAggregationAvg MetricAggregation = "avg"
+	// MetricAggregationMax combines values using the largest of the individual values
+	MetricAggregationMax MetricAggregation = "max"
 )
 
 // Metric represents an observable outcome from a trial run
@@ -101,19 +171,36 @@ type Metric struct {
 	Name string `json:"name"`
 	// Indicator that the goal of the experiment is to minimize the value of this metric
 	Minimize bool `json:"minimize,omitempty"`
+	// Indicator that the value of this metric should be optimized, when false the metric is only collected for reporting, default: true
+	Optimize *bool `json:"optimize,omitempty"`
+	// The lower bound on the value of this metric, trials producing a smaller value are infeasible
+	Min *resource.Quantity `json:"min,omitempty"`
+	// The upper bound on the value of this metric, trials producing a larger value are infeasible
+	Max *resource.Quantity `json:"max,omitempty"`
 
-	// The metric collection type, one of: local|pods|prometheus|datadog|jsonpath, default: local
+	// The metric collection type, one of: local|pods|prometheus|datadog|jsonpath|logs|results, default: local
 	Type MetricType `json:"type,omitempty"`
 	// Collection type specific query, e.g. Go template for "local", PromQL for "prometheus" or a JSON pointer expression (with curly braces) for "jsonpath"
 	Query string `json:"query"`
 	// Collection type specific query for the error associated with collected metric value
 	ErrorQuery string `json:"errorQuery,omitempty"`
+	// The resolution of a range query, when set the query is evaluated over the duration of the trial run
+	Step *metav1.Duration `json:"step,omitempty"`
+	// The function used to reduce a range query to a single value, one of: mean|max|min|p50|p95|p99|last, default: mean
+	Reducer MetricReducer `json:"reducer,omitempty"`
+	// The name of the trial run container to read logs from when collecting "logs" metrics, default: the first container
+	Container string `json:"container,omitempty"`
 
 	// The scheme to use when collecting metrics
 	Scheme string `json:"scheme,omitempty"`
-	// Selector matching services to collect this metric from, only the first matched service to provide a value is used
+	// Selector matching the services or pods to collect this metric from
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
-	// The port number or name on the matched service to collect the metric value from
+	// The type of object matched by the selector, one of: service|pods, default: service
+	TargetType MetricTargetType `json:"targetType,omitempty"`
+	// How values collected from multiple matched objects are combined, one of: sum|avg|max; when omitted the first
+	// matched service to provide a value is used and the values from matched pods are averaged
+	Aggregation MetricAggregation `json:"aggregation,omitempty"`
+	// The port number or name on the matched service (or container of a matched pod) to collect the metric value from
 	Port intstr.IntOrString `json:"port,omitempty"`
 	// URL path component used to collect the metric value from an endpoint (used as a prefix for the Prometheus API)
 	Path string `json:"path,omitempty"`
@@ -143,8 +230,13 @@ type PatchTemplate struct {
 	Type PatchType `json:"type,omitempty"`
 	// A Go Template that evaluates to valid patch
 	Patch string `json:"patch"`
-	// Direct reference to the object the patch should be applied to
+	// Direct reference to the object the patch should be applied to; when "Selector" is specified only the API
+	// version, kind and (optional) namespace of the reference are used
 	TargetRef *corev1.ObjectReference `json:"targetRef,omitempty"`
+	// Selector matches the objects the patch should be applied to, mutually exclusive with the name of the target
+	// reference. A separate patch is rendered for each matching object, the object is available to the patch
+	// template as ".Target"
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 	// ReadinessGates will be evaluated for patch target readiness. A patch target is ready if all conditions specified
 	// in the readiness gates have a status equal to "True". If no readiness gates are specified, some target types may
 	// have default gates assigned to them. Some condition checks may result in errors, e.g. a condition type of "Ready"
@@ -153,6 +245,18 @@ type PatchTemplate struct {
 	ReadinessGates []PatchReadinessGate `json:"readinessGates,omitempty"`
 }
 
+// PatchRollbackPolicy represents the allowable policies for restoring patched objects
+type PatchRollbackPolicy string
+
+const (
+	// PatchRollbackNever leaves patched objects in the state of the most recent trial
+	PatchRollbackNever PatchRollbackPolicy = "never"
+	// PatchRollbackAfterTrial restores patched objects once each trial is finished
+	PatchRollbackAfterTrial PatchRollbackPolicy = "afterTrial"
+	// PatchRollbackOnDelete restores patched objects when the experiment is deleted
+	PatchRollbackOnDelete PatchRollbackPolicy = "onDelete"
+)
+
 // NamespaceTemplateSpec is used as a template for creating new namespaces
 type NamespaceTemplateSpec struct {
 	// Standard object metadata
@@ -169,6 +273,29 @@ type TrialTemplateSpec struct {
 	Spec TrialSpec `json:"spec,omitempty"`
 }
 
+// StoppingCriteria defines conditions which cause the experiment to stop requesting new trials
+type StoppingCriteria struct {
+	// MaxTrials is the maximum number of trials to run for the experiment
+	MaxTrials *int32 `json:"maxTrials,omitempty"`
+	// MaxDuration is the maximum amount of time the experiment will run for, measured from its creation
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+	// MaxConsecutiveFailures is the maximum number of trials which can fail in a row
+	MaxConsecutiveFailures *int32 `json:"maxConsecutiveFailures,omitempty"`
+	// NoImprovementTrials is the number of successful trials in a row which can fail to improve upon the best
+	// trial, only available for experiments with a single optimized metric
+	NoImprovementTrials *int32 `json:"noImprovementTrials,omitempty"`
+}
+
+// PromotionSpec defines how the configuration of the best trial is applied once the experiment completes
+type PromotionSpec struct {
+	// TargetNamespace is the namespace the patches are applied to when they do not specify a namespace, defaults to
+	// the namespace of the experiment
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	// ConfigMapName is the name of a config map in the experiment namespace; when specified, the rendered patches are
+	// written to the config map instead of being applied to the cluster
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
 // ExperimentSpec defines the desired state of Experiment
 type ExperimentSpec struct {
 	// Replicas is the number of trials to execute concurrently, defaults to 1
@@ -184,6 +311,9 @@ type ExperimentSpec struct {
 	// Patches is a sequence of templates written against the experiment parameters that will be used to put the
 	// cluster into the desired state
 	Patches []PatchTemplate `json:"patches,omitempty"`
+	// PatchRollback determines if the original state of patched objects is restored, one of: never|afterTrial|onDelete,
+	// default: never
+	PatchRollback PatchRollbackPolicy `json:"patchRollback,omitempty"`
 	// NamespaceSelector is used to locate existing namespaces for trials
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 	// NamespaceTemplate can be specified to create new namespaces for trials; if specified created namespaces must be
@@ -195,6 +325,46 @@ type ExperimentSpec struct {
 	// initial namespace, however other namespaces (matched by NamespaceSelector) will be used if the effective
 	// replica count is more then one
 	TrialTemplate TrialTemplateSpec `json:"trialTemplate,omitempty"`
+	// StoppingCriteria defines when the experiment should stop requesting new trials
+	StoppingCriteria *StoppingCriteria `json:"stoppingCriteria,omitempty"`
+	// Promotion applies the configuration of the best trial once the experiment completes
+	Promotion *PromotionSpec `json:"promotion,omitempty"`
+}
+
+// ExperimentConditionType represents the possible observable conditions for an experiment
+type ExperimentConditionType string
+
+const (
+	// ExperimentRunning is a condition that indicates the experiment has active trials
+	ExperimentRunning ExperimentConditionType = "redskyops.dev/experiment-running"
+	// ExperimentComplete is a condition that indicates the experiment is no longer expecting new trials
+	ExperimentComplete ExperimentConditionType = "redskyops.dev/experiment-complete"
+)
+
+// ExperimentCondition represents an observed condition of an experiment
+type ExperimentCondition struct {
+	// The condition type, e.g. "redskyops.dev/experiment-complete"
+	Type ExperimentConditionType `json:"type"`
+	// The status of the condition, one of "True", "False", or "Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// The time at which the condition last changed status
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// A reason code describing the why the condition occurred
+	Reason string `json:"reason,omitempty"`
+	// A human readable message describing the transition
+	Message string `json:"message,omitempty"`
+}
+
+// BestTrialStatus describes the trial with the best observed value of the optimized metric
+type BestTrialStatus struct {
+	// Name of the trial
+	Name string `json:"name"`
+	// Namespace of the trial
+	Namespace string `json:"namespace,omitempty"`
+	// Assignments are the parameter assignments of the trial
+	Assignments []Assignment `json:"assignments,omitempty"`
+	// Values are the metric values of the trial
+	Values []Value `json:"values,omitempty"`
 }
 
 // ExperimentStatus defines the observed state of Experiment
@@ -203,7 +373,29 @@ type ExperimentStatus struct {
 	Phase string `json:"phase"`
 	// ActiveTrials is the observed number of running trials
 	ActiveTrials int32 `json:"activeTrials"`
-	// TODO Number of trials: Succeeded, Failed int32 (this would need to be fetch remotely, falling back to the in cluster count)
+	// SucceededTrials is the total number of trials which completed successfully, including deleted trials
+	SucceededTrials int32 `json:"succeededTrials,omitempty"`
+	// FailedTrials is the total number of trials which failed, including deleted trials
+	FailedTrials int32 `json:"failedTrials,omitempty"`
+	// AbandonedTrials is the total number of trials which were deleted before they finished
+	AbandonedTrials int32 `json:"abandonedTrials,omitempty"`
+	// ConsecutiveFailures is the number of trials which failed in a row, including deleted trials
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// TrialsWithoutImprovement is the number of successful trials since the best trial, including deleted trials
+	TrialsWithoutImprovement int32 `json:"trialsWithoutImprovement,omitempty"`
+	// TrialsCountedUntil is the time up to which the outcome of finished trials is included in the trial counts
+	TrialsCountedUntil *metav1.Time `json:"trialsCountedUntil,omitempty"`
+	// BaselineCreated indicates that the trial using the baseline assignments was created
+	BaselineCreated bool `json:"baselineCreated,omitempty"`
+	// BestTrial is the best trial observed so far, including deleted trials, only available for experiments with a
+	// single optimized metric
+	BestTrial *BestTrialStatus `json:"bestTrial,omitempty"`
+	// PromotedTrial is the name of the trial whose configuration was promoted
+	PromotedTrial string `json:"promotedTrial,omitempty"`
+	// Reason is a brief machine readable explanation of which stopping criteria caused the experiment to complete
+	Reason string `json:"reason,omitempty"`
+	// Conditions is the current state of the experiment
+	Conditions []ExperimentCondition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -212,6 +404,9 @@ type ExperimentStatus struct {
 
 // Experiment is the Schema for the experiments API
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Experiment status"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeededTrials",description="Succeeded trials"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedTrials",description="Failed trials"
+// +kubebuilder:printcolumn:name="Best",type="string",JSONPath=".status.bestTrial.name",description="Best trial"
 type Experiment struct {
 	metav1.TypeMeta `json:",inline"`
 	// Standard object metadata
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Int64OrString is a type that can hold an int64 or a string. When used in JSON or YAML marshalling and
+// unmarshalling, it produces or consumes the inner type. Unlike `intstr.IntOrString`, integer values are not
+// limited to 32-bits; the `intstr.Int` and `intstr.String` constants are used to indicate the type. The CRD
+// generator does not support types which marshal themselves, the generated schema is replaced with an `anyOf` integer
+// or string schema by the patches in `config/crd/patches`.
+//
+// +kubebuilder:validation:XIntOrString
+type Int64OrString struct {
+	Type   intstr.Type `json:"-"`
+	IntVal int64       `json:"-"`
+	StrVal string      `json:"-"`
+}
+
+// OpenAPISchemaType is used by the kube-openapi generator when constructing the OpenAPI spec of this type
+func (Int64OrString) OpenAPISchemaType() []string { return []string{"string"} }
+
+// OpenAPISchemaFormat is used by the kube-openapi generator when constructing the OpenAPI spec of this type
+func (Int64OrString) OpenAPISchemaFormat() string { return "int-or-string" }
+
+// FromInt64 creates an Int64OrString object with an int64 value
+func FromInt64(val int64) Int64OrString {
+	return Int64OrString{Type: intstr.Int, IntVal: val}
+}
+
+// FromString creates an Int64OrString object with a string value
+func FromString(val string) Int64OrString {
+	return Int64OrString{Type: intstr.String, StrVal: val}
+}
+
+// String returns the string value, or the string representation of the int64 value
+func (in *Int64OrString) String() string {
+	if in.Type == intstr.String {
+		return in.StrVal
+	}
+	return strconv.FormatInt(in.IntVal, 10)
+}
+
+// Int64Value returns the int64 value, or the parsed string value (zero if the string is not an integer)
+func (in *Int64OrString) Int64Value() int64 {
+	if in.Type == intstr.String {
+		i, _ := strconv.ParseInt(in.StrVal, 10, 64)
+		return i
+	}
+	return in.IntVal
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface
+func (in *Int64OrString) UnmarshalJSON(value []byte) error {
+	if len(value) > 0 && value[0] == '"' {
+		in.Type = intstr.String
+		in.IntVal = 0
+		return json.Unmarshal(value, &in.StrVal)
+	}
+	in.Type = intstr.Int
+	in.StrVal = ""
+	return json.Unmarshal(value, &in.IntVal)
+}
+
+// MarshalJSON implements the json.Marshaller interface
+func (in Int64OrString) MarshalJSON() ([]byte, error) {
+	if in.Type == intstr.String {
+		return json.Marshal(in.StrVal)
+	}
+	return json.Marshal(in.IntVal)
+}
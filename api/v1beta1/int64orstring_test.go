@@ -0,0 +1,67 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt64OrString_JSON(t *testing.T) {
+	cases := []struct {
+		desc  string
+		value Int64OrString
+		json  string
+	}{
+		{
+			desc:  "int",
+			value: FromInt64(1),
+			json:  `1`,
+		},
+		{
+			desc:  "int64",
+			value: FromInt64(1 << 40),
+			json:  `1099511627776`,
+		},
+		{
+			desc:  "negative",
+			value: FromInt64(-(1 << 40)),
+			json:  `-1099511627776`,
+		},
+		{
+			desc:  "string",
+			value: FromString("0.5"),
+			json:  `"0.5"`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			data, err := json.Marshal(c.value)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, c.json, string(data))
+			}
+
+			actual := Int64OrString{}
+			if assert.NoError(t, json.Unmarshal([]byte(c.json), &actual)) {
+				assert.Equal(t, c.value, actual)
+				assert.Equal(t, c.value.String(), actual.String())
+			}
+		})
+	}
+}
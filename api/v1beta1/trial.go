@@ -46,13 +46,13 @@ func (in *Trial) HasInitializer() bool {
 }
 
 // GetAssignment returns an assignment value by name
-func (in *Trial) GetAssignment(name string) (int64, bool) {
+func (in *Trial) GetAssignment(name string) (Int64OrString, bool) {
 	for i := range in.Spec.Assignments {
 		if in.Spec.Assignments[i].Name == name {
 			return in.Spec.Assignments[i].Value, true
 		}
 	}
-	return 0, false
+	return Int64OrString{}, false
 }
 
 // GetJobSelector returns the job selector
@@ -72,3 +72,20 @@ func (in *Trial) GetJobSelector() *metav1.LabelSelector {
 		},
 	}
 }
+
+// Default sets the default values for any unspecified fields of the trial
+func (in *Trial) Default() {
+	in.Spec.Default()
+}
+
+// Default sets the default values for any unspecified fields of the trial specification
+func (in *TrialSpec) Default() {
+	for i := range in.ReadinessGates {
+		if in.ReadinessGates[i].PeriodSeconds == 0 {
+			in.ReadinessGates[i].PeriodSeconds = 10
+		}
+		if in.ReadinessGates[i].FailureThreshold == 0 {
+			in.ReadinessGates[i].FailureThreshold = 3
+		}
+	}
+}
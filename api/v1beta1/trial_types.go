@@ -30,8 +30,9 @@ import (
 type Assignment struct {
 	// Name of the parameter being assigned
 	Name string `json:"name"`
-	// Value of the assignment
-	Value int64 `json:"value"`
+	// Value of the assignment, integer parameters use integer values while double and categorical parameters
+	// use string values
+	Value Int64OrString `json:"value"`
 }
 
 // TrialReadinessGate represents a readiness check on one or more objects that must pass after patches
@@ -74,7 +75,11 @@ type HelmValue struct {
 type HelmValueSource struct {
 	// Selects a trial parameter assignment as a Helm value
 	ParameterRef *ParameterSelector `json:"parameterRef,omitempty"`
-	// TODO Also support the corev1.EnvVarSource selectors?
+	// Selects a field of the trial as a Helm value, supports `metadata.name`, `metadata.namespace`,
+	// `metadata.labels['<KEY>']` and `metadata.annotations['<KEY>']`
+	FieldRef *corev1.ObjectFieldSelector `json:"fieldRef,omitempty"`
+	// Selects a key of a secret in the trial namespace as a Helm value
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
 // ParameterSelector selects a trial parameter assignment. Note that parameters values are used as is (i.e. in
@@ -82,14 +87,20 @@ type HelmValueSource struct {
 type ParameterSelector struct {
 	// The name of the trial parameter to use
 	Name string `json:"name"`
-	// TODO Offer simple manipulations via "percent", "delta", "scale"? Allow string references to other parameters
+	// Treat the parameter assignment as a percentage of this value
+	Percent int64 `json:"percent,omitempty"`
+	// Multiply the parameter assignment by this decimal factor (e.g. "0.5")
+	Scale string `json:"scale,omitempty"`
+	// Add this value to the parameter assignment after the percentage and scale are applied
+	Delta int64 `json:"delta,omitempty"`
 }
 
 // HelmValuesFromSource represents a source of a values mapping
 type HelmValuesFromSource struct {
 	// The ConfigMap to select from
 	ConfigMap *ConfigMapHelmValuesFromSource `json:"configMap,omitempty"`
-	// TODO Secret support?
+	// The Secret to select from
+	Secret *SecretHelmValuesFromSource `json:"secret,omitempty"`
 }
 
 // ConfigMapHelmValuesFromSource is a reference to a ConfigMap that contains "*values.yaml" keys
@@ -98,6 +109,23 @@ type ConfigMapHelmValuesFromSource struct {
 	corev1.LocalObjectReference `json:",inline"`
 }
 
+// SecretHelmValuesFromSource is a reference to a Secret that contains "*values.yaml" keys
+type SecretHelmValuesFromSource struct {
+	corev1.LocalObjectReference `json:",inline"`
+}
+
+// SetupLifecycle describes when setup tasks are created and deleted
+type SetupLifecycle string
+
+const (
+	// SetupLifecycleTrial creates the setup before every trial and deletes it after the trial finishes
+	SetupLifecycleTrial SetupLifecycle = "Trial"
+	// SetupLifecycleNamespace creates the setup once per trial namespace and keeps it for subsequent trials of the
+	// same experiment; the setup is only updated when the evaluated setup tasks change and it is only deleted when
+	// the experiment finishes or the trial currently holding the setup is deleted
+	SetupLifecycleNamespace SetupLifecycle = "Namespace"
+)
+
 // SetupTask represents the configuration necessary to apply application state to the cluster
 // prior to each trial run and remove that state after the run concludes
 type SetupTask struct {
@@ -119,6 +147,33 @@ type SetupTask struct {
 	HelmValues []HelmValue `json:"helmValues,omitempty"`
 	// The Helm values, ignored unless helmChart is also set
 	HelmValuesFrom []HelmValuesFromSource `json:"helmValuesFrom,omitempty"`
+	// Plain manifests to apply as part of this task
+	Manifests []ManifestSource `json:"manifests,omitempty"`
+	// Reference to a Kustomize overlay (e.g. a Git repository URL and path) to build and apply as part of this task;
+	// the reference is evaluated as a template using the same rules as patches
+	Kustomize string `json:"kustomize,omitempty"`
+	// Override the entrypoint of the image to run an arbitrary command instead of applying resources, requires an image
+	Command []string `json:"command,omitempty"`
+	// Arguments to the image entrypoint (or command) in place of the default "create" argument; each argument is
+	// evaluated as a template using the same rules as patches. When args are specified without deleteArgs, the
+	// deletion part of the task is skipped.
+	Args []string `json:"args,omitempty"`
+	// Arguments to the image entrypoint (or command) in place of the default "delete" argument; each argument is
+	// evaluated as a template using the same rules as patches
+	DeleteArgs []string `json:"deleteArgs,omitempty"`
+}
+
+// ManifestSource represents a source of plain manifests
+type ManifestSource struct {
+	// The ConfigMap to select from
+	ConfigMap *ConfigMapManifestSource `json:"configMap,omitempty"`
+	// The URL of a manifest to download, evaluated as a template using the same rules as patches
+	URL string `json:"url,omitempty"`
+}
+
+// ConfigMapManifestSource is a reference to a ConfigMap that contains "*.yaml" manifest keys
+type ConfigMapManifestSource struct {
+	corev1.LocalObjectReference `json:",inline"`
 }
 
 // PatchOperation represents a patch used to prepare the cluster for a trial run, includes the evaluated
@@ -133,6 +188,9 @@ type PatchOperation struct {
 	// The number of remaining attempts to apply the patch, will be automatically set
 	// to zero if the patch is successfully applied
 	AttemptsRemaining int `json:"attemptsRemaining,omitempty"`
+	// RollbackData is a merge patch which restores the target object to its state before the patch was applied, it
+	// is only recorded if the experiment rolls back patches and is cleared once it has been applied
+	RollbackData []byte `json:"rollbackData,omitempty"`
 }
 
 // ReadinessCheck represents a check to determine when the patched application is "ready" and it is
@@ -172,6 +230,35 @@ type Value struct {
 	AttemptsRemaining int `json:"attemptsRemaining,omitempty"`
 }
 
+// RetryPolicy describes how trials which fail for reasons unrelated to the trial assignments are re-run
+type RetryPolicy struct {
+	// Limit is the maximum number of times the assignments of a failed trial are re-run, defaults to 1
+	Limit *int32 `json:"limit,omitempty"`
+	// Reasons are the failure reasons which can be retried, defaults to image pull failures, pod evictions and
+	// preemptions, and unreachable metric endpoints
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// LogRetention describes how the logs of the trial run job are kept once the job finishes
+type LogRetention struct {
+	// The number of lines from the end of the trial run container logs to keep, defaults to 50
+	TailLines int64 `json:"tailLines,omitempty"`
+	// The name of the trial run container to keep logs for, defaults to the first container
+	Container string `json:"container,omitempty"`
+	// Keep the logs in a config map in the experiment namespace instead of the trial status; the config map is
+	// retained until the experiment is deleted, even if the trial is cleaned up
+	ConfigMap bool `json:"configMap,omitempty"`
+}
+
+// ResultsCollection describes how a results file written by the trial run job is collected
+type ResultsCollection struct {
+	// The location of the results file written by the trial run containers, defaults to
+	// "/var/run/redskyops/results.json"; the directory is shared by all of the trial run containers
+	Path string `json:"path,omitempty"`
+	// The image used for the results collector sidecar, must include a shell, defaults to "busybox"
+	Image string `json:"image,omitempty"`
+}
+
 // TrialConditionType represents the possible observable conditions for a trial
 type TrialConditionType string
 
@@ -190,6 +277,8 @@ const (
 	TrialReady TrialConditionType = "redskyops.dev/trial-ready"
 	// TrialObserved is a condition that indicates a trial has had metrics collected
 	TrialObserved TrialConditionType = "redskyops.dev/trial-observed"
+	// TrialRolledBack is a condition that indicates the patches of a finished trial have been rolled back
+	TrialRolledBack TrialConditionType = "redskyops.dev/trial-rolled-back"
 )
 
 // TrialCondition represents an observed condition of a trial
@@ -231,6 +320,12 @@ type TrialSpec struct {
 	TTLSecondsAfterFailure *int32 `json:"ttlSecondsAfterFailure,omitempty"`
 	// The readiness gates to check before running the trial job
 	ReadinessGates []TrialReadinessGate `json:"readinessGates,omitempty"`
+	// RetryPolicy is used to re-run the assignments of a failed trial in a new trial instead of reporting the failure
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// LogRetention is used to keep the end of the trial run logs for diagnosing failures after the job is gone
+	LogRetention *LogRetention `json:"logRetention,omitempty"`
+	// Results is used to collect a results file written by the trial run job using a sidecar container
+	Results *ResultsCollection `json:"results,omitempty"`
 
 	// Values are the collected metrics at the end of the trial run
 	Values []Value `json:"values,omitempty"`
@@ -245,6 +340,8 @@ type TrialSpec struct {
 	SetupDefaultClusterRole string `json:"setupDefaultClusterRole,omitempty"`
 	// Policy rules to be assigned to the setup service account when creating namespaces
 	SetupDefaultRules []rbacv1.PolicyRule `json:"setupDefaultRules,omitempty"`
+	// Controls how often setup tasks are created and deleted, one of "Trial" (the default) or "Namespace"
+	SetupLifecycle SetupLifecycle `json:"setupLifecycle,omitempty"`
 }
 
 // TrialStatus defines the observed state of Trial
@@ -265,6 +362,8 @@ type TrialStatus struct {
 	PatchOperations []PatchOperation `json:"patchOperations,omitempty"`
 	// ReadinessChecks are the all of the objects whose conditions need to be inspected for this trial
 	ReadinessChecks []ReadinessCheck `json:"readinessChecks,omitempty"`
+	// LogTail is the end of the trial run container logs, only recorded when log retention is configured
+	LogTail string `json:"logTail,omitempty"`
 }
 
 // +genclient
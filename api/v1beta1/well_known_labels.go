@@ -25,6 +25,10 @@ const (
 	AnnotationNextTrialURL = "redskyops.dev/next-trial-url"
 	// AnnotationReportTrialURL is the URL used to report trial observations
 	AnnotationReportTrialURL = "redskyops.dev/report-trial-url"
+	// AnnotationSuggestions is the number of trials suggested by the local optimizer
+	AnnotationSuggestions = "redskyops.dev/suggestions"
+	// AnnotationSamplingIndex is the position in the sampling plan of the next local optimizer suggestion
+	AnnotationSamplingIndex = "redskyops.dev/sampling-index"
 
 	// LabelExperiment is the name of the experiment associated with an object
 	LabelExperiment = "redskyops.dev/experiment"
@@ -36,9 +40,13 @@ const (
 	// AnnotationInitializer is a comma-delimited list of initializing processes. Similar to a "finalizer", the trial
 	// will not start executing until the initializer is empty.
 	AnnotationInitializer = "redskyops.dev/initializer"
+	// AnnotationRetries is the number of times the assignments of a failed trial have been re-run in a new trial
+	AnnotationRetries = "redskyops.dev/retries"
 
 	// LabelTrial contains the name of the trial associated with an object
 	LabelTrial = "redskyops.dev/trial"
 	// LabelTrialRole contains the role in trial execution
 	LabelTrialRole = "redskyops.dev/trial-role"
+	// LabelBaseline is set to "true" on the trial which uses the baseline parameter values
+	LabelBaseline = "redskyops.dev/baseline"
 )
@@ -31,6 +31,7 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Assignment) DeepCopyInto(out *Assignment) {
 	*out = *in
+	out.Value = in.Value
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Assignment.
@@ -43,6 +44,31 @@ func (in *Assignment) DeepCopy() *Assignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BestTrialStatus) DeepCopyInto(out *BestTrialStatus) {
+	*out = *in
+	if in.Assignments != nil {
+		in, out := &in.Assignments, &out.Assignments
+		*out = make([]Assignment, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]Value, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BestTrialStatus.
+func (in *BestTrialStatus) DeepCopy() *BestTrialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BestTrialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapHelmValuesFromSource) DeepCopyInto(out *ConfigMapHelmValuesFromSource) {
 	*out = *in
@@ -59,6 +85,22 @@ func (in *ConfigMapHelmValuesFromSource) DeepCopy() *ConfigMapHelmValuesFromSour
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapManifestSource) DeepCopyInto(out *ConfigMapManifestSource) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapManifestSource.
+func (in *ConfigMapManifestSource) DeepCopy() *ConfigMapManifestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapManifestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Constraint) DeepCopyInto(out *Constraint) {
 	*out = *in
@@ -90,7 +132,7 @@ func (in *Experiment) DeepCopyInto(out *Experiment) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Experiment.
@@ -111,6 +153,22 @@ func (in *Experiment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentCondition) DeepCopyInto(out *ExperimentCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentCondition.
+func (in *ExperimentCondition) DeepCopy() *ExperimentCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentList) DeepCopyInto(out *ExperimentList) {
 	*out = *in
@@ -159,7 +217,9 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		*out = make([]Parameter, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Constraints != nil {
 		in, out := &in.Constraints, &out.Constraints
@@ -198,6 +258,16 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.TrialTemplate.DeepCopyInto(&out.TrialTemplate)
+	if in.StoppingCriteria != nil {
+		in, out := &in.StoppingCriteria, &out.StoppingCriteria
+		*out = new(StoppingCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(PromotionSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentSpec.
@@ -213,6 +283,22 @@ func (in *ExperimentSpec) DeepCopy() *ExperimentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 	*out = *in
+	if in.TrialsCountedUntil != nil {
+		in, out := &in.TrialsCountedUntil, &out.TrialsCountedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.BestTrial != nil {
+		in, out := &in.BestTrial, &out.BestTrial
+		*out = new(BestTrialStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ExperimentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentStatus.
@@ -254,6 +340,16 @@ func (in *HelmValueSource) DeepCopyInto(out *HelmValueSource) {
 		*out = new(ParameterSelector)
 		**out = **in
 	}
+	if in.FieldRef != nil {
+		in, out := &in.FieldRef, &out.FieldRef
+		*out = new(corev1.ObjectFieldSelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValueSource.
@@ -274,6 +370,11 @@ func (in *HelmValuesFromSource) DeepCopyInto(out *HelmValuesFromSource) {
 		*out = new(ConfigMapHelmValuesFromSource)
 		**out = **in
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretHelmValuesFromSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFromSource.
@@ -286,9 +387,79 @@ func (in *HelmValuesFromSource) DeepCopy() *HelmValuesFromSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int64OrString) DeepCopyInto(out *Int64OrString) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int64OrString.
+func (in *Int64OrString) DeepCopy() *Int64OrString {
+	if in == nil {
+		return nil
+	}
+	out := new(Int64OrString)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRetention) DeepCopyInto(out *LogRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRetention.
+func (in *LogRetention) DeepCopy() *LogRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestSource) DeepCopyInto(out *ManifestSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapManifestSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSource.
+func (in *ManifestSource) DeepCopy() *ManifestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metric) DeepCopyInto(out *Metric) {
 	*out = *in
+	if in.Optimize != nil {
+		in, out := &in.Optimize, &out.Optimize
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Selector != nil {
 		in, out := &in.Selector, &out.Selector
 		*out = new(v1.LabelSelector)
@@ -357,6 +528,18 @@ func (in *OrderConstraint) DeepCopy() *OrderConstraint {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
+	out.Min = in.Min
+	out.Max = in.Max
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Baseline != nil {
+		in, out := &in.Baseline, &out.Baseline
+		*out = new(Int64OrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
@@ -393,6 +576,11 @@ func (in *PatchOperation) DeepCopyInto(out *PatchOperation) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.RollbackData != nil {
+		in, out := &in.RollbackData, &out.RollbackData
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchOperation.
@@ -428,6 +616,11 @@ func (in *PatchTemplate) DeepCopyInto(out *PatchTemplate) {
 		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReadinessGates != nil {
 		in, out := &in.ReadinessGates, &out.ReadinessGates
 		*out = make([]PatchReadinessGate, len(*in))
@@ -445,6 +638,21 @@ func (in *PatchTemplate) DeepCopy() *PatchTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
+func (in *PromotionSpec) DeepCopy() *PromotionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadinessCheck) DeepCopyInto(out *ReadinessCheck) {
 	*out = *in
@@ -475,6 +683,62 @@ func (in *ReadinessCheck) DeepCopy() *ReadinessCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsCollection) DeepCopyInto(out *ResultsCollection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsCollection.
+func (in *ResultsCollection) DeepCopy() *ResultsCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretHelmValuesFromSource) DeepCopyInto(out *SecretHelmValuesFromSource) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretHelmValuesFromSource.
+func (in *SecretHelmValuesFromSource) DeepCopy() *SecretHelmValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretHelmValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetupTask) DeepCopyInto(out *SetupTask) {
 	*out = *in
@@ -499,6 +763,28 @@ func (in *SetupTask) DeepCopyInto(out *SetupTask) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]ManifestSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeleteArgs != nil {
+		in, out := &in.DeleteArgs, &out.DeleteArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupTask.
@@ -511,6 +797,41 @@ func (in *SetupTask) DeepCopy() *SetupTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoppingCriteria) DeepCopyInto(out *StoppingCriteria) {
+	*out = *in
+	if in.MaxTrials != nil {
+		in, out := &in.MaxTrials, &out.MaxTrials
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConsecutiveFailures != nil {
+		in, out := &in.MaxConsecutiveFailures, &out.MaxConsecutiveFailures
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoImprovementTrials != nil {
+		in, out := &in.NoImprovementTrials, &out.NoImprovementTrials
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoppingCriteria.
+func (in *StoppingCriteria) DeepCopy() *StoppingCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(StoppingCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SumConstraint) DeepCopyInto(out *SumConstraint) {
 	*out = *in
@@ -701,6 +1022,21 @@ func (in *TrialSpec) DeepCopyInto(out *TrialSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogRetention != nil {
+		in, out := &in.LogRetention, &out.LogRetention
+		*out = new(LogRetention)
+		**out = **in
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(ResultsCollection)
+		**out = **in
+	}
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
 		*out = make([]Value, len(*in))
@@ -22,6 +22,34 @@ kustomize create --namespace "$NAMESPACE"
 find . -type f -name "*.yaml" ! -name "kustomization.yaml" -exec kustomize edit add resource {} +
 
 
+# Add plain manifests (mounted directories or URLs, one per line)
+if [ -n "$MANIFESTS" ] ; then
+    i=0
+    echo "$MANIFESTS" | while read -r m ; do
+        i=$((i+1))
+        case "$m" in
+        http://*|https://*)
+            curl -sSfL "$m" -o "manifest-$i.yaml"
+            kustomize edit add resource "manifest-$i.yaml"
+            ;;
+        *)
+            for f in "$m"/*.yaml "$m"/*.yml "$m"/*.json ; do
+                [ -e "$f" ] || continue
+                cp "$f" "manifest-$i-$(basename "$f")"
+                kustomize edit add resource "manifest-$i-$(basename "$f")"
+            done
+            ;;
+        esac
+    done
+fi
+
+
+# Add Kustomize overlay
+if [ -n "$KUSTOMIZE" ] ; then
+    kustomize edit add resource "$KUSTOMIZE"
+fi
+
+
 # Add Helm configuration
 if [ -n "$HELM_CONFIG" ] ; then
     echo "$HELM_CONFIG" | base64 -d > helm.yaml
@@ -56,6 +84,12 @@ while [ "$#" != "0" ] ; do
         }
         shift
         ;;
+    apply)
+        handle () {
+            kubectl apply -f -
+        }
+        shift
+        ;;
     delete)
         handle () {
             kubectl delete -f -
@@ -0,0 +1,33 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// recordFailure emits a warning event using the reason and message of the trial's failed condition, if any
+func recordFailure(recorder record.EventRecorder, t *redskyv1beta1.Trial) {
+	for _, c := range t.Status.Conditions {
+		if c.Type == redskyv1beta1.TrialFailed && c.Status == corev1.ConditionTrue {
+			recorder.Event(t, corev1.EventTypeWarning, c.Reason, c.Message)
+			return
+		}
+	}
+}
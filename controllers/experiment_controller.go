@@ -18,17 +18,30 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/redskyops/redskyops-controller/internal/controller"
 	"github.com/redskyops/redskyops-controller/internal/experiment"
 	"github.com/redskyops/redskyops-controller/internal/meta"
+	"github.com/redskyops/redskyops-controller/internal/setup"
+	"github.com/redskyops/redskyops-controller/internal/template"
 	"github.com/redskyops/redskyops-controller/internal/trial"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -37,17 +50,29 @@ import (
 // ExperimentReconciler reconciles an Experiment object
 type ExperimentReconciler struct {
 	client.Client
-	Log logr.Logger
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Promoted patch targets matched by a selector are listed directly from the API server
+	apiReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=list;watch;update;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;create;update;delete
+// +kubebuilder:rbac:groups=batch;extensions,resources=jobs,verbs=get;list;watch;create
 
 func (r *ExperimentReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
 
 	exp := &redskyv1beta1.Experiment{}
 	if err := r.Get(ctx, req.NamespacedName, exp); err != nil {
+		if apierrs.IsNotFound(err) {
+			// Stop reporting the trial counts of deleted experiments
+			controller.DeleteExperimentMetrics(req.NamespacedName)
+		}
 		return ctrl.Result{}, controller.IgnoreNotFound(err)
 	}
 
@@ -64,14 +89,37 @@ func (r *ExperimentReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return *result, err
 	}
 
+	if result, err := r.promoteBestTrial(ctx, exp, trialList); result != nil {
+		return *result, err
+	}
+
+	if result, err := r.rollbackTrials(ctx, exp, trialList); result != nil {
+		return *result, err
+	}
+
 	if result, err := r.cleanupTrials(ctx, exp, trialList); result != nil {
 		return *result, err
 	}
 
+	if result, err := r.teardownSharedSetup(ctx, exp, trialList); result != nil {
+		return *result, err
+	}
+
+	// Make sure we get another chance to count trials which finished too recently to be counted
+	if experiment.HasUncountedTrials(exp, trialList.Items) {
+		return ctrl.Result{RequeueAfter: time.Second}, nil
+	}
+
+	// Make sure we get another chance to enforce the maximum duration
+	if d := experiment.RemainingDuration(exp, time.Now()); d > 0 && exp.Status.Reason == "" {
+		return ctrl.Result{RequeueAfter: d}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
 func (r *ExperimentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.apiReader = mgr.GetAPIReader()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("experiment").
 		For(&redskyv1beta1.Experiment{}).
@@ -99,44 +147,215 @@ func (r *ExperimentReconciler) updateStatus(ctx context.Context, exp *redskyv1be
 	}
 
 	// Update the experiment status
+	reason, best := exp.Status.Reason, bestTrialName(exp)
 	dirty = experiment.UpdateStatus(exp, trialList) || dirty
 
 	// Only send an update if something actually changed
 	if dirty {
 		if err := r.Update(ctx, exp); err != nil {
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "experiment")
+		}
+
+		if exp.Status.Reason != "" && exp.Status.Reason != reason {
+			r.Recorder.Eventf(exp, corev1.EventTypeNormal, "Stopped", "Stopping criteria met: %s", exp.Status.Reason)
+		}
+		if name := bestTrialName(exp); name != "" && name != best {
+			r.Recorder.Eventf(exp, corev1.EventTypeNormal, "BestTrial", "Trial %s is the new best trial", name)
 		}
 	}
 	return nil, nil
 }
 
+// bestTrialName returns the name of the best trial recorded on the experiment status
+func bestTrialName(exp *redskyv1beta1.Experiment) string {
+	if exp.Status.BestTrial == nil {
+		return ""
+	}
+	return exp.Status.BestTrial.Name
+}
+
 // updateTrialStatus will update the status of all the experiment trials
 func (r *ExperimentReconciler) updateTrialStatus(ctx context.Context, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
 	for i := range trialList.Items {
 		t := &trialList.Items[i]
 
-		var dirty bool
-
 		// If the trial is not finished, but it has been observed, mark it as complete
+		var completed bool
 		if !trial.IsFinished(t) && trial.CheckCondition(&t.Status, redskyv1beta1.TrialObserved, corev1.ConditionTrue) {
 			now := metav1.Now()
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialComplete, corev1.ConditionTrue, "", "", &now)
-			dirty = true
+			completed = true
 		}
 
 		// Update the trial status
-		dirty = trial.UpdateStatus(t) || dirty
+		dirty := trial.UpdateStatus(t) || completed
 
 		// Only send an update if something actually changed
 		if dirty {
 			if err := r.Update(ctx, t); err != nil {
-				return controller.RequeueConflict(err)
+				return controller.RequeueConflict(err, "experiment")
+			}
+			if completed {
+				r.Recorder.Event(t, corev1.EventTypeNormal, "Completed", "Trial completed")
+				controller.CountTrialOutcome(t)
 			}
 		}
 	}
 	return nil, nil
 }
 
+// promoteBestTrial will apply the patches using the assignments of the best trial once the experiment is completed
+func (r *ExperimentReconciler) promoteBestTrial(ctx context.Context, exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
+	// Only promote the best trial of completed experiments which have not already promoted it
+	if exp.Spec.Promotion == nil || exp.Status.Phase != experiment.PhaseCompleted || exp.Status.BestTrial == nil ||
+		exp.Status.PromotedTrial == exp.Status.BestTrial.Name || !exp.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+
+	t := promotionTrial(exp, trialList)
+	if err := r.promote(ctx, exp, t); err != nil {
+		r.Recorder.Eventf(exp, corev1.EventTypeWarning, "PromotionFailed", "Failed to promote trial %s: %v", t.Name, err)
+		return &ctrl.Result{}, err
+	}
+
+	exp.Status.PromotedTrial = t.Name
+	err := r.Update(ctx, exp)
+	if err == nil {
+		r.Recorder.Eventf(exp, corev1.EventTypeNormal, "Promoted", "Promoted the configuration of trial %s", t.Name)
+	}
+	return controller.RequeueConflict(err, "experiment")
+}
+
+// promote renders the experiment patches for the supplied trial and either applies them or writes them to a config map
+func (r *ExperimentReconciler) promote(ctx context.Context, exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) error {
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = exp.Namespace
+	cm.Name = exp.Spec.Promotion.ConfigMapName
+	cm.Labels = map[string]string{
+		redskyv1beta1.LabelExperiment: exp.Name,
+		redskyv1beta1.LabelTrial:      t.Name,
+	}
+	cm.Data = make(map[string]string, len(exp.Spec.Patches))
+
+	te, err := template.NewForExperiment(exp)
+	if err != nil {
+		return err
+	}
+	for i := range exp.Spec.Patches {
+		p := &exp.Spec.Patches[i]
+		targets, err := patchTargets(ctx, r.apiReader, t, p)
+		if err != nil {
+			return err
+		}
+
+		for _, target := range targets {
+			ref, data, err := renderTemplate(te, t, p, target)
+			if err != nil {
+				return err
+			}
+
+			// Empty patches and patches to the trial job are ignored
+			if len(data) == 0 || string(data) == "null" || trial.IsTrialJobReference(t, ref) {
+				continue
+			}
+
+			pt, err := patchType(p)
+			if err != nil {
+				return err
+			}
+
+			if cm.Name != "" {
+				key := fmt.Sprintf("%s-%s-%s-%d.json", strings.ToLower(ref.Kind), ref.Namespace, ref.Name, i)
+				cm.Data[key] = string(data)
+				continue
+			}
+
+			// RBAC: We assume that we have "patch" permission from a customer defined role so we do not limit what types we can patch
+			u := &unstructured.Unstructured{}
+			u.SetName(ref.Name)
+			u.SetNamespace(ref.Namespace)
+			u.SetGroupVersionKind(ref.GroupVersionKind())
+			if err := r.Patch(ctx, u, client.RawPatch(pt, data)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cm.Name == "" {
+		return nil
+	}
+
+	// Create or replace the config map (the existing config map is read directly, we do not want to cache every config map)
+	if err := r.Create(ctx, cm); !apierrs.IsAlreadyExists(err) {
+		return err
+	}
+	existing := &corev1.ConfigMap{}
+	if err := r.apiReader.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, existing); err != nil {
+		return err
+	}
+	if existing.Labels[redskyv1beta1.LabelExperiment] != exp.Name {
+		return fmt.Errorf("refusing to overwrite config map %s, it was not created by the experiment", cm.Name)
+	}
+	cm.ResourceVersion = existing.ResourceVersion
+	return r.Update(ctx, cm)
+}
+
+// promotionTrial returns the trial used to render the promoted patches, the trial may have already been deleted so
+// it is reconstructed from the experiment status if necessary
+func promotionTrial(exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) *redskyv1beta1.Trial {
+	best := exp.Status.BestTrial
+	t := &redskyv1beta1.Trial{}
+	for i := range trialList.Items {
+		if trialList.Items[i].Name == best.Name && trialList.Items[i].Namespace == best.Namespace {
+			trialList.Items[i].DeepCopyInto(t)
+			break
+		}
+	}
+	if t.Name == "" {
+		t.Name = best.Name
+		t.Labels = map[string]string{redskyv1beta1.LabelExperiment: exp.Name}
+		t.Spec.Assignments = best.Assignments
+		t.Spec.Values = best.Values
+	}
+
+	// Patches which do not specify a namespace are applied to the target namespace
+	t.Namespace = exp.Namespace
+	if exp.Spec.Promotion.TargetNamespace != "" {
+		t.Namespace = exp.Spec.Promotion.TargetNamespace
+	}
+	return t
+}
+
+// rollbackTrials will restore the objects patched by the trials of a deleted experiment before the trials are deleted
+func (r *ExperimentReconciler) rollbackTrials(ctx context.Context, exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
+	// Only roll back trials when the experiment is deleted
+	if exp.GetDeletionTimestamp().IsZero() || exp.Spec.PatchRollback != redskyv1beta1.PatchRollbackOnDelete {
+		return nil, nil
+	}
+
+	// Roll back the most recent trials first so the objects end up in the state from before the earliest trial
+	trials := make([]*redskyv1beta1.Trial, 0, len(trialList.Items))
+	for i := range trialList.Items {
+		trials = append(trials, &trialList.Items[i])
+	}
+	sort.SliceStable(trials, func(i, j int) bool {
+		return trials[j].CreationTimestamp.Before(&trials[i].CreationTimestamp)
+	})
+
+	for _, t := range trials {
+		if ok, err := rollbackPatch(ctx, r, t); err != nil {
+			return &ctrl.Result{}, err
+		} else if ok {
+			err := r.Update(ctx, t)
+			if err == nil && !trial.NeedsRollback(t) {
+				r.Recorder.Eventf(exp, corev1.EventTypeNormal, "RolledBack", "Restored the objects patched by trial %s", t.Name)
+			}
+			return controller.RequeueConflict(err, "experiment")
+		}
+	}
+	return nil, nil
+}
+
 // cleanupTrials will delete any trials whose TTL has expired or are active past
 func (r *ExperimentReconciler) cleanupTrials(ctx context.Context, exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
 	for i := range trialList.Items {
@@ -153,11 +372,125 @@ func (r *ExperimentReconciler) cleanupTrials(ctx context.Context, exp *redskyv1b
 			if err := r.Delete(ctx, t); err != nil {
 				return &ctrl.Result{}, err
 			}
+			r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialDeleted", "Deleted trial %s", t.Name)
 		}
 	}
 	return nil, nil
 }
 
+// teardownSharedSetup will delete the setup shared by trials in the same namespace once the experiment is finished
+func (r *ExperimentReconciler) teardownSharedSetup(ctx context.Context, exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
+	if exp.Spec.TrialTemplate.Spec.SetupLifecycle != redskyv1beta1.SetupLifecycleNamespace && !meta.HasFinalizer(exp, setup.StateFinalizer) {
+		return nil, nil
+	}
+
+	// Until the experiment is finished, just make sure it cannot be deleted before the setup is
+	if exp.GetDeletionTimestamp().IsZero() && !experiment.CheckCondition(&exp.Status, redskyv1beta1.ExperimentComplete, corev1.ConditionTrue) {
+		if meta.AddFinalizer(exp, setup.StateFinalizer) {
+			err := r.Update(ctx, exp)
+			return controller.RequeueConflict(err, "experiment")
+		}
+		return nil, nil
+	}
+
+	// Setup cannot be deleted while a trial is still using it
+	activeNamespaces := make(map[string]bool, len(trialList.Items))
+	for i := range trialList.Items {
+		if trial.IsActive(&trialList.Items[i]) {
+			activeNamespaces[trialList.Items[i].Namespace] = true
+		}
+	}
+
+	// The setup state is listed directly from the API server, we do not want to cache every config map
+	stateList := &corev1.ConfigMapList{}
+	if err := r.apiReader.List(ctx, stateList, client.MatchingLabels{
+		redskyv1beta1.LabelExperiment: exp.Name,
+		redskyv1beta1.LabelTrialRole:  "trialSetup",
+	}); err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	var pending bool
+	for i := range stateList.Items {
+		state := &stateList.Items[i]
+		if activeNamespaces[state.Namespace] {
+			pending = true
+			continue
+		}
+
+		deleted, err := r.deleteSharedSetup(ctx, exp, state)
+		if err != nil {
+			return &ctrl.Result{}, err
+		}
+		pending = pending || !deleted
+	}
+
+	// Check back on the delete jobs, they do not trigger a reconcile of the experiment
+	if pending {
+		return &ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	if meta.RemoveFinalizer(exp, setup.StateFinalizer) {
+		err := r.Update(ctx, exp)
+		return controller.RequeueConflict(err, "experiment")
+	}
+	return nil, nil
+}
+
+// deleteSharedSetup runs the delete job for the supplied setup state, returning true once the state is removed
+func (r *ExperimentReconciler) deleteSharedSetup(ctx context.Context, exp *redskyv1beta1.Experiment, state *corev1.ConfigMap) (bool, error) {
+	job, err := setup.NewTeardownJob(exp, state)
+	if err != nil {
+		return false, err
+	}
+
+	// Create the delete job the first time through, it is owned by the state so it is cleaned up along with it
+	var failureMessage string
+	existing := &batchv1.Job{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: job.Namespace, Name: job.Name}, existing); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return false, err
+		}
+
+		if len(job.Spec.Template.Spec.Containers) > 0 {
+			if err := controllerutil.SetControllerReference(state, job, r.Scheme); err != nil {
+				return false, err
+			}
+			err := r.Create(ctx, job)
+			if err == nil {
+				r.Recorder.Eventf(exp, corev1.EventTypeNormal, "SetupJobCreated", "Created setup delete job %s/%s", job.Namespace, job.Name)
+				return false, nil
+			}
+
+			// Forbidden indicates that the namespace was probably deleted
+			if !apierrs.IsForbidden(err) {
+				return false, controller.IgnoreAlreadyExists(err)
+			}
+		}
+	} else {
+		// Determine if the job is finished (i.e. completed or failed)
+		var status corev1.ConditionStatus
+		status, failureMessage = setup.GetConditionStatus(existing)
+		if status == corev1.ConditionFalse {
+			status, failureMessage = inspectSetupJobPods(ctx, r, existing)
+		}
+		if status != corev1.ConditionTrue {
+			return false, nil
+		}
+	}
+
+	// A failed delete job is not retried, the state is still removed so it does not block the experiment deletion
+	if err := r.Delete(ctx, state); err != nil && !apierrs.IsNotFound(err) {
+		return false, err
+	}
+	if failureMessage != "" {
+		r.Recorder.Eventf(exp, corev1.EventTypeWarning, "SetupDeleteFailed", "Failed to delete setup shared by trials in namespace %s: %s", state.Namespace, failureMessage)
+		return true, nil
+	}
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "SetupDeleted", "Deleted setup shared by trials in namespace %s", state.Namespace)
+	return true, nil
+}
+
 // listTrials retrieves the list of trial objects matching the specified selector
 func (r *ExperimentReconciler) listTrials(ctx context.Context, trialList *redskyv1beta1.TrialList, selector *metav1.LabelSelector) error {
 	matchingSelector, err := meta.MatchingSelector(selector)
@@ -0,0 +1,267 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestPromotionTrial(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec:       redskyv1beta1.ExperimentSpec{Promotion: &redskyv1beta1.PromotionSpec{}},
+		Status: redskyv1beta1.ExperimentStatus{
+			BestTrial: &redskyv1beta1.BestTrialStatus{
+				Name:        "test-002",
+				Namespace:   "trials",
+				Assignments: []redskyv1beta1.Assignment{{Name: "replicas", Value: redskyv1beta1.FromInt64(3)}},
+				Values:      []redskyv1beta1.Value{{Name: "cost", Value: "1.5"}},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc              string
+		targetNamespace   string
+		trials            []redskyv1beta1.Trial
+		expectedNamespace string
+		expectedLabels    map[string]string
+	}{
+		{
+			desc:              "deleted trial",
+			expectedNamespace: "default",
+			expectedLabels:    map[string]string{redskyv1beta1.LabelExperiment: "test"},
+		},
+		{
+			desc:            "existing trial",
+			targetNamespace: "production",
+			trials: []redskyv1beta1.Trial{
+				{ObjectMeta: metav1.ObjectMeta{Name: "test-002", Namespace: "other"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-002", Namespace: "trials", Labels: map[string]string{"app": "test"}},
+					Spec: redskyv1beta1.TrialSpec{
+						Assignments: []redskyv1beta1.Assignment{{Name: "replicas", Value: redskyv1beta1.FromInt64(3)}},
+						Values:      []redskyv1beta1.Value{{Name: "cost", Value: "1.5"}},
+					},
+				},
+			},
+			expectedNamespace: "production",
+			expectedLabels:    map[string]string{"app": "test"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			e := exp.DeepCopy()
+			e.Spec.Promotion.TargetNamespace = c.targetNamespace
+
+			pt := promotionTrial(e, &redskyv1beta1.TrialList{Items: c.trials})
+			assert.Equal(t, "test-002", pt.Name)
+			assert.Equal(t, c.expectedNamespace, pt.Namespace)
+			assert.Equal(t, c.expectedLabels, pt.Labels)
+			assert.Equal(t, e.Status.BestTrial.Assignments, pt.Spec.Assignments)
+			assert.Equal(t, e.Status.BestTrial.Values, pt.Spec.Values)
+		})
+	}
+}
+
+func TestExperimentReconciler_Promote(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: redskyv1beta1.ExperimentSpec{
+			Patches: []redskyv1beta1.PatchTemplate{
+				{
+					TargetRef: &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"},
+					Patch:     `{"spec":{"replicas":{{ .Values.replicas }}}}`,
+				},
+				{
+					TargetRef: &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Namespace: "other"},
+					Patch:     `{"metadata":{"labels":{"replicas":"{{ .Values.replicas }}"}}}`,
+				},
+			},
+			Promotion: &redskyv1beta1.PromotionSpec{ConfigMapName: "promoted"},
+		},
+		Status: redskyv1beta1.ExperimentStatus{
+			BestTrial: &redskyv1beta1.BestTrialStatus{
+				Name:        "test-002",
+				Assignments: []redskyv1beta1.Assignment{{Name: "replicas", Value: redskyv1beta1.FromInt64(3)}},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		existing *corev1.ConfigMap
+		err      bool
+	}{
+		{
+			desc: "create",
+		},
+		{
+			desc: "replace",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "promoted", Namespace: "default", Labels: map[string]string{redskyv1beta1.LabelExperiment: "test"}},
+				Data:       map[string]string{"deployment-app-0.json": "{}"},
+			},
+		},
+		{
+			desc: "unlabeled",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "promoted", Namespace: "default"},
+				Data:       map[string]string{"config.yaml": "important"},
+			},
+			err: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var objs []runtime.Object
+			if c.existing != nil {
+				objs = append(objs, c.existing)
+			}
+			cl := fake.NewFakeClientWithScheme(scheme, objs...)
+			r := &ExperimentReconciler{Client: cl, apiReader: cl}
+
+			err := r.promote(context.TODO(), exp, promotionTrial(exp, &redskyv1beta1.TrialList{}))
+
+			cm := &corev1.ConfigMap{}
+			if !assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "promoted"}, cm)) {
+				return
+			}
+			if c.err {
+				assert.Error(t, err)
+				assert.Equal(t, c.existing.Data, cm.Data)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, map[string]string{
+					"deployment-default-app-0.json": `{"spec":{"replicas":3}}`,
+					"deployment-other-app-1.json":   `{"metadata":{"labels":{"replicas":"3"}}}`,
+				}, cm.Data)
+				assert.Equal(t, "test", cm.Labels[redskyv1beta1.LabelExperiment])
+				assert.Equal(t, "test-002", cm.Labels[redskyv1beta1.LabelTrial])
+			}
+		})
+	}
+}
+
+func TestExperimentReconciler_TeardownFailed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	now := metav1.Now()
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Finalizers: []string{setup.StateFinalizer}},
+		Spec: redskyv1beta1.ExperimentSpec{
+			TrialTemplate: redskyv1beta1.TrialTemplateSpec{
+				Spec: redskyv1beta1.TrialSpec{
+					SetupLifecycle: redskyv1beta1.SetupLifecycleNamespace,
+					SetupTasks:     []redskyv1beta1.SetupTask{{Name: "app"}},
+				},
+			},
+		},
+		Status: redskyv1beta1.ExperimentStatus{
+			Conditions: []redskyv1beta1.ExperimentCondition{
+				{Type: redskyv1beta1.ExperimentComplete, Status: corev1.ConditionTrue, LastTransitionTime: now},
+			},
+		},
+	}
+	state := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "redsky-setup-test",
+			Namespace: "default",
+			Labels:    map[string]string{redskyv1beta1.LabelExperiment: "test", redskyv1beta1.LabelTrialRole: "trialSetup"},
+		},
+		Data: map[string]string{setup.StateTrial: "test-001"},
+	}
+	podLabels := map[string]string{"job-name": "redsky-setup-test-delete"}
+
+	cases := []struct {
+		desc      string
+		jobStatus batchv1.JobStatus
+		pod       *corev1.Pod
+	}{
+		{
+			desc: "job failed",
+			jobStatus: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"}},
+			},
+		},
+		{
+			desc: "container failed",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "redsky-setup-test-delete-abc", Namespace: "default", Labels: podLabels},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "app", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			ctx := context.TODO()
+			exp := exp.DeepCopy()
+			state := state.DeepCopy()
+
+			job, err := setup.NewTeardownJob(exp, state)
+			require.NoError(t, err)
+			job.Spec.Selector = &metav1.LabelSelector{MatchLabels: podLabels}
+			job.Status = c.jobStatus
+
+			objs := []runtime.Object{exp, state, job}
+			if c.pod != nil {
+				objs = append(objs, c.pod)
+			}
+			cl := fake.NewFakeClientWithScheme(scheme, objs...)
+			recorder := record.NewFakeRecorder(10)
+			r := &ExperimentReconciler{Client: cl, Log: log.NullLogger{}, Scheme: scheme, Recorder: recorder, apiReader: cl}
+
+			_, err = r.teardownSharedSetup(ctx, exp, &redskyv1beta1.TrialList{})
+			require.NoError(t, err)
+
+			// The failed delete job is terminal: the state is removed along with the finalizer
+			assert.True(t, apierrs.IsNotFound(cl.Get(ctx, types.NamespacedName{Namespace: state.Namespace, Name: state.Name}, &corev1.ConfigMap{})))
+			require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}, exp))
+			assert.NotContains(t, exp.Finalizers, setup.StateFinalizer)
+			if assert.Len(t, recorder.Events, 1) {
+				assert.Contains(t, <-recorder.Events, "Warning SetupDeleteFailed")
+			}
+		})
+	}
+}
@@ -26,10 +26,14 @@ import (
 	"github.com/redskyops/redskyops-controller/internal/controller"
 	"github.com/redskyops/redskyops-controller/internal/meta"
 	"github.com/redskyops/redskyops-controller/internal/metric"
+	"github.com/redskyops/redskyops-controller/internal/template"
 	"github.com/redskyops/redskyops-controller/internal/trial"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -37,14 +41,22 @@ import (
 // MetricReconciler reconciles the metrics on a Trial object
 type MetricReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Template lookups (e.g. price tables) read directly from the API server to avoid caching every config map
+	apiReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=batch;extensions,resources=jobs,verbs=list
 // +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=services,verbs=list
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *MetricReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -67,6 +79,7 @@ func (r *MetricReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 }
 
 func (r *MetricReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.apiReader = mgr.GetAPIReader()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("metric").
 		For(&redskyv1beta1.Trial{}).
@@ -130,7 +143,7 @@ func (r *MetricReconciler) evaluateMetrics(ctx context.Context, t *redskyv1beta1
 	if len(t.Spec.Values) > 0 {
 		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialObserved, corev1.ConditionUnknown, "", "", probeTime)
 		err := r.Update(ctx, t)
-		return controller.RequeueConflict(err)
+		return controller.RequeueConflict(err, "metric")
 	}
 
 	return nil, nil
@@ -147,6 +160,17 @@ func (r *MetricReconciler) collectMetrics(ctx context.Context, t *redskyv1beta1.
 		metrics[exp.Spec.Metrics[i].Name] = &exp.Spec.Metrics[i]
 	}
 
+	// Metric queries are rendered with access to the experiment and the trial run job
+	te, err := template.NewForExperiment(exp)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	job, jobPods, err := r.trialJob(ctx, t)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	te = te.ForTrialJob(job, jobPods).WithLookup(ctx, r.apiReader, exp.Namespace, t.Namespace)
+
 	// Iterate over the metric values, looking for remaining attempts
 	log := r.Log.WithValues("trial", fmt.Sprintf("%s/%s", t.Namespace, t.Name))
 	for i := range t.Spec.Values {
@@ -157,11 +181,12 @@ func (r *MetricReconciler) collectMetrics(ctx context.Context, t *redskyv1beta1.
 
 		// Capture the metric
 		var captureError error
-		if target, err := r.target(ctx, t.Namespace, metrics[v.Name]); err != nil {
+		if target, err := collector.Target(ctx, r, t.Namespace, metrics[v.Name]); err != nil {
 			captureError = err
-		} else if value, stddev, err := metric.CaptureMetric(metrics[v.Name], t, target); err != nil {
+		} else if value, stddev, err := metric.Capture(te, metrics[v.Name], t, target); err != nil {
 			if merr, ok := err.(*metric.CaptureError); ok && merr.RetryAfter > 0 {
 				// Do not count retries against the remaining attempts
+				controller.CountMetricCapture(metrics[v.Name].Type, "retry")
 				return &ctrl.Result{RequeueAfter: merr.RetryAfter}, nil
 			}
 			captureError = err
@@ -173,11 +198,23 @@ func (r *MetricReconciler) collectMetrics(ctx context.Context, t *redskyv1beta1.
 			}
 		}
 
+		if m, ok := metrics[v.Name]; ok {
+			if captureError != nil {
+				controller.CountMetricCapture(m.Type, "failure")
+			} else {
+				controller.CountMetricCapture(m.Type, "success")
+			}
+		}
+
 		// Handle any errors the occurred while collecting the value
 		if captureError != nil && v.AttemptsRemaining > 0 {
 			v.AttemptsRemaining = v.AttemptsRemaining - 1
 			if v.AttemptsRemaining == 0 {
-				trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, "MetricFailed", captureError.Error(), probeTime)
+				reason := "MetricFailed"
+				if metric.IsUnreachable(captureError) {
+					reason = trial.ReasonMetricUnreachable
+				}
+				trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, reason, captureError.Error(), probeTime)
 				if merr, ok := captureError.(*metric.CaptureError); ok {
 					// Metric errors contain additional information which should be logged for debugging
 					log.Error(merr, "Metric collection failed", "address", merr.Address, "query", merr.Query, "completionTime", merr.CompletionTime)
@@ -188,37 +225,58 @@ func (r *MetricReconciler) collectMetrics(ctx context.Context, t *redskyv1beta1.
 		// We have started collecting metrics (success or fail), transition into a false status
 		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialObserved, corev1.ConditionFalse, "", "", probeTime)
 		err := r.Update(ctx, t)
-		return controller.RequeueConflict(err)
+		if err == nil {
+			if captureError == nil {
+				r.Recorder.Eventf(t, corev1.EventTypeNormal, "MetricCaptured", "Captured value %s for metric %s", v.Value, v.Name)
+			} else if v.AttemptsRemaining == 0 {
+				recordFailure(r.Recorder, t)
+				controller.CountTrialOutcome(t)
+			}
+		}
+		return controller.RequeueConflict(err, "metric")
 	}
 
-	// We made it through all of the metrics without needing additional changes
+	// We made it through all of the metrics, trials which do not satisfy the metric bounds are infeasible
+	if err := metric.CheckBounds(exp.Spec.Metrics, t.Spec.Values); err != nil {
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, trial.ReasonInfeasible, err.Error(), probeTime)
+	}
 	trial.ApplyCondition(&t.Status, redskyv1beta1.TrialObserved, corev1.ConditionTrue, "", "", probeTime)
-	err := r.Update(ctx, t)
-	return controller.RequeueConflict(err)
+	err = r.Update(ctx, t)
+	if err == nil {
+		controller.ObserveTrialPhase(t, controller.TrialPhaseMetrics)
+		recordFailure(r.Recorder, t)
+		controller.CountTrialOutcome(t)
+	}
+	return controller.RequeueConflict(err, "metric")
 }
 
-func (r *MetricReconciler) target(ctx context.Context, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error) {
-	switch m.Type {
-	case redskyv1beta1.MetricPods:
-		// Use the selector to get a list of pods
-		target := &corev1.PodList{}
-		if sel, err := meta.MatchingSelector(m.Selector); err != nil {
-			return nil, err
-		} else if err := r.List(ctx, target, client.InNamespace(namespace), sel); err != nil {
-			return nil, err
+// trialJob returns the trial run job and it's pods, either value may be nil if it cannot be found
+func (r *MetricReconciler) trialJob(ctx context.Context, t *redskyv1beta1.Trial) (*batchv1.Job, *corev1.PodList, error) {
+	matchingSelector, err := meta.MatchingSelector(t.GetJobSelector())
+	if err != nil {
+		return nil, nil, err
+	}
+	jobList := &batchv1.JobList{}
+	if err := r.List(ctx, jobList, client.InNamespace(t.Namespace), matchingSelector); err != nil {
+		return nil, nil, err
+	}
+
+	// Setup jobs always have "role=trialSetup" so ignore jobs with that label
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if job.Labels[redskyv1beta1.LabelTrialRole] == "trialSetup" {
+			continue
 		}
-		return target, nil
-	case redskyv1beta1.MetricPrometheus, redskyv1beta1.MetricJSONPath:
-		// Both Prometheus and JSONPath target a service
-		target := &corev1.ServiceList{}
-		if sel, err := meta.MatchingSelector(m.Selector); err != nil {
-			return nil, err
-		} else if err := r.List(ctx, target, client.InNamespace(namespace), sel); err != nil {
-			return nil, err
+
+		podSelector, err := meta.MatchingSelector(job.Spec.Selector)
+		if err != nil {
+			return job, nil, nil
 		}
-		return target, nil
-	default:
-		// Assume no target is necessary
-		return nil, nil
+		podList := &corev1.PodList{}
+		if err := r.List(ctx, podList, client.InNamespace(job.Namespace), podSelector); err != nil {
+			return nil, nil, err
+		}
+		return job, podList, nil
 	}
+	return nil, nil, nil
 }
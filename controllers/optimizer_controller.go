@@ -0,0 +1,221 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/controller"
+	"github.com/redskyops/redskyops-controller/internal/experiment"
+	"github.com/redskyops/redskyops-controller/internal/meta"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OptimizerReconciler reconciles experiment and trial objects using the built-in local optimizer
+type OptimizerReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	trialCreation *rate.Limiter
+}
+
+// +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=list;watch;create;update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=list
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *OptimizerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("experiment", req.NamespacedName)
+
+	// Fetch the experiment state from the cluster
+	exp := &redskyv1beta1.Experiment{}
+	if err := r.Get(ctx, req.NamespacedName, exp); err != nil {
+		return ctrl.Result{}, controller.IgnoreNotFound(err)
+	}
+
+	// Only experiments configured for local optimization are considered
+	if !optimizer.IsLocal(exp) {
+		return ctrl.Result{}, nil
+	}
+
+	// Get the current list of trials
+	trialList := &redskyv1beta1.TrialList{}
+	if err := r.listTrials(ctx, trialList, exp.TrialSelector()); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Look for active trials and finished trials which may need to be retried
+	var activeTrials int32
+	for i := range trialList.Items {
+		t := &trialList.Items[i]
+		tlog := log.WithValues("trial", t.Namespace+"/"+t.Name)
+
+		// Count active trials
+		if trial.IsActive(t) {
+			activeTrials++
+		}
+
+		// Trials that have the optimizer finalizer may need to be retried
+		if meta.HasFinalizer(t, optimizer.Finalizer) {
+			if trial.NeedsRetry(t) && exp.DeletionTimestamp.IsZero() {
+				// Wait for the failed trial to finish tearing down (e.g. rolling back patches) before retrying
+				if trial.IsActive(t) {
+					continue
+				}
+				if result, err := r.retryTrial(ctx, tlog, exp, t); result != nil {
+					return *result, err
+				}
+				activeTrials++
+			} else if trial.IsFinished(t) || !t.DeletionTimestamp.IsZero() {
+				if result, err := r.releaseTrial(ctx, t); result != nil {
+					return *result, err
+				}
+			}
+		}
+	}
+
+	// Create a new trial if necessary
+	if activeTrials < exp.Replicas() && exp.DeletionTimestamp.IsZero() {
+		if result, err := r.nextTrial(ctx, log, exp, trialList); result != nil {
+			return *result, err
+		}
+	}
+
+	// Nothing to do
+	return ctrl.Result{}, nil
+}
+
+func (r *OptimizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Enforce a one trial per-second creation limit (no burst! that is the whole point)
+	r.trialCreation = rate.NewLimiter(1, 1)
+
+	// To search for namespaces by name, we need to index them (this may have already been done by the server reconciler)
+	_ = mgr.GetCache().IndexField(&corev1.Namespace{}, "metadata.name", func(obj runtime.Object) []string { return []string{obj.(*corev1.Namespace).Name} })
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("optimizer").
+		For(&redskyv1beta1.Experiment{}).
+		WithEventFilter(&createFilter{}).
+		Complete(r)
+}
+
+// listTrials retrieves the list of trial objects matching the specified selector
+func (r *OptimizerReconciler) listTrials(ctx context.Context, trialList *redskyv1beta1.TrialList, selector *metav1.LabelSelector) error {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return err
+	}
+	return r.List(ctx, trialList, client.MatchingLabelsSelector{Selector: s})
+}
+
+// retryTrial will re-run the assignments of a trial which failed for a transient reason in a new trial
+func (r *OptimizerReconciler) retryTrial(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+	retry, err := createRetry(ctx, r, t, optimizer.Finalizer)
+	if err != nil {
+		return &ctrl.Result{}, err
+	} else if retry == nil {
+		return nil, nil
+	}
+
+	// Update the original trial
+	if err := r.Update(ctx, t); err != nil {
+		return controller.RequeueConflict(err, "optimizer")
+	}
+
+	log.Info("Retrying trial", "retry", retry.Name, "retries", trial.Retries(retry))
+	r.Recorder.Eventf(t, corev1.EventTypeNormal, "Retried", "Retrying assignments in trial %s", retry.Name)
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialRetried", "Retrying assignments of trial %s in trial %s", t.Name, retry.Name)
+	return nil, nil
+}
+
+// releaseTrial will remove the finalizer from a trial which does not need to be retried
+func (r *OptimizerReconciler) releaseTrial(ctx context.Context, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+	if !meta.RemoveFinalizer(t, optimizer.Finalizer) {
+		return nil, nil
+	}
+
+	err := r.Update(ctx, t)
+	return controller.RequeueConflict(err, "optimizer")
+}
+
+// nextTrial will obtain a suggestion from the local optimizer and create the corresponding trial; if the cluster can
+// not accommodate additional trials or the optimizer is exhausted, no action will be taken
+func (r *OptimizerReconciler) nextTrial(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) (*ctrl.Result, error) {
+	// Enforce a rate limit on trial creation
+	if res := r.trialCreation.Reserve(); res.OK() {
+		if d := res.Delay(); d > 0 {
+			res.Cancel()
+			return &ctrl.Result{RequeueAfter: d}, nil
+		}
+	}
+
+	// Determine the namespace (if any) to use for the trial
+	namespace, err := experiment.NextTrialNamespace(ctx, r, exp, trialList)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	if namespace == "" {
+		return nil, nil
+	}
+
+	// Obtain a suggestion from the local optimizer
+	assignments, err := optimizer.Suggest(exp)
+	if err == optimizer.ErrExhausted {
+		return nil, nil
+	} else if err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	// Generate a new trial from the template on the experiment and apply the suggestion
+	t := &redskyv1beta1.Trial{}
+	experiment.PopulateTrialFromTemplate(exp, t)
+	t.Namespace = namespace
+	t.Spec.Assignments = assignments
+	experiment.ApplyBaselineLabel(exp, t)
+	trial.UpdateStatus(t)
+
+	// Failed trials can only be retried if we are notified when they finish
+	if t.Spec.RetryPolicy != nil {
+		meta.AddFinalizer(t, optimizer.Finalizer)
+	}
+
+	// Record the suggestion before creating the trial, a conflict means the suggestion may have already been used
+	if err := r.Update(ctx, exp); err != nil {
+		return controller.RequeueConflict(err, "optimizer")
+	}
+
+	// Create the trial
+	if err := r.Create(ctx, t); err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	log.Info("Created new trial", "assignments", t.Spec.Assignments)
+	return nil, nil
+}
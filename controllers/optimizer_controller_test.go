@@ -0,0 +1,109 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestOptimizerReconciler_Retry(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	replicas := int32(0)
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: redskyv1beta1.ExperimentSpec{
+			Replicas:     &replicas,
+			Optimization: []redskyv1beta1.Optimization{{Name: optimizer.OptimizationAlgorithm, Value: optimizer.AlgorithmRandom}},
+		},
+	}
+
+	cases := []struct {
+		desc          string
+		reason        string
+		expectedRetry bool
+	}{
+		{
+			desc:          "retryable failure",
+			reason:        "Evicted",
+			expectedRetry: true,
+		},
+		{
+			desc:   "failure",
+			reason: "BackoffLimitExceeded",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			failed := &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-001",
+					Namespace:  "default",
+					Labels:     map[string]string{redskyv1beta1.LabelExperiment: "test"},
+					Finalizers: []string{optimizer.Finalizer},
+				},
+				Spec: redskyv1beta1.TrialSpec{RetryPolicy: &redskyv1beta1.RetryPolicy{}},
+				Status: redskyv1beta1.TrialStatus{
+					Conditions: []redskyv1beta1.TrialCondition{{Type: redskyv1beta1.TrialFailed, Status: corev1.ConditionTrue, Reason: c.reason}},
+				},
+			}
+
+			r := &OptimizerReconciler{
+				Client:   fake.NewFakeClientWithScheme(scheme, exp.DeepCopy(), failed),
+				Log:      log.NullLogger{},
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test"}})
+			assert.NoError(t, err)
+
+			// The finalizer is always removed from the failed trial
+			ctx := context.Background()
+			actual := &redskyv1beta1.Trial{}
+			if assert.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-001"}, actual)) {
+				assert.Empty(t, actual.Finalizers)
+			}
+
+			// Only retryable failures are re-run, the retry takes over the finalizer
+			retry := &redskyv1beta1.Trial{}
+			err = r.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-001-retry-1"}, retry)
+			if c.expectedRetry && assert.NoError(t, err) {
+				assert.Equal(t, int32(1), trial.Retries(retry))
+				assert.Equal(t, []string{optimizer.Finalizer}, retry.Finalizers)
+			} else if !c.expectedRetry {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
@@ -29,23 +30,35 @@ import (
 	"github.com/redskyops/redskyops-controller/internal/trial"
 	"github.com/redskyops/redskyops-controller/internal/validation"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// patchWaiting is the reason used when a trial cannot be patched until another trial releases a shared patch target
+const patchWaiting = "Waiting"
+
 // PatchReconciler reconciles the patches on a Trial object
 type PatchReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	mapper meta.RESTMapper
+
+	// Patch targets matched by a selector are listed directly from the API server to avoid caching arbitrary types
+	apiReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile inspects a trial to see if patches need to be applied. The "trial patched" status condition
 // is used to control what actions need to be taken. If the status is "unknown" then the experiment is fetched
@@ -57,10 +70,18 @@ func (r *PatchReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	now := metav1.Now()
 
 	t := &redskyv1beta1.Trial{}
-	if err := r.Get(ctx, req.NamespacedName, t); err != nil || r.ignoreTrial(t) {
+	if err := r.Get(ctx, req.NamespacedName, t); err != nil {
 		return ctrl.Result{}, controller.IgnoreNotFound(err)
 	}
 
+	if result, err := r.rollbackPatches(ctx, t, &now); result != nil {
+		return *result, err
+	}
+
+	if r.ignoreTrial(t) {
+		return ctrl.Result{}, nil
+	}
+
 	if result, err := r.evaluatePatchOperations(ctx, t, &now); result != nil {
 		return *result, err
 	}
@@ -74,6 +95,10 @@ func (r *PatchReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 
 // SetupWithManager registers a new patch reconciler with the supplied manager
 func (r *PatchReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The REST mapper is used to determine which patch targets are cluster scoped
+	r.mapper = mgr.GetRESTMapper()
+	r.apiReader = mgr.GetAPIReader()
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("patch").
 		For(&redskyv1beta1.Trial{}).
@@ -135,28 +160,40 @@ func (r *PatchReconciler) evaluatePatchOperations(ctx context.Context, t *redsky
 	t.Status.ReadinessChecks = nil
 
 	// Evaluate the patches
-	te := template.New()
+	te, err := template.NewForExperiment(exp)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
 	for i := range exp.Spec.Patches {
 		p := &exp.Spec.Patches[i]
 
-		// Render the patch template
-		ref, data, err := r.renderTemplate(te, t, p)
+		// Find the objects matched by the selector
+		targets, err := patchTargets(ctx, r.apiReader, t, p)
 		if err != nil {
 			return &ctrl.Result{}, err
 		}
 
-		// Add a patch operation if necessary
-		if po, err := r.createPatchOperation(t, p, ref, data); err != nil {
-			return &ctrl.Result{}, err
-		} else if po != nil {
-			t.Status.PatchOperations = append(t.Status.PatchOperations, *po)
-		}
+		for _, target := range targets {
+			// Render the patch template
+			ref, data, err := renderTemplate(te, t, p, target)
+			if err != nil {
+				return &ctrl.Result{}, err
+			}
+			r.clearClusterScopedNamespace(ref)
 
-		// Add a readiness check if necessary
-		if rc, err := r.createReadinessCheck(t, p, ref); err != nil {
-			return &ctrl.Result{}, err
-		} else if rc != nil {
-			t.Status.ReadinessChecks = append(t.Status.ReadinessChecks, *rc)
+			// Add a patch operation if necessary
+			if po, err := r.createPatchOperation(t, p, ref, data); err != nil {
+				return &ctrl.Result{}, err
+			} else if po != nil {
+				t.Status.PatchOperations = append(t.Status.PatchOperations, *po)
+			}
+
+			// Add a readiness check if necessary
+			if rc, err := r.createReadinessCheck(t, p, ref); err != nil {
+				return &ctrl.Result{}, err
+			} else if rc != nil {
+				t.Status.ReadinessChecks = append(t.Status.ReadinessChecks, *rc)
+			}
 		}
 	}
 
@@ -165,8 +202,8 @@ func (r *PatchReconciler) evaluatePatchOperations(ctx context.Context, t *redsky
 
 	// Update the status to indicate that patches are evaluated
 	trial.ApplyCondition(&t.Status, redskyv1beta1.TrialPatched, corev1.ConditionFalse, "", "", probeTime)
-	err := r.Update(ctx, t)
-	return controller.RequeueConflict(err)
+	err = r.Update(ctx, t)
+	return controller.RequeueConflict(err, "patch")
 }
 
 // applyPatches will actually patch the objects from the patch operations
@@ -176,6 +213,33 @@ func (r *PatchReconciler) applyPatches(ctx context.Context, t *redskyv1beta1.Tri
 		return nil, nil
 	}
 
+	// Get the experiment to determine if the original state of the patched objects must be recorded
+	exp := &redskyv1beta1.Experiment{}
+	if err := r.Get(ctx, t.ExperimentNamespacedName(), exp); err != nil {
+		return &ctrl.Result{}, err
+	}
+	rollback := exp.Spec.PatchRollback == redskyv1beta1.PatchRollbackAfterTrial || exp.Spec.PatchRollback == redskyv1beta1.PatchRollbackOnDelete
+
+	// Do not patch shared objects which are still being used by another trial
+	if name, err := r.sharedTargetHolder(ctx, t); err != nil {
+		return &ctrl.Result{}, err
+	} else if name != "" {
+		// Only record the wait (and emit the event) once, subsequent checks just requeue
+		if isWaiting(t) {
+			return &ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		msg := fmt.Sprintf("Waiting for trial %s to release shared patch targets", name)
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialPatched, corev1.ConditionFalse, patchWaiting, msg, probeTime)
+		if err := r.Update(ctx, t); err != nil {
+			return controller.RequeueConflict(err, "patch")
+		}
+		r.Recorder.Event(t, corev1.EventTypeNormal, patchWaiting, msg)
+		return &ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	} else if isWaiting(t) {
+		// The shared patch targets were released, the cleared reason is saved with the first patch attempt
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialPatched, corev1.ConditionFalse, "", "", probeTime)
+	}
+
 	// Iterate over the patches, looking for remaining attempts
 	for i := range t.Status.PatchOperations {
 		p := &t.Status.PatchOperations[i]
@@ -183,44 +247,235 @@ func (r *PatchReconciler) applyPatches(ctx context.Context, t *redskyv1beta1.Tri
 			continue
 		}
 
-		// Construct a patch on an unstructured object
-		// RBAC: We assume that we have "patch" permission from a customer defined role so we do not limit what types we can patch
-		u := &unstructured.Unstructured{}
-		u.SetName(p.TargetRef.Name)
-		u.SetNamespace(p.TargetRef.Namespace)
-		u.SetGroupVersionKind(p.TargetRef.GroupVersionKind())
-		if err := r.Patch(ctx, u, client.RawPatch(p.PatchType, p.Data)); err != nil {
+		var patchErr error
+		if err := r.applyPatch(ctx, p, rollback); err != nil {
 			p.AttemptsRemaining = p.AttemptsRemaining - 1
 			if p.AttemptsRemaining == 0 {
 				// There are no remaining patch attempts remaining, fail the trial
 				trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, "PatchFailed", err.Error(), probeTime)
+				patchErr = err
 			}
 		} else {
 			p.AttemptsRemaining = 0
 		}
 
+		// Patches applied by the trial must be rolled back before the trial is considered inactive
+		if len(p.RollbackData) > 0 && exp.Spec.PatchRollback == redskyv1beta1.PatchRollbackAfterTrial {
+			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialRolledBack, corev1.ConditionUnknown, "", "", probeTime)
+		}
+
 		// Update the patch operation status
 		err := r.Update(ctx, t)
-		return controller.RequeueConflict(err)
+		if err == nil && patchErr != nil {
+			r.Recorder.Eventf(t, corev1.EventTypeWarning, "PatchFailed", "Failed to patch %s %s: %v", p.TargetRef.Kind, p.TargetRef.Name, patchErr)
+			controller.CountTrialOutcome(t)
+		}
+		return controller.RequeueConflict(err, "patch")
 	}
 
 	// We made it through all of the patches without needing additional changes
 	trial.ApplyCondition(&t.Status, redskyv1beta1.TrialPatched, corev1.ConditionTrue, "", "", probeTime)
 	err := r.Update(ctx, t)
-	return controller.RequeueConflict(err)
+	if err == nil {
+		r.Recorder.Eventf(t, corev1.EventTypeNormal, "Patched", "Applied %d patches", len(t.Status.PatchOperations))
+		controller.ObserveTrialPhase(t, controller.TrialPhasePatch)
+	}
+	return controller.RequeueConflict(err, "patch")
+}
+
+// patchTargets returns the current state of the objects the supplied patch template should be rendered against. If
+// the patch template does not use a selector, a single target is returned: either the directly referenced object or
+// nil if the reference is not known until the patch is rendered (or the object does not exist)
+func patchTargets(ctx context.Context, r client.Reader, t *redskyv1beta1.Trial, p *redskyv1beta1.PatchTemplate) ([]*unstructured.Unstructured, error) {
+	if p.Selector == nil && (p.TargetRef == nil || p.TargetRef.Name == "") {
+		return []*unstructured.Unstructured{nil}, nil
+	}
+	if p.TargetRef == nil || p.TargetRef.Kind == "" {
+		return nil, fmt.Errorf("invalid patch reference")
+	}
+
+	// Use the trial namespace unless the reference specifies a namespace (ignored for cluster scoped kinds)
+	ns := p.TargetRef.Namespace
+	if ns == "" {
+		ns = t.Namespace
+	}
+
+	if p.Selector == nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(p.TargetRef.GroupVersionKind())
+		if err := r.Get(ctx, types.NamespacedName{Namespace: ns, Name: p.TargetRef.Name}, u); err != nil {
+			return []*unstructured.Unstructured{nil}, controller.IgnoreNotFound(err)
+		}
+		return []*unstructured.Unstructured{u}, nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(p.Selector)
+	if err != nil {
+		return nil, err
+	}
+	ul := &unstructured.UnstructuredList{}
+	ul.SetGroupVersionKind(p.TargetRef.GroupVersionKind())
+	if err := r.List(ctx, ul, client.InNamespace(ns), client.MatchingLabelsSelector{Selector: s}); err != nil {
+		return nil, err
+	}
+
+	targets := make([]*unstructured.Unstructured, len(ul.Items))
+	for i := range ul.Items {
+		targets[i] = &ul.Items[i]
+	}
+	return targets, nil
+}
+
+// clearClusterScopedNamespace removes the namespace (which defaults to the trial namespace) from references to cluster
+// scoped objects; references to types which cannot be mapped are left unchanged
+func (r *PatchReconciler) clearClusterScopedNamespace(ref *corev1.ObjectReference) {
+	if r.mapper == nil {
+		return
+	}
+	gvk := ref.GroupVersionKind()
+	if m, err := r.mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err == nil && m.Scope.Name() == meta.RESTScopeNameRoot {
+		ref.Namespace = ""
+	}
+}
+
+// sharedTargetHolder returns the name of an active trial which was created first and has patches for any of the
+// shared objects patched by the supplied trial; an empty string is returned if the trial can proceed
+func (r *PatchReconciler) sharedTargetHolder(ctx context.Context, t *redskyv1beta1.Trial) (string, error) {
+	var shared []*corev1.ObjectReference
+	for i := range t.Status.PatchOperations {
+		p := &t.Status.PatchOperations[i]
+		if p.AttemptsRemaining > 0 && trial.IsSharedTarget(t, &p.TargetRef) {
+			shared = append(shared, &p.TargetRef)
+		}
+	}
+	if len(shared) == 0 {
+		return "", nil
+	}
+
+	// Shared objects may be patched by trials from any experiment in any namespace
+	trialList := &redskyv1beta1.TrialList{}
+	if err := r.List(ctx, trialList); err != nil {
+		return "", err
+	}
+
+	for i := range trialList.Items {
+		o := &trialList.Items[i]
+		if o.UID == t.UID || !trial.IsActive(o) || !createdBefore(o, t) {
+			continue
+		}
+
+		// Trials which have not evaluated their patches yet do not hold anything
+		if !trial.CheckCondition(&o.Status, redskyv1beta1.TrialPatched, corev1.ConditionFalse) &&
+			!trial.CheckCondition(&o.Status, redskyv1beta1.TrialPatched, corev1.ConditionTrue) {
+			continue
+		}
+
+		for j := range o.Status.PatchOperations {
+			for _, ref := range shared {
+				if trial.IsSameTarget(&o.Status.PatchOperations[j].TargetRef, ref) {
+					return o.Namespace + "/" + o.Name, nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// isWaiting checks to see if the trial has already recorded that it is waiting for shared patch targets
+func isWaiting(t *redskyv1beta1.Trial) bool {
+	for _, c := range t.Status.Conditions {
+		if c.Type == redskyv1beta1.TrialPatched && c.Reason == patchWaiting {
+			return true
+		}
+	}
+	return false
+}
+
+// createdBefore determines the order in which trials may use shared patch targets
+func createdBefore(t1, t2 *redskyv1beta1.Trial) bool {
+	if t1.CreationTimestamp.Equal(&t2.CreationTimestamp) {
+		return t1.Namespace+"/"+t1.Name < t2.Namespace+"/"+t2.Name
+	}
+	return t1.CreationTimestamp.Before(&t2.CreationTimestamp)
+}
+
+// applyPatch applies a single patch operation, optionally recording the data necessary to roll back the patch
+func (r *PatchReconciler) applyPatch(ctx context.Context, p *redskyv1beta1.PatchOperation, rollback bool) error {
+	// Construct a patch on an unstructured object
+	// RBAC: We assume that we have "patch" permission from a customer defined role so we do not limit what types we can patch
+	u := &unstructured.Unstructured{}
+	u.SetName(p.TargetRef.Name)
+	u.SetNamespace(p.TargetRef.Namespace)
+	u.SetGroupVersionKind(p.TargetRef.GroupVersionKind())
+
+	// Capture the original state of the object
+	// RBAC: Recording the original state additionally requires "get" permission on the patched types
+	var original *unstructured.Unstructured
+	if rollback {
+		original = u.DeepCopy()
+		if err := r.Get(ctx, types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}, original); err != nil {
+			return err
+		}
+	}
+
+	if err := r.Patch(ctx, u, client.RawPatch(p.PatchType, p.Data)); err != nil {
+		return err
+	}
+
+	// The patched object is returned by the server so we can compute the difference
+	if original != nil {
+		data, err := trial.RollbackData(original, u)
+		if err != nil {
+			return err
+		}
+		p.RollbackData = data
+	}
+	return nil
+}
+
+// rollbackPatches will restore the patched objects once a trial is finished
+func (r *PatchReconciler) rollbackPatches(ctx context.Context, t *redskyv1beta1.Trial, probeTime *metav1.Time) (*ctrl.Result, error) {
+	// Only roll back finished trials which have not been rolled back yet
+	if !trial.IsFinished(t) || !trial.CheckCondition(&t.Status, redskyv1beta1.TrialRolledBack, corev1.ConditionUnknown) {
+		return nil, nil
+	}
+
+	// Roll back one patch at a time
+	if ok, err := rollbackPatch(ctx, r, t); err != nil {
+		return &ctrl.Result{}, err
+	} else if ok {
+		err := r.Update(ctx, t)
+		return controller.RequeueConflict(err, "patch")
+	}
+
+	trial.ApplyCondition(&t.Status, redskyv1beta1.TrialRolledBack, corev1.ConditionTrue, "", "", probeTime)
+	err := r.Update(ctx, t)
+	if err == nil {
+		r.Recorder.Event(t, corev1.EventTypeNormal, "RolledBack", "Restored the original state of the patched objects")
+	}
+	return controller.RequeueConflict(err, "patch")
 }
 
 // renderTemplate determines the patch target and renders the patch template
-func (r *PatchReconciler) renderTemplate(te *template.Engine, t *redskyv1beta1.Trial, p *redskyv1beta1.PatchTemplate) (*corev1.ObjectReference, []byte, error) {
+func renderTemplate(te *template.Engine, t *redskyv1beta1.Trial, p *redskyv1beta1.PatchTemplate, target *unstructured.Unstructured) (*corev1.ObjectReference, []byte, error) {
 	// Render the actual patch data
-	data, err := te.RenderPatch(p, t)
+	var obj runtime.Object
+	if target != nil {
+		obj = target
+	}
+	data, err := te.RenderPatch(p, t, obj)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Determine the reference, possibly extracting it from the rendered data
 	ref := &corev1.ObjectReference{}
-	if p.TargetRef != nil {
+	if target != nil {
+		ref.APIVersion = target.GetAPIVersion()
+		ref.Kind = target.GetKind()
+		ref.Name = target.GetName()
+		ref.Namespace = target.GetNamespace()
+	} else if p.TargetRef != nil {
 		p.TargetRef.DeepCopyInto(ref)
 	} else if p.Type == redskyv1beta1.PatchStrategic || p.Type == "" {
 		m := &struct {
@@ -262,16 +517,11 @@ func (r *PatchReconciler) createPatchOperation(t *redskyv1beta1.Trial, p *redsky
 	}
 
 	// Determine the patch type
-	switch p.Type {
-	case redskyv1beta1.PatchStrategic, "":
-		po.PatchType = types.StrategicMergePatchType
-	case redskyv1beta1.PatchMerge:
-		po.PatchType = types.MergePatchType
-	case redskyv1beta1.PatchJSON:
-		po.PatchType = types.JSONPatchType
-	default:
-		return nil, fmt.Errorf("unknown patch type: %s", p.Type)
+	pt, err := patchType(p)
+	if err != nil {
+		return nil, err
 	}
+	po.PatchType = pt
 
 	// If the patch is for the trial job itself, it cannot be applied (since the job won't exist until well after patches are applied)
 	if trial.IsTrialJobReference(t, &po.TargetRef) {
@@ -284,6 +534,20 @@ func (r *PatchReconciler) createPatchOperation(t *redskyv1beta1.Trial, p *redsky
 	return po, nil
 }
 
+// patchType returns the content type of the patch template
+func patchType(p *redskyv1beta1.PatchTemplate) (types.PatchType, error) {
+	switch p.Type {
+	case redskyv1beta1.PatchStrategic, "":
+		return types.StrategicMergePatchType, nil
+	case redskyv1beta1.PatchMerge:
+		return types.MergePatchType, nil
+	case redskyv1beta1.PatchJSON:
+		return types.JSONPatchType, nil
+	default:
+		return "", fmt.Errorf("unknown patch type: %s", p.Type)
+	}
+}
+
 // createReadinessCheck creates a readiness check for a patch operation
 func (r *PatchReconciler) createReadinessCheck(t *redskyv1beta1.Trial, p *redskyv1beta1.PatchTemplate, ref *corev1.ObjectReference) (*redskyv1beta1.ReadinessCheck, error) {
 	// Do not create a readiness check on the trial job
@@ -0,0 +1,267 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestCreatedBefore(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Second))
+
+	cases := []struct {
+		desc     string
+		t1       metav1.ObjectMeta
+		t2       metav1.ObjectMeta
+		expected bool
+	}{
+		{
+			desc:     "earlier",
+			t1:       metav1.ObjectMeta{Name: "b", Namespace: "default", CreationTimestamp: now},
+			t2:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: later},
+			expected: true,
+		},
+		{
+			desc:     "later",
+			t1:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: later},
+			t2:       metav1.ObjectMeta{Name: "b", Namespace: "default", CreationTimestamp: now},
+			expected: false,
+		},
+		{
+			desc:     "same time by name",
+			t1:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: now},
+			t2:       metav1.ObjectMeta{Name: "b", Namespace: "default", CreationTimestamp: now},
+			expected: true,
+		},
+		{
+			desc:     "same time by namespace",
+			t1:       metav1.ObjectMeta{Name: "b", Namespace: "other", CreationTimestamp: now},
+			t2:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: now},
+			expected: false,
+		},
+		{
+			desc:     "same trial",
+			t1:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: now},
+			t2:       metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: now},
+			expected: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.expected, createdBefore(&redskyv1beta1.Trial{ObjectMeta: c.t1}, &redskyv1beta1.Trial{ObjectMeta: c.t2}))
+		})
+	}
+}
+
+func TestPatchReconciler_SharedTargetHolder(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+	later := metav1.NewTime(now.Add(time.Minute))
+	node := corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1"}
+	other := corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-2"}
+	local := corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Namespace: "default"}
+
+	// newTrial returns a trial which has evaluated patches for the supplied targets
+	newTrial := func(name string, created metav1.Time, refs ...corev1.ObjectReference) *redskyv1beta1.Trial {
+		t := &redskyv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name), CreationTimestamp: created}}
+		for _, ref := range refs {
+			t.Status.PatchOperations = append(t.Status.PatchOperations, redskyv1beta1.PatchOperation{TargetRef: ref, AttemptsRemaining: 3})
+		}
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialPatched, corev1.ConditionFalse, "", "", &created)
+		return t
+	}
+
+	finished := newTrial("finished", earlier, node)
+	trial.ApplyCondition(&finished.Status, redskyv1beta1.TrialComplete, corev1.ConditionTrue, "", "", &earlier)
+	unevaluated := newTrial("unevaluated", earlier, node)
+	trial.ApplyCondition(&unevaluated.Status, redskyv1beta1.TrialPatched, corev1.ConditionUnknown, "", "", &earlier)
+	applied := newTrial("applied", now, node)
+	applied.Status.PatchOperations[0].AttemptsRemaining = 0
+
+	cases := []struct {
+		desc     string
+		trial    *redskyv1beta1.Trial
+		others   []*redskyv1beta1.Trial
+		expected string
+	}{
+		{
+			desc:     "not shared",
+			trial:    newTrial("test", now, local),
+			others:   []*redskyv1beta1.Trial{newTrial("holder", earlier, local)},
+			expected: "",
+		},
+		{
+			desc:     "already applied",
+			trial:    applied,
+			others:   []*redskyv1beta1.Trial{newTrial("holder", earlier, node)},
+			expected: "",
+		},
+		{
+			desc:     "held",
+			trial:    newTrial("test", now, local, node),
+			others:   []*redskyv1beta1.Trial{newTrial("holder", earlier, node)},
+			expected: "default/holder",
+		},
+		{
+			desc:     "created later",
+			trial:    newTrial("test", now, node),
+			others:   []*redskyv1beta1.Trial{newTrial("later", later, node)},
+			expected: "",
+		},
+		{
+			desc:     "different target",
+			trial:    newTrial("test", now, node),
+			others:   []*redskyv1beta1.Trial{newTrial("holder", earlier, other)},
+			expected: "",
+		},
+		{
+			desc:     "inactive",
+			trial:    newTrial("test", now, node),
+			others:   []*redskyv1beta1.Trial{finished, unevaluated},
+			expected: "",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			objs := []runtime.Object{c.trial}
+			for _, o := range c.others {
+				objs = append(objs, o.DeepCopy())
+			}
+			r := &PatchReconciler{Client: fake.NewFakeClientWithScheme(scheme, objs...)}
+
+			name, err := r.sharedTargetHolder(context.TODO(), c.trial)
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, name)
+			}
+		})
+	}
+}
+
+func TestPatchReconciler_ApplyPatches_Waiting(t *testing.T) {
+	ctx := context.TODO()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+	node := corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1"}
+
+	exp := &redskyv1beta1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	holder := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-001", Namespace: "default", UID: "test-001", CreationTimestamp: earlier},
+		Status: redskyv1beta1.TrialStatus{
+			PatchOperations: []redskyv1beta1.PatchOperation{{TargetRef: node}},
+			Conditions:      []redskyv1beta1.TrialCondition{{Type: redskyv1beta1.TrialPatched, Status: corev1.ConditionTrue}},
+		},
+	}
+	waiting := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-002", Namespace: "default", UID: "test-002", CreationTimestamp: now, Labels: map[string]string{redskyv1beta1.LabelExperiment: "test"}},
+		Status: redskyv1beta1.TrialStatus{
+			PatchOperations: []redskyv1beta1.PatchOperation{{TargetRef: node, AttemptsRemaining: 3}},
+			Conditions:      []redskyv1beta1.TrialCondition{{Type: redskyv1beta1.TrialPatched, Status: corev1.ConditionFalse}},
+		},
+	}
+	key := types.NamespacedName{Namespace: waiting.Namespace, Name: waiting.Name}
+
+	cl := fake.NewFakeClientWithScheme(scheme, exp, holder, waiting)
+	recorder := record.NewFakeRecorder(10)
+	r := &PatchReconciler{Client: cl, Log: log.NullLogger{}, Scheme: scheme, Recorder: recorder}
+
+	// Each pass requeues, but only the first one records the wait
+	for i := 0; i < 3; i++ {
+		tr := &redskyv1beta1.Trial{}
+		require.NoError(t, cl.Get(ctx, key, tr))
+		result, err := r.applyPatches(ctx, tr, &now)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.NotZero(t, result.RequeueAfter)
+	}
+
+	tr := &redskyv1beta1.Trial{}
+	require.NoError(t, cl.Get(ctx, key, tr))
+	assert.True(t, isWaiting(tr))
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal Waiting Waiting for trial default/test-001 to release shared patch targets", <-recorder.Events)
+}
+
+func TestPatchReconciler_ClearClusterScopedNamespace(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Node"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	cases := []struct {
+		desc     string
+		mapper   meta.RESTMapper
+		ref      corev1.ObjectReference
+		expected string
+	}{
+		{
+			desc:     "cluster scoped",
+			mapper:   mapper,
+			ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1", Namespace: "default"},
+			expected: "",
+		},
+		{
+			desc:     "namespaced",
+			mapper:   mapper,
+			ref:      corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Namespace: "default"},
+			expected: "default",
+		},
+		{
+			desc:     "unknown kind",
+			mapper:   mapper,
+			ref:      corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Name: "w", Namespace: "default"},
+			expected: "default",
+		},
+		{
+			desc:     "no mapper",
+			ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1", Namespace: "default"},
+			expected: "default",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			r := &PatchReconciler{mapper: c.mapper}
+			ref := c.ref.DeepCopy()
+			r.clearClusterScopedNamespace(ref)
+			assert.Equal(t, c.expected, ref.Namespace)
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -37,8 +38,9 @@ import (
 // ReadyReconciler checks for readiness of the patched objects
 type ReadyReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 
 	// Keep the raw API reader for doing stabilization checks. In that case we only have patch/get permissions
 	// on the object and if we were to use the standard caching reader we would hang because cache itself also
@@ -49,6 +51,7 @@ type ReadyReconciler struct {
 
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile inspects a trial to see if the patched objects are ready for the trial job to start
 func (r *ReadyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
@@ -150,7 +153,7 @@ func (r *ReadyReconciler) evaluateReadinessChecks(ctx context.Context, t *redsky
 	// Update the status to indicate that readiness checks are evaluated
 	trial.ApplyCondition(&t.Status, redskyv1beta1.TrialReady, corev1.ConditionFalse, "", "", probeTime)
 	err := r.Update(ctx, t)
-	return controller.RequeueConflict(err)
+	return controller.RequeueConflict(err, "ready")
 }
 
 // checkReadiness will evaluate the readiness checks for the trial
@@ -173,14 +176,22 @@ func (r *ReadyReconciler) checkReadiness(ctx context.Context, t *redskyv1beta1.T
 		if err != nil {
 			readinessCheckFailed(t, probeTime, err)
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			if err == nil {
+				recordFailure(r.Recorder, t)
+				controller.CountTrialOutcome(t)
+			}
+			return controller.RequeueConflict(err, "ready")
 		}
 
 		// Check for readiness
 		if msg, isReady, err := checker.check(ctx, c, ul, probeTime); err != nil {
 			readinessCheckFailed(t, probeTime, err)
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			if err == nil {
+				recordFailure(r.Recorder, t)
+				controller.CountTrialOutcome(t)
+			}
+			return controller.RequeueConflict(err, "ready")
 		} else if !isReady {
 			// This will get overwritten with anything that isn't ready as we progress through the loop
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialReady, corev1.ConditionFalse, "Waiting", msg, probeTime)
@@ -197,7 +208,11 @@ func (r *ReadyReconciler) checkReadiness(ctx context.Context, t *redskyv1beta1.T
 		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialReady, corev1.ConditionTrue, "", "", probeTime)
 	}
 	err := r.Update(ctx, t)
-	return controller.RequeueConflict(err)
+	if err == nil && checker.ready {
+		r.Recorder.Event(t, corev1.EventTypeNormal, "Ready", "All readiness checks passed")
+		controller.ObserveTrialPhase(t, controller.TrialPhaseReadiness)
+	}
+	return controller.RequeueConflict(err, "ready")
 }
 
 // getCheckTargets returns the list of target objects for the readiness check
@@ -0,0 +1,46 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/controller"
+	"github.com/redskyops/redskyops-controller/internal/meta"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// createRetry creates a new trial which re-runs the assignments of a trial which failed for a transient reason. The
+// supplied finalizer is moved from the failed trial to the new trial so the new trial takes over the responsibility
+// for the outcome of the assignments. Returns nil if the failed trial was already retried, otherwise the failed trial
+// must be updated to record that it was retried.
+func createRetry(ctx context.Context, c client.Client, t *redskyv1beta1.Trial, finalizer string) (*redskyv1beta1.Trial, error) {
+	if !meta.RemoveFinalizer(t, finalizer) {
+		return nil, nil
+	}
+
+	// Create the new trial, it may already exist if we failed to update the original trial
+	retry := trial.NewRetry(t)
+	controllerutil.AddFinalizer(retry, finalizer)
+	if err := c.Create(ctx, retry); controller.IgnoreAlreadyExists(err) != nil {
+		return nil, err
+	}
+	return retry, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// rollbackPatch restores the target of the last patch operation of the trial which has not yet been rolled back,
+// patches are rolled back in reverse order. Returns false if there was nothing left to roll back, otherwise the
+// trial must be updated to record that the patch was rolled back.
+func rollbackPatch(ctx context.Context, c client.Client, t *redskyv1beta1.Trial) (bool, error) {
+	for i := len(t.Status.PatchOperations) - 1; i >= 0; i-- {
+		p := &t.Status.PatchOperations[i]
+		if len(p.RollbackData) == 0 {
+			continue
+		}
+
+		u := &unstructured.Unstructured{}
+		u.SetName(p.TargetRef.Name)
+		u.SetNamespace(p.TargetRef.Namespace)
+		u.SetGroupVersionKind(p.TargetRef.GroupVersionKind())
+		if err := c.Patch(ctx, u, client.RawPatch(types.MergePatchType, p.RollbackData)); err != nil && !apierrs.IsNotFound(err) {
+			return true, err
+		}
+
+		p.RollbackData = nil
+		return true, nil
+	}
+	return false, nil
+}
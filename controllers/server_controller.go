@@ -27,6 +27,7 @@ import (
 	"github.com/redskyops/redskyops-controller/internal/controller"
 	"github.com/redskyops/redskyops-controller/internal/experiment"
 	"github.com/redskyops/redskyops-controller/internal/meta"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
 	"github.com/redskyops/redskyops-controller/internal/server"
 	"github.com/redskyops/redskyops-controller/internal/trial"
 	"github.com/redskyops/redskyops-controller/internal/validation"
@@ -38,6 +39,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -48,6 +50,7 @@ type ServerReconciler struct {
 	client.Client
 	Log            logr.Logger
 	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
 	ExperimentsAPI experimentsv1alpha1.API
 
 	trialCreation *rate.Limiter
@@ -56,6 +59,7 @@ type ServerReconciler struct {
 // +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=list;watch;create;update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=list
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *ServerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -67,6 +71,11 @@ func (r *ServerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, controller.IgnoreNotFound(err)
 	}
 
+	// Experiments using the local optimizer are never synchronized with the server
+	if optimizer.IsLocal(exp) {
+		return ctrl.Result{}, nil
+	}
+
 	// Create the experiment on the server
 	if exp.GetAnnotations()[redskyv1beta1.AnnotationExperimentURL] == "" && exp.Replicas() > 0 {
 		if result, err := r.createExperiment(ctx, log, exp); result != nil {
@@ -96,12 +105,22 @@ func (r *ServerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		// Trials that have the server finalizer may need to be reported
 		if meta.HasFinalizer(t, server.Finalizer) {
 			// TODO Combine report and abandon into one function
-			if trial.IsFinished(t) {
-				if result, err := r.reportTrial(ctx, tlog, t); result != nil {
+			if trial.NeedsRetry(t) {
+				// Wait for the failed trial to finish tearing down (e.g. rolling back patches) before retrying
+				if trial.IsActive(t) {
+					trialHasFinalizer = true
+					continue
+				}
+				if result, err := r.retryTrial(ctx, tlog, exp, t); result != nil {
+					return *result, err
+				}
+				activeTrials++
+			} else if trial.IsFinished(t) {
+				if result, err := r.reportTrial(ctx, tlog, exp, t); result != nil {
 					return *result, err
 				}
 			} else if trial.IsAbandoned(t) {
-				if result, err := r.abandonTrial(ctx, tlog, t); result != nil {
+				if result, err := r.abandonTrial(ctx, tlog, exp, t); result != nil {
 					return *result, err
 				}
 			} else {
@@ -157,7 +176,7 @@ func (r *ServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			r.Log.Info("Red Sky API is unavailable, skipping setup", "message", err.Error())
 			return nil
 		}
-		r.ExperimentsAPI = api
+		r.ExperimentsAPI = controller.InstrumentAPI(api)
 	}
 
 	// Enforce a one trial per-second creation limit (no burst! that is the whole point)
@@ -205,18 +224,62 @@ func (r *ServerReconciler) createExperiment(ctx context.Context, log logr.Logger
 		return &ctrl.Result{}, err
 	}
 
+	// Queue the baseline trial so it is the first suggestion
+	if err := r.createBaselineTrial(ctx, exp, &ee); err != nil {
+		return &ctrl.Result{}, err
+	}
+
 	// Apply the server response to the cluster state
 	server.ToCluster(exp, &ee)
 
 	// Update the experiment
 	if err = r.Update(ctx, exp); err != nil {
-		return controller.RequeueConflict(err)
+		return controller.RequeueConflict(err, "server")
 	}
 
 	log.Info("Created remote experiment", "experimentURL", exp.Annotations[redskyv1beta1.AnnotationExperimentURL])
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "ExperimentCreated", "Created remote experiment %s", exp.Annotations[redskyv1beta1.AnnotationExperimentURL])
 	return nil, nil
 }
 
+// createBaselineTrial will create a trial on the server using the baseline assignments from the cluster state; the
+// trial is labeled as the baseline and no trial is created if the server already has a trial with the baseline
+// assignments (e.g. if the experiment is being re-created or a previous attempt to label the baseline failed)
+func (r *ServerReconciler) createBaselineTrial(ctx context.Context, exp *redskyv1beta1.Experiment, ee *experimentsv1alpha1.Experiment) error {
+	baseline := server.FromClusterBaseline(exp)
+	if baseline == nil || ee.TrialsURL == "" {
+		return nil
+	}
+
+	// Look for an existing baseline on the server, only relying on the label if the assignments do not match
+	tl, err := r.ExperimentsAPI.GetAllTrials(ctx, ee.TrialsURL, nil)
+	if err != nil {
+		return err
+	}
+	var ta *experimentsv1alpha1.TrialAssignments
+	for i := range tl.Trials {
+		if tl.Trials[i].Labels[experimentsv1alpha1.LabelBaseline] == "true" {
+			return nil
+		}
+		if ta == nil && server.IsBaseline(baseline, &tl.Trials[i].TrialAssignments) {
+			ta = &tl.Trials[i].TrialAssignments
+		}
+	}
+
+	if ta == nil {
+		t, err := r.ExperimentsAPI.CreateTrial(ctx, ee.TrialsURL, *baseline)
+		if err != nil {
+			return err
+		}
+		ta = &t
+	}
+
+	if ta.LabelsURL != "" {
+		return r.ExperimentsAPI.LabelTrial(ctx, ta.LabelsURL, experimentsv1alpha1.TrialLabels{Labels: map[string]string{experimentsv1alpha1.LabelBaseline: "true"}})
+	}
+	return nil
+}
+
 // unlinkExperiment will delete the experiment from the server using the URLs recorded in the cluster; the finalizer
 // added when the experiment was created on the server will also be removed
 func (r *ServerReconciler) unlinkExperiment(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment) (*ctrl.Result, error) {
@@ -234,10 +297,11 @@ func (r *ServerReconciler) unlinkExperiment(ctx context.Context, log logr.Logger
 
 	// Update the experiment
 	if err := r.Update(ctx, exp); err != nil {
-		return controller.RequeueConflict(err)
+		return controller.RequeueConflict(err, "server")
 	}
 
 	log.Info("Unlinked remote experiment")
+	r.Recorder.Event(exp, corev1.EventTypeNormal, "ExperimentUnlinked", "Unlinked remote experiment")
 	return nil, nil
 }
 
@@ -266,7 +330,10 @@ func (r *ServerReconciler) nextTrial(ctx context.Context, log logr.Logger, exp *
 	if err != nil {
 		if server.StopExperiment(exp, err) {
 			err := r.Update(ctx, exp)
-			return controller.RequeueConflict(err)
+			if err == nil {
+				r.Recorder.Event(exp, corev1.EventTypeNormal, "Stopped", "The server stopped the experiment")
+			}
+			return controller.RequeueConflict(err, "server")
 		}
 		return controller.RequeueIfUnavailable(err)
 	}
@@ -277,21 +344,34 @@ func (r *ServerReconciler) nextTrial(ctx context.Context, log logr.Logger, exp *
 	t.Namespace = namespace
 	server.ToClusterTrial(t, &suggestion)
 
+	// Record the baseline before creating the trial so no other trial is labeled as the baseline
+	if experiment.ApplyBaselineLabel(exp, t) {
+		if err := r.Update(ctx, exp); err != nil {
+			r.abandonSuggestion(ctx, t)
+			return controller.RequeueConflict(err, "server")
+		}
+	}
+
 	// Create the trial
 	if err := r.Create(ctx, t); err != nil {
-		// If creation fails, abandon the suggestion (ignoring those errors)
-		if url := t.GetAnnotations()[redskyv1beta1.AnnotationReportTrialURL]; url != "" {
-			_ = r.ExperimentsAPI.AbandonRunningTrial(ctx, url)
-		}
+		r.abandonSuggestion(ctx, t)
 		return &ctrl.Result{}, err
 	}
 
 	log.Info("Created new trial", "reportTrialURL", t.GetAnnotations()[redskyv1beta1.AnnotationReportTrialURL], "assignments", t.Spec.Assignments)
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialCreated", "Created trial %s", t.Name)
 	return nil, nil
 }
 
+// abandonSuggestion abandons the server suggestion used by a trial which could not be created (ignoring errors)
+func (r *ServerReconciler) abandonSuggestion(ctx context.Context, t *redskyv1beta1.Trial) {
+	if url := t.GetAnnotations()[redskyv1beta1.AnnotationReportTrialURL]; url != "" {
+		_ = r.ExperimentsAPI.AbandonRunningTrial(ctx, url)
+	}
+}
+
 // reportTrial will report the values from a finished in cluster trial back to the server
-func (r *ServerReconciler) reportTrial(ctx context.Context, log logr.Logger, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+func (r *ServerReconciler) reportTrial(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
 	if !meta.RemoveFinalizer(t, server.Finalizer) {
 		return nil, nil
 	}
@@ -316,15 +396,38 @@ func (r *ServerReconciler) reportTrial(ctx context.Context, log logr.Logger, t *
 
 	// Update the trial
 	if err := r.Update(ctx, t); err != nil {
-		return controller.RequeueConflict(err)
+		return controller.RequeueConflict(err, "server")
 	}
 
 	log.Info("Reported trial")
+	r.Recorder.Event(t, corev1.EventTypeNormal, "Reported", "Reported trial to the server")
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialReported", "Reported trial %s", t.Name)
+	return nil, nil
+}
+
+// retryTrial will re-run the assignments of a trial which failed for a transient reason in a new trial; the new trial
+// takes over the responsibility of reporting back to the server so the failure is never reported
+func (r *ServerReconciler) retryTrial(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+	retry, err := createRetry(ctx, r, t, server.Finalizer)
+	if err != nil {
+		return &ctrl.Result{}, err
+	} else if retry == nil {
+		return nil, nil
+	}
+
+	// Update the original trial
+	if err := r.Update(ctx, t); err != nil {
+		return controller.RequeueConflict(err, "server")
+	}
+
+	log.Info("Retrying trial", "retry", retry.Name, "retries", trial.Retries(retry), "reportTrialURL", t.GetAnnotations()[redskyv1beta1.AnnotationReportTrialURL])
+	r.Recorder.Eventf(t, corev1.EventTypeNormal, "Retried", "Retrying assignments in trial %s", retry.Name)
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialRetried", "Retrying assignments of trial %s in trial %s", t.Name, retry.Name)
 	return nil, nil
 }
 
 // abandonTrial will remove the finalizer and try to notify the server that the trial will not be reported
-func (r *ServerReconciler) abandonTrial(ctx context.Context, log logr.Logger, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+func (r *ServerReconciler) abandonTrial(ctx context.Context, log logr.Logger, exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
 	if !meta.RemoveFinalizer(t, server.Finalizer) {
 		return nil, nil
 	}
@@ -341,9 +444,11 @@ func (r *ServerReconciler) abandonTrial(ctx context.Context, log logr.Logger, t
 
 	// Update the trial
 	if err := r.Update(ctx, t); err != nil {
-		return controller.RequeueConflict(err)
+		return controller.RequeueConflict(err, "server")
 	}
 
 	log.Info("Abandoned trial")
+	r.Recorder.Event(t, corev1.EventTypeNormal, "Abandoned", "Abandoned trial on the server")
+	r.Recorder.Eventf(exp, corev1.EventTypeNormal, "TrialAbandoned", "Abandoned trial %s", t.Name)
 	return nil, nil
 }
@@ -31,21 +31,29 @@ import (
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// setupRetained is the reason used when the setup shared by trials in the same namespace is not deleted with the trial
+const setupRetained = "SetupRetained"
+
 // SetupReconciler reconciles a Trial object for setup tasks
 type SetupReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update;delete
 // +kubebuilder:rbac:groups="",resources=pods,verbs=list
 // +kubebuilder:rbac:groups=batch;extensions,resources=jobs,verbs=list;watch;create
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *SetupReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -99,7 +107,7 @@ func (r *SetupReconciler) inspectSetupJobs(ctx context.Context, t *redskyv1beta1
 
 	// This is purely for recovery
 	if len(list.Items) == 0 {
-		if t.DeletionTimestamp.IsZero() {
+		if t.DeletionTimestamp.IsZero() && !setup.IsNamespaceLifecycle(t) {
 			// Normally if the trial hasn't been deleted and there are no jobs, the status will already be unknown
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialSetupCreated, corev1.ConditionUnknown, "", "", probeTime)
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialSetupDeleted, corev1.ConditionUnknown, "", "", probeTime)
@@ -125,7 +133,7 @@ func (r *SetupReconciler) inspectSetupJobs(ctx context.Context, t *redskyv1beta1
 		// Determine if the job is finished (i.e. completed or failed)
 		conditionStatus, failureMessage := setup.GetConditionStatus(job)
 		if conditionStatus == corev1.ConditionFalse {
-			conditionStatus, failureMessage = r.inspectSetupJobPods(ctx, job)
+			conditionStatus, failureMessage = inspectSetupJobPods(ctx, r, job)
 		}
 		trial.ApplyCondition(&t.Status, conditionType, conditionStatus, "", "", probeTime)
 
@@ -142,14 +150,36 @@ func (r *SetupReconciler) inspectSetupJobs(ctx context.Context, t *redskyv1beta1
 	for i := range t.Status.Conditions {
 		if t.Status.Conditions[i].LastTransitionTime.Equal(probeTime) {
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			if err == nil {
+				r.recordTransitions(t, probeTime)
+			}
+			return controller.RequeueConflict(err, "setup")
 		}
 	}
 	return nil, nil
 }
 
+// recordTransitions emits events for the setup conditions which became true at the probe time
+func (r *SetupReconciler) recordTransitions(t *redskyv1beta1.Trial, probeTime *metav1.Time) {
+	for _, c := range t.Status.Conditions {
+		if c.Status != corev1.ConditionTrue || !c.LastTransitionTime.Equal(probeTime) {
+			continue
+		}
+		switch c.Type {
+		case redskyv1beta1.TrialSetupCreated:
+			r.Recorder.Event(t, corev1.EventTypeNormal, "SetupCreated", "Setup tasks finished creating")
+			controller.ObserveTrialPhase(t, controller.TrialPhaseSetup)
+		case redskyv1beta1.TrialSetupDeleted:
+			r.Recorder.Event(t, corev1.EventTypeNormal, "SetupDeleted", "Setup tasks finished deleting")
+		case redskyv1beta1.TrialFailed:
+			recordFailure(r.Recorder, t)
+			controller.CountTrialOutcome(t)
+		}
+	}
+}
+
 // inspectSetupJobPods will do further inspection on a job's pods to determine its current state
-func (r *SetupReconciler) inspectSetupJobPods(ctx context.Context, j *batchv1.Job) (corev1.ConditionStatus, string) {
+func inspectSetupJobPods(ctx context.Context, r client.Reader, j *batchv1.Job) (corev1.ConditionStatus, string) {
 	list := &corev1.PodList{}
 	if matchingSelector, err := meta.MatchingSelector(j.Spec.Selector); err == nil {
 		_ = r.List(ctx, list, client.InNamespace(j.Namespace), matchingSelector)
@@ -175,12 +205,15 @@ func (r *SetupReconciler) createSetupJob(ctx context.Context, t *redskyv1beta1.T
 		// Before we can create the job, we need an initializer/finalizer
 		if trial.AddInitializer(t, setup.Initializer) || meta.AddFinalizer(t, setup.Finalizer) {
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "setup")
 		}
 
 		// Do not create setup tasks if the trial is deleted
 		if t.DeletionTimestamp.IsZero() {
 			mode = setup.ModeCreate
+			if setup.IsNamespaceLifecycle(t) {
+				mode = setup.ModeApply
+			}
 		}
 	}
 
@@ -192,6 +225,13 @@ func (r *SetupReconciler) createSetupJob(ctx context.Context, t *redskyv1beta1.T
 		}
 	}
 
+	// Setup shared by trials in the same namespace is only applied when it changes and only deleted when it is released
+	if mode != "" && setup.IsNamespaceLifecycle(t) {
+		if result, err := r.shareSetup(ctx, t, mode, probeTime); result != nil {
+			return result, err
+		}
+	}
+
 	// Create a setup job if necessary
 	if mode != "" {
 		job, err := setup.NewJob(t, mode)
@@ -202,12 +242,15 @@ func (r *SetupReconciler) createSetupJob(ctx context.Context, t *redskyv1beta1.T
 			return &ctrl.Result{}, err
 		}
 		err = r.Create(ctx, job)
+		if err == nil {
+			r.Recorder.Eventf(t, corev1.EventTypeNormal, "SetupJobCreated", "Created setup %s job %s", mode, job.Name)
+		}
 
 		// Forbidden for a delete job indicates that namespace was probably deleted
 		if apierrs.IsForbidden(err) && mode == setup.ModeDelete {
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialSetupDeleted, corev1.ConditionTrue, "Forbidden", err.Error(), probeTime)
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "setup")
 		}
 
 		return &ctrl.Result{}, controller.IgnoreAlreadyExists(err)
@@ -227,17 +270,27 @@ func (r *SetupReconciler) finish(ctx context.Context, t *redskyv1beta1.Trial) (*
 
 	// If the create job is finished, remove the initializer so the rest of the trial can run
 	if trial.CheckCondition(&t.Status, redskyv1beta1.TrialSetupCreated, corev1.ConditionTrue) {
+		// Record this trial as the holder of the shared setup before the trial can run
+		if setup.IsNamespaceLifecycle(t) && !trial.IsFinished(t) && t.DeletionTimestamp.IsZero() {
+			if result, err := r.recordSetupState(ctx, t); result != nil {
+				return result, err
+			}
+		}
+
 		if trial.RemoveInitializer(t, setup.Initializer) {
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "setup")
 		}
 	}
 
 	// Do not remove the finalizer until the delete job is finished
 	if trial.CheckCondition(&t.Status, redskyv1beta1.TrialSetupDeleted, corev1.ConditionTrue) {
 		if meta.RemoveFinalizer(t, setup.Finalizer) {
+			if err := r.releaseSetupState(ctx, t); err != nil {
+				return &ctrl.Result{}, err
+			}
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "setup")
 		}
 	}
 
@@ -249,9 +302,131 @@ func (r *SetupReconciler) finish(ctx context.Context, t *redskyv1beta1.Trial) (*
 		// TODO Is it possible we got here because the create job just never had a chance to finish?
 		if meta.RemoveFinalizer(t, setup.Finalizer) {
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
+			return controller.RequeueConflict(err, "setup")
 		}
 	}
 
 	return nil, nil
 }
+
+// shareSetup checks the state of the setup shared by trials in the same namespace, returning a non-nil result if the
+// setup job for the supplied mode is not necessary
+func (r *SetupReconciler) shareSetup(ctx context.Context, t *redskyv1beta1.Trial, mode string, probeTime *metav1.Time) (*ctrl.Result, error) {
+	state := &corev1.ConfigMap{}
+	if err := r.Get(ctx, setup.StateKey(t), state); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &ctrl.Result{}, err
+		}
+		state = nil
+	}
+
+	switch mode {
+	case setup.ModeApply:
+		// Reuse the existing setup if applying it again would not change anything
+		checksum, err := setup.Checksum(t)
+		if err != nil {
+			return &ctrl.Result{}, err
+		}
+		if state == nil || state.Data[setup.StateChecksum] != checksum {
+			return nil, nil
+		}
+
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialSetupCreated, corev1.ConditionTrue, "SetupReused", "", probeTime)
+		err = r.Update(ctx, t)
+		if err == nil {
+			r.Recorder.Eventf(t, corev1.EventTypeNormal, "SetupReused", "Reusing setup applied by trial %s", state.Data[setup.StateTrial])
+			controller.ObserveTrialPhase(t, controller.TrialPhaseSetup)
+		}
+		return controller.RequeueConflict(err, "setup")
+
+	case setup.ModeDelete:
+		// If nothing holds the setup, the delete job is needed to clean up a failed apply
+		if state == nil {
+			return nil, nil
+		}
+
+		// The trial holding the setup only deletes it if there is no experiment left to do it
+		if state.Data[setup.StateTrial] == t.Name {
+			exp := &redskyv1beta1.Experiment{}
+			if err := r.Get(ctx, t.ExperimentNamespacedName(), exp); err != nil {
+				if apierrs.IsNotFound(err) {
+					return nil, nil
+				}
+				return &ctrl.Result{}, err
+			}
+		}
+
+		// Keep the setup for the next trial, the experiment deletes it once it is finished
+		trial.ApplyCondition(&t.Status, redskyv1beta1.TrialSetupDeleted, corev1.ConditionTrue, setupRetained, "", probeTime)
+		err := r.Update(ctx, t)
+		return controller.RequeueConflict(err, "setup")
+	}
+
+	return nil, nil
+}
+
+// recordSetupState records the trial as the holder of the setup shared by trials in the same namespace
+func (r *SetupReconciler) recordSetupState(ctx context.Context, t *redskyv1beta1.Trial) (*ctrl.Result, error) {
+	checksum, err := setup.Checksum(t)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	desired, err := setup.NewState(t, checksum)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	state := &corev1.ConfigMap{}
+	if err := r.Get(ctx, setup.StateKey(t), state); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &ctrl.Result{}, err
+		}
+		err := r.Create(ctx, desired)
+		if apierrs.IsAlreadyExists(err) {
+			return &ctrl.Result{Requeue: true}, nil
+		}
+		if err != nil {
+			return &ctrl.Result{}, err
+		}
+		return nil, nil
+	}
+
+	if state.Data[setup.StateChecksum] == checksum && state.Data[setup.StateTrial] == t.Name {
+		return nil, nil
+	}
+
+	state.Data = desired.Data
+	if err := r.Update(ctx, state); err != nil {
+		return controller.RequeueConflict(err, "setup")
+	}
+	return nil, nil
+}
+
+// releaseSetupState removes the record of the setup shared by trials in the same namespace once it has been deleted
+func (r *SetupReconciler) releaseSetupState(ctx context.Context, t *redskyv1beta1.Trial) error {
+	if !setup.IsNamespaceLifecycle(t) {
+		return nil
+	}
+
+	// Retained setup is still in use, it is released by the experiment
+	for _, c := range t.Status.Conditions {
+		if c.Type == redskyv1beta1.TrialSetupDeleted && c.Reason == setupRetained {
+			return nil
+		}
+	}
+
+	state := &corev1.ConfigMap{}
+	if err := r.Get(ctx, setup.StateKey(t), state); err != nil {
+		return controller.IgnoreNotFound(err)
+	}
+
+	// Only the trial holding the setup actually deleted it
+	if state.Data[setup.StateTrial] != t.Name {
+		return nil
+	}
+
+	if err := r.Delete(ctx, state); err != nil && !apierrs.IsNotFound(err) && !apierrs.IsForbidden(err) {
+		return err
+	}
+	return nil
+}
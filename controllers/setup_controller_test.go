@@ -0,0 +1,170 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/experiment"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
+	"github.com/redskyops/redskyops-controller/internal/setup"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestNamespaceSetupLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	// A sequential experiment (the default of one replica) with two trials sharing the same setup
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "test"},
+		Spec: redskyv1beta1.ExperimentSpec{
+			Optimization: []redskyv1beta1.Optimization{
+				{Name: optimizer.OptimizationAlgorithm, Value: optimizer.AlgorithmRandom},
+				{Name: optimizer.OptimizationBudget, Value: "2"},
+			},
+			Parameters: []redskyv1beta1.Parameter{{Name: "replicas", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(10)}},
+			TrialTemplate: redskyv1beta1.TrialTemplateSpec{
+				Spec: redskyv1beta1.TrialSpec{
+					SetupLifecycle: redskyv1beta1.SetupLifecycleNamespace,
+					SetupTasks:     []redskyv1beta1.SetupTask{{Name: "app"}},
+				},
+			},
+		},
+	}
+	expKey := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
+	stateKey := types.NamespacedName{Namespace: exp.Namespace, Name: "redsky-setup-" + exp.Name}
+
+	c := &generateNameClient{Client: fake.NewFakeClientWithScheme(scheme, exp, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})}
+	recorder := record.NewFakeRecorder(1000)
+	er := &ExperimentReconciler{Client: c, Log: log.NullLogger{}, Scheme: scheme, Recorder: recorder, apiReader: c}
+	or := &OptimizerReconciler{Client: c, Log: log.NullLogger{}, Scheme: scheme, Recorder: recorder, trialCreation: rate.NewLimiter(rate.Inf, 0)}
+	sr := &SetupReconciler{Client: c, Log: log.NullLogger{}, Scheme: scheme, Recorder: recorder}
+
+	// settle runs the reconcilers (finishing any setup jobs) until nothing changes
+	settle := func() *redskyv1beta1.TrialList {
+		trialList := &redskyv1beta1.TrialList{}
+		for i := 0; i < 20; i++ {
+			runReconcile(t, er, expKey)
+			runReconcile(t, or, expKey)
+
+			require.NoError(t, c.List(ctx, trialList))
+			for j := range trialList.Items {
+				runReconcile(t, sr, types.NamespacedName{Namespace: trialList.Items[j].Namespace, Name: trialList.Items[j].Name})
+			}
+
+			jobList := &batchv1.JobList{}
+			require.NoError(t, c.List(ctx, jobList))
+			for j := range jobList.Items {
+				if job := &jobList.Items[j]; len(job.Status.Conditions) == 0 {
+					job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+					require.NoError(t, c.Update(ctx, job))
+				}
+			}
+		}
+		require.NoError(t, c.List(ctx, trialList))
+		return trialList
+	}
+
+	// finish marks the trial run as complete
+	finish := func(tr *redskyv1beta1.Trial) {
+		now := metav1.Now()
+		trial.ApplyCondition(&tr.Status, redskyv1beta1.TrialComplete, corev1.ConditionTrue, "", "", &now)
+		require.NoError(t, c.Update(ctx, tr))
+	}
+
+	// The first trial applies the setup and holds it
+	trialList := settle()
+	require.Len(t, trialList.Items, 1)
+	first := &trialList.Items[0]
+	assert.True(t, trial.CheckCondition(&first.Status, redskyv1beta1.TrialSetupCreated, corev1.ConditionTrue))
+	state := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, stateKey, state))
+	assert.Equal(t, first.Name, state.Data[setup.StateTrial])
+
+	// Finishing the first trial must retain the setup and allow the second trial to start
+	finish(first)
+	trialList = settle()
+	require.Len(t, trialList.Items, 2)
+	for i := range trialList.Items {
+		tr := &trialList.Items[i]
+		if tr.Name != first.Name {
+			assert.True(t, trial.CheckCondition(&tr.Status, redskyv1beta1.TrialSetupCreated, corev1.ConditionTrue))
+			finish(tr)
+			continue
+		}
+		assert.False(t, trial.IsActive(tr))
+		for _, cond := range tr.Status.Conditions {
+			if cond.Type == redskyv1beta1.TrialSetupDeleted {
+				assert.Equal(t, setupRetained, cond.Reason)
+			}
+		}
+	}
+	assert.True(t, apierrs.IsNotFound(c.Get(ctx, types.NamespacedName{Namespace: first.Namespace, Name: first.Name + "-delete"}, &batchv1.Job{})))
+
+	// Once the budget is exhausted, the experiment deletes the shared setup
+	trialList = settle()
+	require.Len(t, trialList.Items, 2)
+	require.NoError(t, c.Get(ctx, expKey, exp))
+	assert.True(t, experiment.CheckCondition(&exp.Status, redskyv1beta1.ExperimentComplete, corev1.ConditionTrue))
+	assert.NotContains(t, exp.Finalizers, setup.StateFinalizer)
+	assert.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: stateKey.Namespace, Name: stateKey.Name + "-delete"}, &batchv1.Job{}))
+	assert.True(t, apierrs.IsNotFound(c.Get(ctx, stateKey, state)))
+}
+
+// runReconcile invokes the reconciler for the supplied object
+func runReconcile(t *testing.T, r interface {
+	Reconcile(ctrl.Request) (ctrl.Result, error)
+}, key types.NamespacedName) {
+	_, err := r.Reconcile(ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+}
+
+// generateNameClient assigns names to objects created with a generated name, the fake client does not
+type generateNameClient struct {
+	client.Client
+	n int
+}
+
+func (c *generateNameClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	if m, err := apimeta.Accessor(obj); err == nil && m.GetName() == "" && m.GetGenerateName() != "" {
+		c.n++
+		m.SetName(fmt.Sprintf("%s%03d", m.GetGenerateName(), c.n))
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
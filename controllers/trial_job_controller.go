@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -27,8 +28,11 @@ import (
 	"github.com/redskyops/redskyops-controller/internal/trial"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -37,13 +41,31 @@ import (
 // TrialJobReconciler reconciles a Trial's job
 type TrialJobReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Logs are not available through the controller runtime client
+	pods corev1client.PodsGetter
 }
 
+const (
+	// statusLogBytes is the maximum size of the trial run logs retained in the trial status
+	statusLogBytes = 4 * 1024
+	// configMapLogBytes is the maximum size of the trial run logs retained in a config map
+	configMapLogBytes = 256 * 1024
+	// imagePullGracePeriod is how long a trial run pod can fail to pull an image before the trial fails, unless the
+	// failure is going to be retried
+	imagePullGracePeriod = 5 * time.Minute
+)
+
+// +kubebuilder:rbac:groups=redskyops.dev,resources=experiments,verbs=get
 // +kubebuilder:rbac:groups=redskyops.dev,resources=trials,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=batch;extensions,resources=jobs,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *TrialJobReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -89,6 +111,12 @@ func (r *TrialJobReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 }
 
 func (r *TrialJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	pods, err := corev1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	r.pods = pods
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("trial-job").
 		For(&redskyv1beta1.Trial{}).
@@ -124,12 +152,31 @@ func (r *TrialJobReconciler) ignoreTrial(t *redskyv1beta1.Trial) bool {
 // updateStatus will update the trial status based on the supplied list of trial run jobs
 func (r *TrialJobReconciler) updateStatus(ctx context.Context, t *redskyv1beta1.Trial, jobList *batchv1.JobList, probeTime *metav1.Time) (*ctrl.Result, error) {
 	for i := range jobList.Items {
+		started, finished := t.Status.StartTime != nil, t.Status.CompletionTime != nil
 		if update, requeue := r.applyJobStatus(ctx, t, &jobList.Items[i], probeTime); update {
+			// The job pods (and their logs) do not last as long as the trial, keep the end of the logs once the job is over
+			if (!finished && t.Status.CompletionTime != nil) || trial.CheckCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue) {
+				if err := r.retainLogs(ctx, t); err != nil {
+					return &ctrl.Result{}, err
+				}
+			}
+
 			err := r.Update(ctx, t)
-			return controller.RequeueConflict(err)
-		} else if requeue {
-			// We are watching jobs, not pods; we may need to poll the pod state before it is consistent
-			return &ctrl.Result{Requeue: true}, nil
+			if err == nil {
+				if !started && t.Status.StartTime != nil {
+					r.Recorder.Eventf(t, corev1.EventTypeNormal, "Started", "Trial run job %s started", jobList.Items[i].Name)
+				}
+				if !finished && t.Status.CompletionTime != nil {
+					r.Recorder.Eventf(t, corev1.EventTypeNormal, "Finished", "Trial run job %s finished", jobList.Items[i].Name)
+					controller.ObserveTrialPhase(t, controller.TrialPhaseJob)
+				}
+				recordFailure(r.Recorder, t)
+				controller.CountTrialOutcome(t)
+			}
+			return controller.RequeueConflict(err, "trial-job")
+		} else if requeue != nil {
+			// We are watching jobs, not pods; we may need to poll the pod state
+			return requeue, nil
 		}
 	}
 
@@ -143,8 +190,70 @@ func (r *TrialJobReconciler) createJob(ctx context.Context, t *redskyv1beta1.Tri
 		return &ctrl.Result{}, err
 	}
 
-	err := r.Create(ctx, job)
-	return &ctrl.Result{}, err
+	if err := r.Create(ctx, job); err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(t, corev1.EventTypeNormal, "JobCreated", "Created trial run job %s", job.Name)
+	return &ctrl.Result{}, nil
+}
+
+// retainLogs keeps the end of the trial run logs in either the trial status or a config map
+func (r *TrialJobReconciler) retainLogs(ctx context.Context, t *redskyv1beta1.Trial) error {
+	lr := t.Spec.LogRetention
+	if lr == nil || r.pods == nil {
+		return nil
+	}
+
+	tailLines := lr.TailLines
+	if tailLines <= 0 {
+		tailLines = 50
+	}
+	limitBytes := int64(statusLogBytes)
+	if lr.ConfigMap {
+		limitBytes = configMapLogBytes
+	}
+
+	// Failing to read the logs should not prevent the trial from finishing
+	logs, err := trial.RunLogs(r.pods, t, lr.Container, &corev1.PodLogOptions{TailLines: &tailLines, LimitBytes: &limitBytes})
+	if err != nil {
+		r.Log.Error(err, "Unable to retain trial run logs", "trial", fmt.Sprintf("%s/%s", t.Namespace, t.Name))
+		return nil
+	}
+	tail := trial.Tail(logs, tailLines, int(limitBytes))
+
+	if !lr.ConfigMap {
+		t.Status.LogTail = tail
+		return nil
+	}
+
+	// The config map is owned by the experiment so it survives the clean up of the trial
+	exp := &redskyv1beta1.Experiment{}
+	if err := r.Get(ctx, t.ExperimentNamespacedName(), exp); err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = exp.Namespace
+	cm.Name = t.Name + "-logs"
+	cm.Labels = map[string]string{
+		redskyv1beta1.LabelExperiment: exp.Name,
+		redskyv1beta1.LabelTrial:      t.Name,
+	}
+	cm.Data = map[string]string{"logs": tail}
+	if err := controllerutil.SetControllerReference(exp, cm, r.Scheme); err != nil {
+		return err
+	}
+
+	err = r.Create(ctx, cm)
+	if apierrs.IsAlreadyExists(err) {
+		existing := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: cm.Namespace, Name: cm.Name}, existing); err != nil {
+			return err
+		}
+		existing.Data = cm.Data
+		err = r.Update(ctx, existing)
+	}
+	return err
 }
 
 // listJobs will return all of the jobs for the trial
@@ -170,8 +279,9 @@ func (r *TrialJobReconciler) listJobs(ctx context.Context, jobList *batchv1.JobL
 	return nil
 }
 
-func (r *TrialJobReconciler) applyJobStatus(ctx context.Context, t *redskyv1beta1.Trial, job *batchv1.Job, time *metav1.Time) (bool, bool) {
+func (r *TrialJobReconciler) applyJobStatus(ctx context.Context, t *redskyv1beta1.Trial, job *batchv1.Job, time *metav1.Time) (bool, *ctrl.Result) {
 	var dirty bool
+	var requeue *ctrl.Result
 
 	// Get the interval of the container execution in the job pods
 	startedAt := job.Status.StartTime
@@ -193,12 +303,25 @@ func (r *TrialJobReconciler) applyJobStatus(ctx context.Context, t *redskyv1beta
 						dirty = true
 					}
 				}
+				// Containers which cannot pull their image will never start, unless the pull failure is transient
+				for _, cs := range append(s.InitContainerStatuses, s.ContainerStatuses...) {
+					if w := cs.State.Waiting; w != nil && (w.Reason == "ErrImagePull" || w.Reason == "ImagePullBackOff") {
+						// Only fail immediately if the failure will be retried, otherwise give the pull a chance to succeed
+						remaining := podList.Items[i].CreationTimestamp.Add(imagePullGracePeriod).Sub(time.Time)
+						if remaining > 0 && !trial.IsRetryReason(t, w.Reason) {
+							requeue = &ctrl.Result{RequeueAfter: remaining}
+							continue
+						}
+						trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, w.Reason, w.Message, time)
+						dirty = true
+					}
+				}
 			}
 
 			// Check if the job has a start/completion time, but it is not yet reflected in the pod state we are seeing
 			startedAt, finishedAt = containerTime(podList)
 			if (startedAt == nil && job.Status.StartTime != nil) || (finishedAt == nil && job.Status.CompletionTime != nil) {
-				return dirty, true
+				return dirty, &ctrl.Result{Requeue: true}
 			}
 		}
 	}
@@ -215,20 +338,24 @@ func (r *TrialJobReconciler) applyJobStatus(ctx context.Context, t *redskyv1beta
 		dirty = true
 	}
 
-	// Mark the trial as failed if the job itself failed
+	// Mark the trial as failed if the job itself failed (preferring the more specific pod failure reason, if any)
 	for _, c := range job.Status.Conditions {
-		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue && !trial.CheckCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue) {
 			trial.ApplyCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue, c.Reason, c.Message, time)
 			dirty = true
 		}
 	}
 
-	return dirty, false
+	return dirty, requeue
 }
 
 func containerTime(pods *corev1.PodList) (startedAt *metav1.Time, finishedAt *metav1.Time) {
 	for i := range pods.Items {
 		for j := range pods.Items[i].Status.ContainerStatuses {
+			// The results sidecar only finishes after the trial run, it should not extend the trial
+			if pods.Items[i].Status.ContainerStatuses[j].Name == trial.ResultsContainerName {
+				continue
+			}
 			s := &pods.Items[i].Status.ContainerStatuses[j].State
 			if s.Running != nil {
 				startedAt, _ = earliestTime(startedAt, &s.Running.StartedAt)
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTrialJobReconciler_ImagePullFailure(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = redskyv1beta1.AddToScheme(scheme)
+
+	// Timestamps only have second precision once they are stored
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	cases := []struct {
+		desc    string
+		policy  *redskyv1beta1.RetryPolicy
+		created time.Duration
+		failed  bool
+		requeue bool
+	}{
+		{
+			desc:    "no retry policy",
+			created: time.Minute,
+			requeue: true,
+		},
+		{
+			desc:    "no retry policy after grace period",
+			created: imagePullGracePeriod + time.Minute,
+			failed:  true,
+		},
+		{
+			desc:    "retry policy",
+			policy:  &redskyv1beta1.RetryPolicy{},
+			created: time.Minute,
+			failed:  true,
+		},
+		{
+			desc:    "retry policy without image pull reasons",
+			policy:  &redskyv1beta1.RetryPolicy{Reasons: []string{"Evicted"}},
+			created: time.Minute,
+			requeue: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: batchv1.JobSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "test"}},
+				},
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "test-abcde",
+					Namespace:         "default",
+					Labels:            map[string]string{"job-name": "test"},
+					CreationTimestamp: metav1.NewTime(now.Add(-c.created)),
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "main", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+					},
+				},
+			}
+			tt := &redskyv1beta1.Trial{Spec: redskyv1beta1.TrialSpec{RetryPolicy: c.policy}}
+
+			r := &TrialJobReconciler{Client: fake.NewFakeClientWithScheme(scheme, pod)}
+			dirty, requeue := r.applyJobStatus(context.TODO(), tt, job, &now)
+
+			assert.Equal(t, c.failed, dirty)
+			assert.Equal(t, c.failed, trial.CheckCondition(&tt.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue))
+			if c.requeue && assert.NotNil(t, requeue) {
+				assert.Equal(t, imagePullGracePeriod-c.created, requeue.RequeueAfter)
+			}
+		})
+	}
+}
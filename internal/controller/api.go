@@ -0,0 +1,106 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	experimentsv1alpha1 "github.com/redskyops/redskyops-controller/redskyapi/experiments/v1alpha1"
+)
+
+// InstrumentAPI wraps the supplied API so the latency of each request is recorded
+func InstrumentAPI(api experimentsv1alpha1.API) experimentsv1alpha1.API {
+	return &instrumentedAPI{api: api}
+}
+
+// instrumentedAPI observes the duration of every call to the wrapped API
+type instrumentedAPI struct {
+	api experimentsv1alpha1.API
+}
+
+var _ experimentsv1alpha1.API = &instrumentedAPI{}
+
+func (i *instrumentedAPI) Options(ctx context.Context) (experimentsv1alpha1.ServerMeta, error) {
+	defer ObserveAPIRequest("Options", time.Now())
+	return i.api.Options(ctx)
+}
+
+func (i *instrumentedAPI) GetAllExperiments(ctx context.Context, q *experimentsv1alpha1.ExperimentListQuery) (experimentsv1alpha1.ExperimentList, error) {
+	defer ObserveAPIRequest("GetAllExperiments", time.Now())
+	return i.api.GetAllExperiments(ctx, q)
+}
+
+func (i *instrumentedAPI) GetAllExperimentsByPage(ctx context.Context, u string) (experimentsv1alpha1.ExperimentList, error) {
+	defer ObserveAPIRequest("GetAllExperimentsByPage", time.Now())
+	return i.api.GetAllExperimentsByPage(ctx, u)
+}
+
+func (i *instrumentedAPI) GetExperimentByName(ctx context.Context, n experimentsv1alpha1.ExperimentName) (experimentsv1alpha1.Experiment, error) {
+	defer ObserveAPIRequest("GetExperimentByName", time.Now())
+	return i.api.GetExperimentByName(ctx, n)
+}
+
+func (i *instrumentedAPI) GetExperiment(ctx context.Context, u string) (experimentsv1alpha1.Experiment, error) {
+	defer ObserveAPIRequest("GetExperiment", time.Now())
+	return i.api.GetExperiment(ctx, u)
+}
+
+func (i *instrumentedAPI) CreateExperiment(ctx context.Context, n experimentsv1alpha1.ExperimentName, exp experimentsv1alpha1.Experiment) (experimentsv1alpha1.Experiment, error) {
+	defer ObserveAPIRequest("CreateExperiment", time.Now())
+	return i.api.CreateExperiment(ctx, n, exp)
+}
+
+func (i *instrumentedAPI) DeleteExperiment(ctx context.Context, u string) error {
+	defer ObserveAPIRequest("DeleteExperiment", time.Now())
+	return i.api.DeleteExperiment(ctx, u)
+}
+
+func (i *instrumentedAPI) GetAllTrials(ctx context.Context, u string, q *experimentsv1alpha1.TrialListQuery) (experimentsv1alpha1.TrialList, error) {
+	defer ObserveAPIRequest("GetAllTrials", time.Now())
+	return i.api.GetAllTrials(ctx, u, q)
+}
+
+func (i *instrumentedAPI) CreateTrial(ctx context.Context, u string, assignments experimentsv1alpha1.TrialAssignments) (experimentsv1alpha1.TrialAssignments, error) {
+	defer ObserveAPIRequest("CreateTrial", time.Now())
+	return i.api.CreateTrial(ctx, u, assignments)
+}
+
+func (i *instrumentedAPI) NextTrial(ctx context.Context, u string) (experimentsv1alpha1.TrialAssignments, error) {
+	defer ObserveAPIRequest("NextTrial", time.Now())
+	return i.api.NextTrial(ctx, u)
+}
+
+func (i *instrumentedAPI) ReportTrial(ctx context.Context, u string, values experimentsv1alpha1.TrialValues) error {
+	defer ObserveAPIRequest("ReportTrial", time.Now())
+	return i.api.ReportTrial(ctx, u, values)
+}
+
+func (i *instrumentedAPI) AbandonRunningTrial(ctx context.Context, u string) error {
+	defer ObserveAPIRequest("AbandonRunningTrial", time.Now())
+	return i.api.AbandonRunningTrial(ctx, u)
+}
+
+func (i *instrumentedAPI) LabelExperiment(ctx context.Context, u string, labels experimentsv1alpha1.ExperimentLabels) error {
+	defer ObserveAPIRequest("LabelExperiment", time.Now())
+	return i.api.LabelExperiment(ctx, u, labels)
+}
+
+func (i *instrumentedAPI) LabelTrial(ctx context.Context, u string, labels experimentsv1alpha1.TrialLabels) error {
+	defer ObserveAPIRequest("LabelTrial", time.Now())
+	return i.api.LabelTrial(ctx, u, labels)
+}
@@ -17,7 +17,14 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -29,8 +36,6 @@ var (
 		Help: "Total number of reconciliation conflict errors per controller",
 	}, []string{"controller"})
 
-	// TODO Experiment is an unbounded label, that might be problematic
-
 	// ExperimentTrials is a Prometheus gauge metric which holds the total number
 	// of trials for an experiment (trial counts can go down when they are cleaned up)
 	ExperimentTrials = prometheus.NewGaugeVec(prometheus.GaugeOpts{
@@ -44,6 +49,36 @@ var (
 		Name: "redsky_experiment_active_trials_total",
 		Help: "Total number of active trials present for an experiment",
 	}, []string{"experiment"})
+
+	// TrialPhaseDuration is a Prometheus histogram metric which holds the amount
+	// of time trials spend in each phase
+	TrialPhaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "redsky_trial_phase_duration_seconds",
+		Help:    "Length of time trials spend in each phase",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+	}, []string{"phase"})
+
+	// TrialOutcomes is a Prometheus counter metric which holds the total number
+	// of finished trials by outcome and failure reason
+	TrialOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "redsky_trial_outcomes_total",
+		Help: "Total number of finished trials per outcome and failure reason",
+	}, []string{"outcome", "reason"})
+
+	// APIRequestDuration is a Prometheus histogram metric which holds the latency
+	// of requests made to the remote Red Sky API
+	APIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "redsky_api_request_duration_seconds",
+		Help:    "Latency of remote API requests per method",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// MetricCaptureAttempts is a Prometheus counter metric which holds the total
+	// number of attempts to capture a trial metric
+	MetricCaptureAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "redsky_metric_capture_attempts_total",
+		Help: "Total number of metric capture attempts per metric type and result",
+	}, []string{"type", "result"})
 )
 
 func init() {
@@ -51,5 +86,158 @@ func init() {
 		ReconcileConflictErrors,
 		ExperimentTrials,
 		ExperimentActiveTrials,
+		TrialPhaseDuration,
+		TrialOutcomes,
+		APIRequestDuration,
+		MetricCaptureAttempts,
 	)
 }
+
+// ExperimentLabelPolicy determines how experiments are identified by the "experiment" label. Experiment names are
+// unbounded, so the policy can be used to limit the number of time series the controller produces.
+type ExperimentLabelPolicy string
+
+const (
+	// ExperimentLabelName uses the experiment name as the label value
+	ExperimentLabelName ExperimentLabelPolicy = "name"
+	// ExperimentLabelNamespacedName uses the namespace and name of the experiment as the label value
+	ExperimentLabelNamespacedName ExperimentLabelPolicy = "namespaced-name"
+	// ExperimentLabelNone disables the metrics which are labeled by experiment
+	ExperimentLabelNone ExperimentLabelPolicy = "none"
+)
+
+// ExperimentLabels is the policy used when recording metrics labeled by experiment
+var ExperimentLabels = ExperimentLabelName
+
+// String returns the policy name
+func (p *ExperimentLabelPolicy) String() string {
+	return string(*p)
+}
+
+// Set changes the policy, it allows the policy to be used as a command line flag
+func (p *ExperimentLabelPolicy) Set(s string) error {
+	switch ExperimentLabelPolicy(s) {
+	case ExperimentLabelName, ExperimentLabelNamespacedName, ExperimentLabelNone:
+		*p = ExperimentLabelPolicy(s)
+		return nil
+	default:
+		return fmt.Errorf("unknown experiment label policy %q, expected one of: %s, %s, %s", s, ExperimentLabelName, ExperimentLabelNamespacedName, ExperimentLabelNone)
+	}
+}
+
+// experimentLabel returns the label value for the named experiment, if the policy disables experiment labels the
+// label value is empty and false is returned
+func experimentLabel(nn types.NamespacedName) (string, bool) {
+	switch ExperimentLabels {
+	case ExperimentLabelNone:
+		return "", false
+	case ExperimentLabelNamespacedName:
+		return nn.String(), true
+	default:
+		return nn.Name, true
+	}
+}
+
+// SetExperimentTrials records the number of total and active trials for an experiment
+func SetExperimentTrials(exp *redskyv1beta1.Experiment, total, active int) {
+	if label, ok := experimentLabel(types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}); ok {
+		ExperimentTrials.WithLabelValues(label).Set(float64(total))
+		ExperimentActiveTrials.WithLabelValues(label).Set(float64(active))
+	}
+}
+
+// DeleteExperimentMetrics stops reporting metrics for the named experiment
+func DeleteExperimentMetrics(nn types.NamespacedName) {
+	if label, ok := experimentLabel(nn); ok {
+		ExperimentTrials.DeleteLabelValues(label)
+		ExperimentActiveTrials.DeleteLabelValues(label)
+	}
+}
+
+// TrialPhase identifies a period in the life of a trial which is timed
+type TrialPhase string
+
+const (
+	// TrialPhaseSetup is the time from trial creation until the setup tasks are created
+	TrialPhaseSetup TrialPhase = "setup"
+	// TrialPhasePatch is the time from setup until the patches are applied
+	TrialPhasePatch TrialPhase = "patch"
+	// TrialPhaseReadiness is the time from patching until the trial is ready
+	TrialPhaseReadiness TrialPhase = "readiness"
+	// TrialPhaseJob is the time from the start until the completion of the trial job
+	TrialPhaseJob TrialPhase = "job"
+	// TrialPhaseMetrics is the time from completion of the trial job until the metrics are collected
+	TrialPhaseMetrics TrialPhase = "metrics"
+)
+
+// ObserveTrialPhase records the duration of a trial phase which just ended; the duration is computed from the trial
+// status so this should be called after the status reflects the end of the phase
+func ObserveTrialPhase(t *redskyv1beta1.Trial, phase TrialPhase) {
+	if start, end := phaseTimes(t, phase); !start.IsZero() && !end.IsZero() {
+		TrialPhaseDuration.WithLabelValues(string(phase)).Observe(end.Sub(start.Time).Seconds())
+	}
+}
+
+// phaseTimes returns the start and end time of a trial phase, either value may be zero if it is not yet known
+func phaseTimes(t *redskyv1beta1.Trial, phase TrialPhase) (start metav1.Time, end metav1.Time) {
+	switch phase {
+	case TrialPhaseSetup:
+		return t.CreationTimestamp, transitionTime(t, redskyv1beta1.TrialSetupCreated)
+	case TrialPhasePatch:
+		// Setup tasks are optional, fall back to the creation time
+		start = transitionTime(t, redskyv1beta1.TrialSetupCreated)
+		if start.IsZero() {
+			start = t.CreationTimestamp
+		}
+		return start, transitionTime(t, redskyv1beta1.TrialPatched)
+	case TrialPhaseReadiness:
+		return transitionTime(t, redskyv1beta1.TrialPatched), transitionTime(t, redskyv1beta1.TrialReady)
+	case TrialPhaseJob:
+		if t.Status.StartTime != nil && t.Status.CompletionTime != nil {
+			return *t.Status.StartTime, *t.Status.CompletionTime
+		}
+	case TrialPhaseMetrics:
+		if t.Status.CompletionTime != nil {
+			return *t.Status.CompletionTime, transitionTime(t, redskyv1beta1.TrialObserved)
+		}
+	}
+	return start, end
+}
+
+// transitionTime returns the time the specified condition became true or a zero time
+func transitionTime(t *redskyv1beta1.Trial, conditionType redskyv1beta1.TrialConditionType) metav1.Time {
+	for _, c := range t.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime
+		}
+	}
+	return metav1.Time{}
+}
+
+// CountTrialOutcome records the outcome of a finished trial
+func CountTrialOutcome(t *redskyv1beta1.Trial) {
+	for _, c := range t.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case redskyv1beta1.TrialFailed:
+			TrialOutcomes.WithLabelValues("failed", c.Reason).Inc()
+			return
+		case redskyv1beta1.TrialComplete:
+			TrialOutcomes.WithLabelValues("completed", "").Inc()
+			return
+		}
+	}
+}
+
+// ObserveAPIRequest records the latency of a remote API request
+func ObserveAPIRequest(method string, start time.Time) {
+	APIRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// CountMetricCapture records an attempt to capture a metric of the specified type, the result should be one of
+// "success", "failure" or "retry"
+func CountMetricCapture(metricType redskyv1beta1.MetricType, result string) {
+	MetricCaptureAttempts.WithLabelValues(string(metricType), result).Inc()
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPhaseTimes(t *testing.T) {
+	created := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	at := func(s int) metav1.Time { return metav1.NewTime(created.Add(time.Duration(s) * time.Second)) }
+	started, completed := at(30), at(90)
+
+	trial := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Status: redskyv1beta1.TrialStatus{
+			StartTime:      &started,
+			CompletionTime: &completed,
+			Conditions: []redskyv1beta1.TrialCondition{
+				{Type: redskyv1beta1.TrialSetupCreated, Status: corev1.ConditionTrue, LastTransitionTime: at(10)},
+				{Type: redskyv1beta1.TrialPatched, Status: corev1.ConditionTrue, LastTransitionTime: at(15)},
+				{Type: redskyv1beta1.TrialReady, Status: corev1.ConditionTrue, LastTransitionTime: at(25)},
+				{Type: redskyv1beta1.TrialObserved, Status: corev1.ConditionFalse, LastTransitionTime: at(95)},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc  string
+		phase TrialPhase
+		start metav1.Time
+		end   metav1.Time
+	}{
+		{
+			desc:  "setup",
+			phase: TrialPhaseSetup,
+			start: created,
+			end:   at(10),
+		},
+		{
+			desc:  "patch",
+			phase: TrialPhasePatch,
+			start: at(10),
+			end:   at(15),
+		},
+		{
+			desc:  "readiness",
+			phase: TrialPhaseReadiness,
+			start: at(15),
+			end:   at(25),
+		},
+		{
+			desc:  "job",
+			phase: TrialPhaseJob,
+			start: started,
+			end:   completed,
+		},
+		{
+			desc:  "metrics not observed",
+			phase: TrialPhaseMetrics,
+			start: completed,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			start, end := phaseTimes(trial, c.phase)
+			assert.True(t, c.start.Equal(&start), "start %s, expected %s", start, c.start)
+			assert.True(t, c.end.Equal(&end), "end %s, expected %s", end, c.end)
+		})
+	}
+}
+
+func TestExperimentLabelPolicy(t *testing.T) {
+	defer func(p ExperimentLabelPolicy) { ExperimentLabels = p }(ExperimentLabels)
+	nn := types.NamespacedName{Namespace: "default", Name: "test"}
+
+	cases := []struct {
+		desc   string
+		policy string
+		label  string
+		ok     bool
+		err    bool
+	}{
+		{
+			desc:   "name",
+			policy: "name",
+			label:  "test",
+			ok:     true,
+		},
+		{
+			desc:   "namespaced name",
+			policy: "namespaced-name",
+			label:  "default/test",
+			ok:     true,
+		},
+		{
+			desc:   "none",
+			policy: "none",
+		},
+		{
+			desc:   "unknown",
+			policy: "everything",
+			err:    true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			ExperimentLabels = ExperimentLabelName
+			err := ExperimentLabels.Set(c.policy)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			label, ok := experimentLabel(nn)
+			assert.Equal(t, c.label, label)
+			assert.Equal(t, c.ok, ok)
+		})
+	}
+}
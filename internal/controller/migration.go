@@ -0,0 +1,218 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	redskyv1alpha1 "github.com/redskyops/redskyops-controller/api/v1alpha1"
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update;patch
+
+// StorageMigration rewrites every stored experiment and trial using the current storage version. Objects which were
+// stored using the v1alpha1 representation are converted before they are written back. Once all of the objects have
+// been rewritten, the old versions are removed from the stored versions of the custom resource definitions.
+type StorageMigration struct {
+	// Client must read directly from the API server, the cache is not populated until the manager is started
+	Client client.Client
+	Log    logr.Logger
+
+	manager   manager.Manager
+	runnables []manager.Runnable
+}
+
+// Gate returns a manager that holds on to the runnables (e.g. controllers) added to it until the migration completes,
+// all other functionality is delegated to the supplied manager; the migration itself must be added to the supplied
+// manager for the held runnables to ever start
+func (m *StorageMigration) Gate(mgr manager.Manager) manager.Manager {
+	m.manager = mgr
+	return &gatedManager{Manager: mgr, migration: m}
+}
+
+// Start runs the migration and then starts any gated runnables, it allows the migration to be added to the manager
+func (m *StorageMigration) Start(<-chan struct{}) error {
+	if err := m.Migrate(context.Background()); err != nil {
+		return err
+	}
+
+	// The manager is already started, so the runnables are started as they are added
+	for _, r := range m.runnables {
+		if err := m.manager.Add(r); err != nil {
+			return err
+		}
+	}
+	m.runnables = nil
+	return nil
+}
+
+// Migrate rewrites all of the experiments and trials
+func (m *StorageMigration) Migrate(ctx context.Context) error {
+	for _, resource := range []struct {
+		kind string
+		crd  string
+		hub  func() conversion.Hub
+	}{
+		{kind: "Experiment", crd: "experiments.redskyops.dev", hub: func() conversion.Hub { return &redskyv1beta1.Experiment{} }},
+		{kind: "Trial", crd: "trials.redskyops.dev", hub: func() conversion.Hub { return &redskyv1beta1.Trial{} }},
+	} {
+		// Once the old versions are no longer stored there is nothing to rewrite
+		if migrated, err := m.migrated(ctx, resource.crd); err != nil {
+			return err
+		} else if migrated {
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(redskyv1beta1.GroupVersion.WithKind(resource.kind + "List"))
+		if err := m.Client.List(ctx, list); err != nil {
+			return err
+		}
+
+		for i := range list.Items {
+			u := &list.Items[i]
+			if err := m.convert(u, resource.hub()); err != nil {
+				return err
+			}
+
+			// Writing the object back causes the API server to store it using the storage version
+			if err := m.Client.Update(ctx, u); err != nil && !apierrs.IsConflict(err) && !apierrs.IsNotFound(err) {
+				return err
+			}
+		}
+
+		if err := m.updateStoredVersions(ctx, resource.crd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convert replaces the content of objects with the v1alpha1 representation with their converted content
+func (m *StorageMigration) convert(u *unstructured.Unstructured, hub conversion.Hub) error {
+	var spoke conversion.Convertible
+	switch hub.(type) {
+	case *redskyv1beta1.Experiment:
+		if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "template"); ok {
+			spoke = &redskyv1alpha1.Experiment{}
+		}
+	case *redskyv1beta1.Trial:
+		for _, f := range []string{"template", "patchOperations", "readinessChecks"} {
+			if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", f); ok {
+				spoke = &redskyv1alpha1.Trial{}
+			}
+		}
+	}
+
+	// Objects using the current representation are written back as-is
+	if spoke == nil {
+		return nil
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, spoke); err != nil {
+		return err
+	}
+	if err := spoke.ConvertTo(hub); err != nil {
+		return err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hub)
+	if err != nil {
+		return err
+	}
+
+	m.Log.Info("Migrating storage", "kind", u.GetKind(), "namespace", u.GetNamespace(), "name", u.GetName())
+	gvk := u.GroupVersionKind()
+	u.SetUnstructuredContent(content)
+	u.SetGroupVersionKind(gvk)
+	return nil
+}
+
+// migrated checks the stored versions of the named custom resource definition to determine if the migration has
+// already completed; if the definition cannot be read, the migration must be run
+func (m *StorageMigration) migrated(ctx context.Context, name string) (bool, error) {
+	crd, err := m.getCRD(ctx, name)
+	if err != nil {
+		return false, ignoreForbidden(err)
+	}
+	return onlyCurrentVersionStored(crd), nil
+}
+
+// updateStoredVersions removes the old versions from the status of the named custom resource definition so they
+// can eventually be dropped from the definition
+func (m *StorageMigration) updateStoredVersions(ctx context.Context, name string) error {
+	crd, err := m.getCRD(ctx, name)
+	if err != nil {
+		return ignoreForbidden(err)
+	}
+
+	if onlyCurrentVersionStored(crd) {
+		return nil
+	}
+
+	if err := unstructured.SetNestedStringSlice(crd.Object, []string{redskyv1beta1.GroupVersion.Version}, "status", "storedVersions"); err != nil {
+		return err
+	}
+	return ignoreForbidden(m.Client.Status().Update(ctx, crd))
+}
+
+// getCRD returns the named custom resource definition
+func (m *StorageMigration) getCRD(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"})
+	if err := m.Client.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+// onlyCurrentVersionStored checks if the current version is the only stored version of a custom resource definition
+func onlyCurrentVersionStored(crd *unstructured.Unstructured) bool {
+	storedVersions, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	return len(storedVersions) == 1 && storedVersions[0] == redskyv1beta1.GroupVersion.Version
+}
+
+// gatedManager is a manager that defers adding runnables until the storage migration completes
+type gatedManager struct {
+	manager.Manager
+	migration *StorageMigration
+}
+
+// Add holds the runnable until the storage migration completes
+func (g *gatedManager) Add(r manager.Runnable) error {
+	g.migration.runnables = append(g.migration.runnables, r)
+	return nil
+}
+
+// ignoreForbidden returns the supplied error, unless that error is a "forbidden" error; the migration can still
+// rewrite the objects without permission to update the custom resource definitions
+func ignoreForbidden(err error) error {
+	if apierrs.IsForbidden(err) {
+		return nil
+	}
+	return err
+}
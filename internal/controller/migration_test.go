@@ -0,0 +1,222 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	redskyv1alpha1 "github.com/redskyops/redskyops-controller/api/v1alpha1"
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestStorageMigration(t *testing.T) {
+	// The fake client can only store unstructured objects if the scheme uses unstructured types
+	scheme := runtime.NewScheme()
+	for _, kind := range []string{"Experiment", "Trial"} {
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind), &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind+"List"), &unstructured.UnstructuredList{})
+	}
+	scheme.AddKnownTypeWithName(crdGVK, &unstructured.Unstructured{})
+
+	// Simulate objects stored with the old representation but labeled with the new version
+	legacyExperiment := legacy(t, &redskyv1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"},
+		Spec: redskyv1alpha1.ExperimentSpec{
+			Template: redskyv1alpha1.TrialTemplateSpec{
+				Spec: redskyv1alpha1.TrialSpec{
+					Template: &batchv1beta1.JobTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"test": "old"}}},
+				},
+			},
+		},
+	})
+	legacyTrial := legacy(t, &redskyv1alpha1.Trial{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-001", Namespace: "default"},
+		Spec: redskyv1alpha1.TrialSpec{
+			PatchOperations: []redskyv1alpha1.PatchOperation{{TargetRef: corev1.ObjectReference{Kind: "ConfigMap", Name: "test"}}},
+		},
+	})
+	currentExperiment := current(t, &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"},
+		Spec: redskyv1beta1.ExperimentSpec{
+			TrialTemplate: redskyv1beta1.TrialTemplateSpec{
+				Spec: redskyv1beta1.TrialSpec{
+					JobTemplate: &batchv1beta1.JobTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"test": "new"}}},
+				},
+			},
+		},
+	})
+
+	m := &StorageMigration{
+		Client: fake.NewFakeClientWithScheme(scheme, legacyExperiment, legacyTrial, currentExperiment, crd("experiments.redskyops.dev"), crd("trials.redskyops.dev")),
+		Log:    log.NullLogger{},
+	}
+	require.NoError(t, m.Migrate(context.TODO()))
+
+	exp := &redskyv1beta1.Experiment{}
+	get(t, m, "old", exp)
+	if assert.NotNil(t, exp.Spec.TrialTemplate.Spec.JobTemplate) {
+		assert.Equal(t, "old", exp.Spec.TrialTemplate.Spec.JobTemplate.Labels["test"])
+	}
+
+	exp = &redskyv1beta1.Experiment{}
+	get(t, m, "new", exp)
+	if assert.NotNil(t, exp.Spec.TrialTemplate.Spec.JobTemplate) {
+		assert.Equal(t, "new", exp.Spec.TrialTemplate.Spec.JobTemplate.Labels["test"])
+	}
+
+	trial := &redskyv1beta1.Trial{}
+	get(t, m, "old-001", trial)
+	if assert.Len(t, trial.Status.PatchOperations, 1) {
+		assert.Equal(t, "test", trial.Status.PatchOperations[0].TargetRef.Name)
+	}
+
+	for _, name := range []string{"experiments.redskyops.dev", "trials.redskyops.dev"} {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(crdGVK)
+		require.NoError(t, m.Client.Get(context.TODO(), types.NamespacedName{Name: name}, u))
+		storedVersions, _, _ := unstructured.NestedStringSlice(u.Object, "status", "storedVersions")
+		assert.Equal(t, []string{"v1beta1"}, storedVersions)
+	}
+}
+
+func TestStorageMigration_Migrated(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, kind := range []string{"Experiment", "Trial"} {
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind), &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind+"List"), &unstructured.UnstructuredList{})
+	}
+	scheme.AddKnownTypeWithName(crdGVK, &unstructured.Unstructured{})
+
+	legacyExperiment := legacy(t, &redskyv1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"},
+		Spec: redskyv1alpha1.ExperimentSpec{
+			Template: redskyv1alpha1.TrialTemplateSpec{
+				Spec: redskyv1alpha1.TrialSpec{
+					Template: &batchv1beta1.JobTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"test": "old"}}},
+				},
+			},
+		},
+	})
+	experiments, trials := crd("experiments.redskyops.dev"), crd("trials.redskyops.dev")
+	_ = unstructured.SetNestedStringSlice(experiments.Object, []string{"v1beta1"}, "status", "storedVersions")
+	_ = unstructured.SetNestedStringSlice(trials.Object, []string{"v1beta1"}, "status", "storedVersions")
+
+	m := &StorageMigration{
+		Client: fake.NewFakeClientWithScheme(scheme, legacyExperiment, experiments, trials),
+		Log:    log.NullLogger{},
+	}
+	require.NoError(t, m.Migrate(context.TODO()))
+
+	// Nothing should have been rewritten
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(redskyv1beta1.GroupVersion.WithKind("Experiment"))
+	require.NoError(t, m.Client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "old"}, u))
+	_, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "template")
+	assert.True(t, ok)
+}
+
+func TestStorageMigration_Gate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, kind := range []string{"Experiment", "Trial"} {
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind), &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(redskyv1beta1.GroupVersion.WithKind(kind+"List"), &unstructured.UnstructuredList{})
+	}
+	scheme.AddKnownTypeWithName(crdGVK, &unstructured.Unstructured{})
+
+	mgr := &runnableRecorder{}
+	m := &StorageMigration{
+		Client: fake.NewFakeClientWithScheme(scheme, crd("experiments.redskyops.dev"), crd("trials.redskyops.dev")),
+		Log:    log.NullLogger{},
+	}
+
+	// Runnables added through the gate must not be added to the manager until the migration completes
+	gated := m.Gate(mgr)
+	require.NoError(t, gated.Add(manager.RunnableFunc(func(<-chan struct{}) error { return nil })))
+	assert.Empty(t, mgr.runnables)
+
+	require.NoError(t, m.Start(nil))
+	assert.Len(t, mgr.runnables, 1)
+}
+
+// runnableRecorder is a manager that only records the runnables added to it
+type runnableRecorder struct {
+	manager.Manager
+	runnables []manager.Runnable
+}
+
+func (r *runnableRecorder) Add(runnable manager.Runnable) error {
+	r.runnables = append(r.runnables, runnable)
+	return nil
+}
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}
+
+// crd returns a custom resource definition which has stored both versions
+func crd(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(crdGVK)
+	u.SetName(name)
+	_ = unstructured.SetNestedStringSlice(u.Object, []string{"v1alpha1", "v1beta1"}, "status", "storedVersions")
+	return u
+}
+
+// legacy returns an unstructured object with the v1alpha1 representation and the v1beta1 version
+func legacy(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	kind := "Experiment"
+	if _, ok := obj.(*redskyv1alpha1.Trial); ok {
+		kind = "Trial"
+	}
+	return toUnstructured(t, obj, kind)
+}
+
+// current returns an unstructured experiment with the v1beta1 representation
+func current(t *testing.T, obj *redskyv1beta1.Experiment) *unstructured.Unstructured {
+	return toUnstructured(t, obj, "Experiment")
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object, kind string) *unstructured.Unstructured {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	result := &unstructured.Unstructured{Object: u}
+	result.SetGroupVersionKind(redskyv1beta1.GroupVersion.WithKind(kind))
+	return result
+}
+
+// get reads the named object from the fake client into the supplied typed object
+func get(t *testing.T, m *StorageMigration, name string, obj runtime.Object) {
+	kind := "Experiment"
+	if _, ok := obj.(*redskyv1beta1.Trial); ok {
+		kind = "Trial"
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(redskyv1beta1.GroupVersion.WithKind(kind))
+	require.NoError(t, m.Client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: name}, u))
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj))
+}
@@ -17,10 +17,6 @@ limitations under the License.
 package controller
 
 import (
-	"path"
-	"runtime"
-	"strings"
-
 	redskyapi "github.com/redskyops/redskyops-controller/redskyapi/experiments/v1alpha1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,45 +36,14 @@ func RequeueIfUnavailable(err error) (*ctrl.Result, error) {
 	return result, err
 }
 
-// RequeueConflict will return a new result and the supplied error, adjusted for Kubernetes conflict errors
-func RequeueConflict(err error) (*ctrl.Result, error) {
-	return requeueConflictForFrameStack(err, getFrames())
-}
-
-func requeueConflictForFrameStack(err error, fs frameStack) (*ctrl.Result, error) {
+// RequeueConflict will return a new result and the supplied error, adjusted for Kubernetes conflict errors; conflicts
+// are counted using the supplied controller name
+func RequeueConflict(err error, controllerName string) (*ctrl.Result, error) {
 	result := &ctrl.Result{}
 	if apierrs.IsConflict(err) {
-		controllerName := guessController(fs)
 		ReconcileConflictErrors.WithLabelValues(controllerName).Inc()
 		result.Requeue = true
 		err = nil
 	}
 	return result, err
 }
-
-// guessController dumps stack to try and guess what the controller name should be
-func guessController(frames frameStack) string {
-	for {
-		frame, more := frames.Next()
-		if path.Base(path.Dir(frame.File)) == "controllers" {
-			p := strings.SplitN(path.Base(frame.File), "_", 2)
-			return p[0]
-		}
-		if !more {
-			break
-		}
-	}
-	return "controller"
-}
-
-type frameStack interface {
-	Next() (runtime.Frame, bool)
-}
-
-func getFrames() frameStack {
-	pc := make([]uintptr, 3)
-	if n := runtime.Callers(3, pc); n > 0 {
-		return runtime.CallersFrames(pc[:n])
-	}
-	return nil
-}
@@ -18,7 +18,6 @@ package controller
 
 import (
 	"fmt"
-	"runtime"
 	"testing"
 
 	redskyapi "github.com/redskyops/redskyops-controller/redskyapi/experiments/v1alpha1"
@@ -28,71 +27,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-type testFrameStack struct {
-	files []string
-	idx   int
-}
-
-func (tfs *testFrameStack) Next() (runtime.Frame, bool) {
-	defer func() {
-		tfs.idx++
-	}()
-
-	more := len(tfs.files) > (tfs.idx + 1)
-
-	return runtime.Frame{
-		File: tfs.files[tfs.idx],
-	}, more
-}
-
-func TestGuessController(t *testing.T) {
-	cases := []struct {
-		desc string
-		name string
-		fs   frameStack
-	}{
-		{
-			desc: "no controllers",
-			name: "controller",
-			fs: &testFrameStack{
-				files: []string{
-					"/a/b/c.go",
-					"/e/f/g.go",
-					"/h/i/j.go",
-				},
-			},
-		},
-		{
-			desc: "controllers",
-			name: "111",
-			fs: &testFrameStack{
-				files: []string{
-					"/a/b/c.go",
-					"/e/f/g.go",
-					"/h/controllers/111_controller.go",
-				},
-			},
-		},
-	}
-	for _, c := range cases {
-		t.Run(c.desc, func(t *testing.T) {
-			name := guessController(c.fs)
-			assert.Equal(t, c.name, name)
-		})
-	}
-}
-
-func TestGetFrames(t *testing.T) {
-	f, more := getFrames().Next()
-	assert.True(t, more)
-	assert.Equal(t, "testing.tRunner", f.Function)
-}
-
-func TestRequeueConflictForFrameStack(t *testing.T) {
+func TestRequeueConflict(t *testing.T) {
 	cases := []struct {
 		desc        string
 		err         error
-		fs          frameStack
 		result      *ctrl.Result
 		expectedErr error
 	}{
@@ -109,11 +47,6 @@ func TestRequeueConflictForFrameStack(t *testing.T) {
 					Reason: metav1.StatusReasonConflict,
 				},
 			},
-			fs: &testFrameStack{
-				files: []string{
-					"/a/b/c.go",
-				},
-			},
 			result: &ctrl.Result{
 				Requeue: true,
 			},
@@ -122,7 +55,7 @@ func TestRequeueConflictForFrameStack(t *testing.T) {
 	}
 	for _, c := range cases {
 		t.Run(c.desc, func(t *testing.T) {
-			result, err := requeueConflictForFrameStack(c.err, c.fs)
+			result, err := RequeueConflict(c.err, "test")
 			assert.Equal(t, c.result, result)
 			assert.Equal(t, c.expectedErr, err)
 		})
@@ -133,7 +66,6 @@ func TestRequeueIfUnavailable(t *testing.T) {
 	cases := []struct {
 		desc        string
 		err         error
-		fs          frameStack
 		result      *ctrl.Result
 		expectedErr error
 	}{
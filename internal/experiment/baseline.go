@@ -0,0 +1,57 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// ApplyBaselineLabel labels the trial as the baseline if the assignments match the baseline of the experiment and the
+// baseline trial has not already been created; returns true if the label was applied, in which case the experiment
+// status is also updated and must be saved before the trial is created
+func ApplyBaselineLabel(exp *redskyv1beta1.Experiment, t *redskyv1beta1.Trial) bool {
+	baseline := exp.BaselineAssignments()
+	if baseline == nil || exp.Status.BaselineCreated {
+		return false
+	}
+
+	// Parameters omitted from the suggestion (e.g. because they only have a single value) are not compared
+	for _, b := range baseline {
+		if v, ok := t.GetAssignment(b.Name); ok && !sameValue(v.String(), b.Value.String()) {
+			return false
+		}
+	}
+
+	if t.Labels == nil {
+		t.Labels = make(map[string]string)
+	}
+	t.Labels[redskyv1beta1.LabelBaseline] = "true"
+	exp.Status.BaselineCreated = true
+	return true
+}
+
+// sameValue compares two assignment values, numerically if possible
+func sameValue(a, b string) bool {
+	if a == b {
+		return true
+	}
+	af, aerr := strconv.ParseFloat(a, 64)
+	bf, berr := strconv.ParseFloat(b, 64)
+	return aerr == nil && berr == nil && af == bf
+}
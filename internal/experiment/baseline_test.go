@@ -0,0 +1,91 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyBaselineLabel(t *testing.T) {
+	one := redskyv1beta1.FromInt64(1)
+	half := redskyv1beta1.FromString("0.5")
+	exp := &redskyv1beta1.Experiment{
+		Spec: redskyv1beta1.ExperimentSpec{
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(5), Baseline: &one},
+				{Name: "two", Min: redskyv1beta1.FromString("0"), Max: redskyv1beta1.FromString("1"), Baseline: &half},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc        string
+		exp         *redskyv1beta1.Experiment
+		assignments []redskyv1beta1.Assignment
+		expected    bool
+	}{
+		{
+			desc: "no baseline",
+			exp:  &redskyv1beta1.Experiment{},
+		},
+		{
+			desc: "baseline",
+			exp:  exp,
+			assignments: []redskyv1beta1.Assignment{
+				{Name: "one", Value: redskyv1beta1.FromInt64(1)},
+				{Name: "two", Value: redskyv1beta1.FromString("0.50")},
+			},
+			expected: true,
+		},
+		{
+			desc: "not baseline",
+			exp:  exp,
+			assignments: []redskyv1beta1.Assignment{
+				{Name: "one", Value: redskyv1beta1.FromInt64(2)},
+				{Name: "two", Value: redskyv1beta1.FromString("0.5")},
+			},
+		},
+		{
+			desc: "existing baseline",
+			exp: &redskyv1beta1.Experiment{
+				Spec:   exp.Spec,
+				Status: redskyv1beta1.ExperimentStatus{BaselineCreated: true},
+			},
+			assignments: []redskyv1beta1.Assignment{
+				{Name: "one", Value: redskyv1beta1.FromInt64(1)},
+				{Name: "two", Value: redskyv1beta1.FromString("0.5")},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			trial := &redskyv1beta1.Trial{Spec: redskyv1beta1.TrialSpec{Assignments: c.assignments}}
+			e := c.exp.DeepCopy()
+			actual := ApplyBaselineLabel(e, trial)
+			assert.Equal(t, c.expected, actual)
+			assert.Equal(t, c.expected || c.exp.Status.BaselineCreated, e.Status.BaselineCreated)
+			if c.expected {
+				assert.Equal(t, "true", trial.Labels[redskyv1beta1.LabelBaseline])
+			} else {
+				assert.NotContains(t, trial.Labels, redskyv1beta1.LabelBaseline)
+			}
+		})
+	}
+}
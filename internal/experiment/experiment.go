@@ -17,9 +17,15 @@ limitations under the License.
 package experiment
 
 import (
+	"sort"
+	"time"
+
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/redskyops/redskyops-controller/internal/controller"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
 	"github.com/redskyops/redskyops-controller/internal/trial"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -39,7 +45,8 @@ const (
 	PhaseIdle = "Idle"
 	// PhaseRunning indicates that there are actively running trials for the experiment
 	PhaseRunning = "Running"
-	// PhaseCompleted indicates that the experiment has exhausted it's trial budget and is no longer expecting new trials
+	// PhaseCompleted indicates that the experiment has exhausted it's trial budget (or met it's stopping criteria) and is
+	// no longer expecting new trials
 	PhaseCompleted = "Completed"
 	// PhaseDeleted indicates that the experiment has been deleted and is waiting for trials to be cleaned up
 	PhaseDeleted = "Deleted"
@@ -48,20 +55,24 @@ const (
 // UpdateStatus will ensure the experiment's status matches what is in the supplied trial list; returns true only if
 // changes were necessary
 func UpdateStatus(exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialList) bool {
-	// Count the active trials
-	activeTrials := int32(0)
-	for i := range trialList.Items {
-		t := &trialList.Items[i]
-		if trial.IsActive(t) && !trial.IsAbandoned(t) {
-			activeTrials++
-		}
+	// Count the trials
+	now := time.Now()
+	activeTrials, dirty := countTrials(exp, trialList.Items, now)
+
+	// Evaluate the stopping criteria, an experiment that was stopped remains stopped until it is scaled back up
+	reason := StopReason(exp, trialList.Items, now)
+	if reason == "" && exp.Replicas() == 0 {
+		reason = exp.Status.Reason
+	} else if reason != "" && exp.Replicas() > 0 {
+		exp.SetReplicas(0)
+		dirty = true
 	}
 
 	// Determine the phase
-	phase := summarize(exp, activeTrials, len(trialList.Items))
+	exhausted := optimizer.IsLocal(exp) && optimizer.Exhausted(exp)
+	phase := summarize(exp, activeTrials, len(trialList.Items), exhausted || reason != "")
 
 	// Update the status object
-	var dirty bool
 	if exp.Status.Phase != phase {
 		exp.Status.Phase = phase
 		dirty = true
@@ -70,17 +81,215 @@ func UpdateStatus(exp *redskyv1beta1.Experiment, trialList *redskyv1beta1.TrialL
 		exp.Status.ActiveTrials = activeTrials
 		dirty = true
 	}
+	if exp.Status.Reason != reason {
+		exp.Status.Reason = reason
+		dirty = true
+	}
+
+	// Update the conditions
+	transitionTime := metav1.NewTime(now)
+	dirty = ApplyCondition(&exp.Status, redskyv1beta1.ExperimentRunning, conditionStatus(activeTrials > 0), "", "", &transitionTime) || dirty
+	dirty = ApplyCondition(&exp.Status, redskyv1beta1.ExperimentComplete, conditionStatus(phase == PhaseCompleted), reason, "", &transitionTime) || dirty
 
 	// If we made a change, record this in the metric gauges
 	if dirty {
-		controller.ExperimentTrials.WithLabelValues(exp.Name).Set(float64(len(trialList.Items)))
-		controller.ExperimentActiveTrials.WithLabelValues(exp.Name).Set(float64(activeTrials))
+		controller.SetExperimentTrials(exp, len(trialList.Items), int(activeTrials))
 		return true
 	}
 	return false
 }
 
-func summarize(exp *redskyv1beta1.Experiment, activeTrials int32, totalTrials int) string {
+// HasUncountedTrials checks to see if the outcome of any of the supplied trials was decided but is not yet included in
+// the trial counts of the experiment status
+func HasUncountedTrials(exp *redskyv1beta1.Experiment, trials []redskyv1beta1.Trial) bool {
+	for i := range trials {
+		if ot := outcomeTime(&trials[i]); !ot.IsZero() && !isCounted(exp, ot) {
+			return true
+		}
+	}
+	return false
+}
+
+// countTrials returns the number of active trials and accumulates the outcome of trials which finished since the last
+// count into the experiment status (including the state used to evaluate the stopping criteria); the counts never
+// decrease, even when finished trials are deleted. Each trial is
+// counted once using the time its outcome was decided: outcomes are only counted up to the start of the current second
+// (condition times only have second precision), so trials finishing in the same second are never split across counts.
+func countTrials(exp *redskyv1beta1.Experiment, trials []redskyv1beta1.Trial, now time.Time) (int32, bool) {
+	var activeTrials int32
+	var finished []*redskyv1beta1.Trial
+	until := metav1.NewTime(now.Truncate(time.Second))
+	for i := range trials {
+		t := &trials[i]
+		if trial.IsActive(t) && !trial.IsAbandoned(t) {
+			activeTrials++
+		}
+
+		if ot := outcomeTime(t); !ot.IsZero() && !isCounted(exp, ot) && ot.Before(&until) {
+			finished = append(finished, t)
+		}
+	}
+
+	// Experiments without a single optimized metric do not have a best trial
+	m := bestTrialMetric(exp)
+	dirty := m == nil && exp.Status.BestTrial != nil
+	if dirty {
+		exp.Status.BestTrial = nil
+	}
+
+	if len(finished) == 0 {
+		return activeTrials, dirty
+	}
+
+	// Accumulate the outcomes in the order they were decided
+	sort.SliceStable(finished, func(i, j int) bool {
+		oi, oj := outcomeTime(finished[i]), outcomeTime(finished[j])
+		if oi.Equal(&oj) {
+			return finished[i].CreationTimestamp.Before(&finished[j].CreationTimestamp)
+		}
+		return oi.Before(&oj)
+	})
+	for _, t := range finished {
+		switch {
+		case trial.NeedsRetry(t):
+			// The failure is replaced by a retry of the same assignments, only the outcome of the retry is counted
+		case trial.IsAbandoned(t):
+			exp.Status.AbandonedTrials++
+		case trial.IsFailed(t):
+			exp.Status.FailedTrials++
+			if infeasible(t) {
+				exp.Status.ConsecutiveFailures = 0
+			} else {
+				exp.Status.ConsecutiveFailures++
+			}
+		default:
+			exp.Status.SucceededTrials++
+			exp.Status.ConsecutiveFailures = 0
+			if m == nil {
+				continue
+			}
+			if isBetter(m, t.Spec.Values, exp.Status.BestTrial) {
+				exp.Status.BestTrial = &redskyv1beta1.BestTrialStatus{
+					Name:        t.Name,
+					Namespace:   t.Namespace,
+					Assignments: t.Spec.Assignments,
+					Values:      t.Spec.Values,
+				}
+				exp.Status.TrialsWithoutImprovement = 0
+			} else if _, ok := trial.Value(t.Spec.Values, m.Name); ok {
+				exp.Status.TrialsWithoutImprovement++
+			}
+		}
+	}
+	exp.Status.TrialsCountedUntil = &until
+	return activeTrials, true
+}
+
+// outcomeTime returns the time the outcome of a trial was decided: the time it finished or, for trials deleted before
+// they finished, the time it was deleted; a zero time is returned for trials which are still running
+func outcomeTime(t *redskyv1beta1.Trial) metav1.Time {
+	if trial.IsAbandoned(t) {
+		return *t.GetDeletionTimestamp()
+	}
+	return finishTime(t)
+}
+
+// isCounted checks to see if an outcome decided at the supplied time is included in the trial counts
+func isCounted(exp *redskyv1beta1.Experiment, ot metav1.Time) bool {
+	return exp.Status.TrialsCountedUntil != nil && ot.Before(exp.Status.TrialsCountedUntil)
+}
+
+// ApplyCondition updates the status of an existing condition or adds it if it does not exist; returns true only if
+// changes were necessary
+func ApplyCondition(status *redskyv1beta1.ExperimentStatus, conditionType redskyv1beta1.ExperimentConditionType, conditionStatus corev1.ConditionStatus, reason, message string, time *metav1.Time) bool {
+	// Make sure we have a time
+	if time == nil {
+		now := metav1.Now()
+		time = &now
+	}
+
+	// Update an existing condition
+	for i := range status.Conditions {
+		c := &status.Conditions[i]
+		if c.Type != conditionType {
+			continue
+		}
+
+		if c.Status != conditionStatus {
+			// Status change, record the transition
+			c.Status = conditionStatus
+			c.LastTransitionTime = *time
+		} else if c.Reason == reason && c.Message == message {
+			// Nothing changed
+			return false
+		}
+		c.Reason = reason
+		c.Message = message
+		return true
+	}
+
+	// Condition does not exist
+	status.Conditions = append(status.Conditions, redskyv1beta1.ExperimentCondition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *time,
+	})
+	return true
+}
+
+// CheckCondition checks to see if a condition has a specific status
+func CheckCondition(status *redskyv1beta1.ExperimentStatus, conditionType redskyv1beta1.ExperimentConditionType, conditionStatus corev1.ConditionStatus) bool {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return status.Conditions[i].Status == conditionStatus
+		}
+	}
+
+	// If the condition we are looking for *is* unknown, then we did "find" it
+	return conditionStatus == corev1.ConditionUnknown
+}
+
+// conditionStatus converts a boolean into a condition status
+func conditionStatus(b bool) corev1.ConditionStatus {
+	if b {
+		return corev1.ConditionTrue
+	}
+	return corev1.ConditionFalse
+}
+
+// bestTrialMetric returns the metric used to determine the best trial, only experiments with a single optimized metric
+// have a best trial
+func bestTrialMetric(exp *redskyv1beta1.Experiment) *redskyv1beta1.Metric {
+	var m *redskyv1beta1.Metric
+	for i := range exp.Spec.Metrics {
+		if !exp.Spec.Metrics[i].IsOptimized() {
+			continue
+		}
+		if m != nil {
+			return nil
+		}
+		m = &exp.Spec.Metrics[i]
+	}
+	return m
+}
+
+// isBetter checks to see if the supplied values are an improvement over the best trial; the best trial retains its
+// values after it is deleted so it is only replaced by something better
+func isBetter(m *redskyv1beta1.Metric, values []redskyv1beta1.Value, best *redskyv1beta1.BestTrialStatus) bool {
+	v, ok := trial.Value(values, m.Name)
+	if !ok {
+		return false
+	}
+	if best == nil {
+		return true
+	}
+	bv, ok := trial.Value(best.Values, m.Name)
+	return !ok || (m.Minimize && v < bv) || (!m.Minimize && v > bv)
+}
+
+func summarize(exp *redskyv1beta1.Experiment, activeTrials int32, totalTrials int, stopped bool) string {
 	remote := exp.Annotations[redskyv1beta1.AnnotationExperimentURL] != "" // TODO Or check for the server finalizer?
 
 	if !exp.GetDeletionTimestamp().IsZero() {
@@ -91,6 +300,10 @@ func summarize(exp *redskyv1beta1.Experiment, activeTrials int32, totalTrials in
 		return PhaseRunning
 	}
 
+	if stopped {
+		return PhaseCompleted
+	}
+
 	if exp.Replicas() == 0 {
 		if remote && exp.Annotations[redskyv1beta1.AnnotationNextTrialURL] == "" {
 			return PhaseCompleted
@@ -18,9 +18,11 @@ package experiment
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,33 +35,105 @@ func TestSummarize(t *testing.T) {
 
 	// Initial phase is "empty" or created
 	setupExperiment(exp, nil, "", "", nil)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhaseEmpty))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhaseEmpty))
 	setupExperiment(exp, nil, "http://example.com/experiment", "", nil)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhaseCreated))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhaseCreated))
 
 	// Once deleted, phase should ignore replica/active trials
 	setupExperiment(exp, nil, "", "", &now)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhaseDeleted))
-	g.Expect(summarize(exp, 1, 0)).To(Equal(PhaseDeleted))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhaseDeleted))
+	g.Expect(summarize(exp, 1, 0, false)).To(Equal(PhaseDeleted))
 	setupExperiment(exp, &paused, "", "", &now)
-	g.Expect(summarize(exp, 1, 0)).To(Equal(PhaseDeleted))
+	g.Expect(summarize(exp, 1, 0, false)).To(Equal(PhaseDeleted))
 
 	// Even when paused, phase should reflect active trials
 	setupExperiment(exp, &paused, "", "", nil)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhasePaused))
-	g.Expect(summarize(exp, 1, 0)).To(Equal(PhaseRunning))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhasePaused))
+	g.Expect(summarize(exp, 1, 0, false)).To(Equal(PhaseRunning))
 
 	// When the experiment is synchronized remotely the "paused" state accounts for the experiment exceeding the budget
 	setupExperiment(exp, &paused, "http://example.com/experiment", "", nil)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhaseCompleted))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhaseCompleted))
 	setupExperiment(exp, &paused, "http://example.com/experiment", "http://example.com/experiment/next", nil)
-	g.Expect(summarize(exp, 0, 0)).To(Equal(PhasePaused))
+	g.Expect(summarize(exp, 0, 0, false)).To(Equal(PhasePaused))
 
 	// Idle occurs when there are trials
 	setupExperiment(exp, nil, "", "", nil)
-	g.Expect(summarize(exp, 0, 1)).To(Equal(PhaseIdle))
+	g.Expect(summarize(exp, 0, 1, false)).To(Equal(PhaseIdle))
 	setupExperiment(exp, nil, "http://example.com/experiment", "", nil)
-	g.Expect(summarize(exp, 0, 1)).To(Equal(PhaseIdle))
+	g.Expect(summarize(exp, 0, 1, false)).To(Equal(PhaseIdle))
+
+	// A local optimizer with no more suggestions is completed once the active trials finish
+	setupExperiment(exp, nil, "", "", nil)
+	g.Expect(summarize(exp, 0, 1, true)).To(Equal(PhaseCompleted))
+	g.Expect(summarize(exp, 1, 1, true)).To(Equal(PhaseRunning))
+}
+
+func TestUpdateStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ref := time.Now().Add(-time.Hour)
+	exp := &redskyv1beta1.Experiment{}
+	exp.Spec.Metrics = []redskyv1beta1.Metric{{Name: "cost", Minimize: true}}
+	trialList := &redskyv1beta1.TrialList{
+		Items: []redskyv1beta1.Trial{
+			completedTrial(ref, 1, "5"),
+			completedTrial(ref, 2, "3"),
+			failedTrial(ref, 3, ""),
+			{},
+		},
+	}
+	trialList.Items[0].Name = "one"
+	trialList.Items[1].Name = "two"
+	trialList.Items[2].Name = "three"
+	trialList.Items[3].Name = "four"
+	trialList.Items[3].DeletionTimestamp = &metav1.Time{Time: ref.Add(4 * time.Minute)}
+
+	g.Expect(UpdateStatus(exp, trialList)).To(BeTrue())
+	g.Expect(exp.Status.SucceededTrials).To(Equal(int32(2)))
+	g.Expect(exp.Status.FailedTrials).To(Equal(int32(1)))
+	g.Expect(exp.Status.AbandonedTrials).To(Equal(int32(1)))
+	g.Expect(exp.Status.ActiveTrials).To(Equal(int32(0)))
+	g.Expect(exp.Status.TrialsCountedUntil).NotTo(BeNil())
+	g.Expect(exp.Status.BestTrial).NotTo(BeNil())
+	g.Expect(exp.Status.BestTrial.Name).To(Equal("two"))
+	g.Expect(CheckCondition(&exp.Status, redskyv1beta1.ExperimentRunning, corev1.ConditionFalse)).To(BeTrue())
+	g.Expect(CheckCondition(&exp.Status, redskyv1beta1.ExperimentComplete, corev1.ConditionFalse)).To(BeTrue())
+	g.Expect(HasUncountedTrials(exp, trialList.Items)).To(BeFalse())
+
+	// Nothing changed, nothing to update
+	g.Expect(UpdateStatus(exp, trialList)).To(BeFalse())
+
+	// The counts and best trial (including its values) are retained after trials are cleaned up
+	trialList.Items = trialList.Items[:1]
+	g.Expect(UpdateStatus(exp, trialList)).To(BeFalse())
+	g.Expect(exp.Status.SucceededTrials).To(Equal(int32(2)))
+	g.Expect(exp.Status.FailedTrials).To(Equal(int32(1)))
+	g.Expect(exp.Status.AbandonedTrials).To(Equal(int32(1)))
+	g.Expect(exp.Status.BestTrial.Name).To(Equal("two"))
+	g.Expect(exp.Status.BestTrial.Values).To(Equal([]redskyv1beta1.Value{{Name: "cost", Value: "3"}}))
+
+	// Trials which finish in the current second are counted once the second is over
+	now := time.Now()
+	trialList.Items = append(trialList.Items, completedTrial(now, 0, "1"))
+	trialList.Items[1].Name = "five"
+	_, dirty := countTrials(exp, trialList.Items, now.Truncate(time.Second))
+	g.Expect(dirty).To(BeFalse())
+	g.Expect(HasUncountedTrials(exp, trialList.Items)).To(BeTrue())
+	_, dirty = countTrials(exp, trialList.Items, now.Add(time.Second))
+	g.Expect(dirty).To(BeTrue())
+	g.Expect(exp.Status.SucceededTrials).To(Equal(int32(3)))
+	g.Expect(exp.Status.BestTrial.Name).To(Equal("five"))
+	g.Expect(HasUncountedTrials(exp, trialList.Items)).To(BeFalse())
+
+	// Trials are only counted once
+	_, dirty = countTrials(exp, trialList.Items, now.Add(2*time.Second))
+	g.Expect(dirty).To(BeFalse())
+	g.Expect(exp.Status.SucceededTrials).To(Equal(int32(3)))
+
+	// There is no best trial with multiple optimized metrics
+	exp.Spec.Metrics = append(exp.Spec.Metrics, redskyv1beta1.Metric{Name: "duration", Minimize: true})
+	g.Expect(UpdateStatus(exp, trialList)).To(BeTrue())
+	g.Expect(exp.Status.BestTrial).To(BeNil())
 }
 
 // Explicitly sets the state of the fields consider when computing the phase
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ReasonMaxTrials indicates the experiment stopped because it reached the maximum number of trials
+	ReasonMaxTrials = "MaxTrials"
+	// ReasonMaxDuration indicates the experiment stopped because it ran for the maximum duration
+	ReasonMaxDuration = "MaxDuration"
+	// ReasonMaxConsecutiveFailures indicates the experiment stopped because too many trials failed in a row
+	ReasonMaxConsecutiveFailures = "MaxConsecutiveFailures"
+	// ReasonNoImprovement indicates the experiment stopped because too many trials failed to improve the best value
+	ReasonNoImprovement = "NoImprovement"
+)
+
+// StopReason evaluates the stopping criteria of the experiment against the trial counts of the experiment status and
+// the supplied trials, returning the reason of the first criterion which is met or an empty string if the experiment
+// should continue
+func StopReason(exp *redskyv1beta1.Experiment, trials []redskyv1beta1.Trial, now time.Time) string {
+	sc := exp.Spec.StoppingCriteria
+	if sc == nil {
+		return ""
+	}
+
+	if sc.MaxTrials != nil {
+		// Deleted trials are only included in the counts, running trials (and trials which are not yet counted) are not;
+		// failures which are retried are never counted
+		count := exp.Status.SucceededTrials + exp.Status.FailedTrials
+		for i := range trials {
+			t := &trials[i]
+			if ot := outcomeTime(t); !trial.IsAbandoned(t) && !trial.NeedsRetry(t) && (ot.IsZero() || !isCounted(exp, ot)) {
+				count++
+			}
+		}
+		if count >= *sc.MaxTrials {
+			return ReasonMaxTrials
+		}
+	}
+
+	if sc.MaxDuration != nil && RemainingDuration(exp, now) <= 0 {
+		return ReasonMaxDuration
+	}
+
+	if sc.MaxConsecutiveFailures != nil && exp.Status.ConsecutiveFailures >= *sc.MaxConsecutiveFailures {
+		return ReasonMaxConsecutiveFailures
+	}
+
+	if sc.NoImprovementTrials != nil && exp.Status.TrialsWithoutImprovement >= *sc.NoImprovementTrials {
+		return ReasonNoImprovement
+	}
+
+	return ""
+}
+
+// RemainingDuration returns the amount of time left before the experiment reaches its maximum duration, a zero
+// duration is returned if there is no maximum duration or it has already elapsed
+func RemainingDuration(exp *redskyv1beta1.Experiment, now time.Time) time.Duration {
+	sc := exp.Spec.StoppingCriteria
+	if sc == nil || sc.MaxDuration == nil || exp.CreationTimestamp.IsZero() {
+		return 0
+	}
+
+	if d := exp.CreationTimestamp.Add(sc.MaxDuration.Duration).Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// finishTime returns the latest transition time of the conditions which finish a trial
+func finishTime(t *redskyv1beta1.Trial) metav1.Time {
+	ft := metav1.Time{}
+	for _, c := range t.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		if c.Type == redskyv1beta1.TrialComplete || c.Type == redskyv1beta1.TrialFailed {
+			if ft.Before(&c.LastTransitionTime) {
+				ft = c.LastTransitionTime
+			}
+		}
+	}
+	return ft
+}
+
+// infeasible checks to see if a trial failed because its metric values were out of bounds, infeasible trials ran
+// successfully and are not considered failures
+func infeasible(t *redskyv1beta1.Trial) bool {
+	for _, c := range t.Status.Conditions {
+		if c.Type == redskyv1beta1.TrialFailed && c.Status == corev1.ConditionTrue {
+			return c.Reason == trial.ReasonInfeasible
+		}
+	}
+	return false
+}
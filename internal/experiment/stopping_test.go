@@ -0,0 +1,279 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"testing"
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStopReason(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	counted := metav1.NewTime(now.Add(-time.Minute))
+	two := int32(2)
+	three := int32(3)
+
+	cases := []struct {
+		desc     string
+		criteria *redskyv1beta1.StoppingCriteria
+		status   redskyv1beta1.ExperimentStatus
+		trials   []redskyv1beta1.Trial
+		expected string
+	}{
+		{
+			desc:   "no criteria",
+			status: redskyv1beta1.ExperimentStatus{SucceededTrials: 2, ConsecutiveFailures: 2},
+		},
+		{
+			desc:     "max trials",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxTrials: &two},
+			status:   redskyv1beta1.ExperimentStatus{SucceededTrials: 1, TrialsCountedUntil: &counted},
+			trials:   []redskyv1beta1.Trial{completedTrial(now, -2), {}},
+			expected: ReasonMaxTrials,
+		},
+		{
+			desc:     "max trials not reached",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxTrials: &three},
+			status:   redskyv1beta1.ExperimentStatus{SucceededTrials: 1, TrialsCountedUntil: &counted},
+			trials:   []redskyv1beta1.Trial{completedTrial(now, -2), {}},
+		},
+		{
+			desc:     "max trials includes deleted trials",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxTrials: &three},
+			status:   redskyv1beta1.ExperimentStatus{SucceededTrials: 1, FailedTrials: 1, TrialsCountedUntil: &counted},
+			trials:   []redskyv1beta1.Trial{{}},
+			expected: ReasonMaxTrials,
+		},
+		{
+			desc:     "max trials includes trials which are not yet counted",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxTrials: &two},
+			status:   redskyv1beta1.ExperimentStatus{SucceededTrials: 1, TrialsCountedUntil: &counted},
+			trials:   []redskyv1beta1.Trial{completedTrial(now, 0)},
+			expected: ReasonMaxTrials,
+		},
+		{
+			desc:     "max duration",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxDuration: &metav1.Duration{Duration: time.Hour}},
+			expected: ReasonMaxDuration,
+		},
+		{
+			desc:     "max duration not reached",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxDuration: &metav1.Duration{Duration: 3 * time.Hour}},
+		},
+		{
+			desc:     "consecutive failures",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxConsecutiveFailures: &two},
+			status:   redskyv1beta1.ExperimentStatus{ConsecutiveFailures: 2},
+			expected: ReasonMaxConsecutiveFailures,
+		},
+		{
+			desc:     "consecutive failures not reached",
+			criteria: &redskyv1beta1.StoppingCriteria{MaxConsecutiveFailures: &two},
+			status:   redskyv1beta1.ExperimentStatus{FailedTrials: 2, ConsecutiveFailures: 1},
+		},
+		{
+			desc:     "no improvement",
+			criteria: &redskyv1beta1.StoppingCriteria{NoImprovementTrials: &two},
+			status:   redskyv1beta1.ExperimentStatus{TrialsWithoutImprovement: 2},
+			expected: ReasonNoImprovement,
+		},
+		{
+			desc:     "improvement",
+			criteria: &redskyv1beta1.StoppingCriteria{NoImprovementTrials: &two},
+			status:   redskyv1beta1.ExperimentStatus{TrialsWithoutImprovement: 1},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			exp := &redskyv1beta1.Experiment{}
+			exp.CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Hour))
+			exp.Spec.StoppingCriteria = c.criteria
+			exp.Spec.Metrics = []redskyv1beta1.Metric{{Name: "cost", Minimize: true}}
+			exp.Status = c.status
+			assert.Equal(t, c.expected, StopReason(exp, c.trials, now))
+		})
+	}
+}
+
+func TestCountTrials_StoppingState(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		desc                     string
+		trials                   []redskyv1beta1.Trial
+		consecutiveFailures      int32
+		trialsWithoutImprovement int32
+	}{
+		{
+			desc:                "consecutive failures",
+			trials:              []redskyv1beta1.Trial{failedTrial(now, -3, ""), completedTrial(now, -5), failedTrial(now, -4, "")},
+			consecutiveFailures: 2,
+		},
+		{
+			desc:                "consecutive failures interrupted",
+			trials:              []redskyv1beta1.Trial{failedTrial(now, -5, ""), completedTrial(now, -4), failedTrial(now, -3, "")},
+			consecutiveFailures: 1,
+		},
+		{
+			desc:   "infeasible is not a failure",
+			trials: []redskyv1beta1.Trial{failedTrial(now, -5, ""), failedTrial(now, -4, trial.ReasonInfeasible)},
+		},
+		{
+			desc:                     "no improvement",
+			trials:                   []redskyv1beta1.Trial{completedTrial(now, -5, "4"), completedTrial(now, -4, "5"), completedTrial(now, -3, "6")},
+			trialsWithoutImprovement: 2,
+		},
+		{
+			desc:   "improvement",
+			trials: []redskyv1beta1.Trial{completedTrial(now, -5, "5"), completedTrial(now, -4, "6"), completedTrial(now, -3, "4")},
+		},
+		{
+			desc:                     "no improvement ignores failures",
+			trials:                   []redskyv1beta1.Trial{completedTrial(now, -5, "5"), completedTrial(now, -4, "6"), failedTrial(now, -3, "")},
+			consecutiveFailures:      1,
+			trialsWithoutImprovement: 1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			exp := &redskyv1beta1.Experiment{}
+			exp.Spec.Metrics = []redskyv1beta1.Metric{{Name: "cost", Minimize: true}}
+			countTrials(exp, c.trials, now)
+			assert.Equal(t, c.consecutiveFailures, exp.Status.ConsecutiveFailures)
+			assert.Equal(t, c.trialsWithoutImprovement, exp.Status.TrialsWithoutImprovement)
+		})
+	}
+}
+
+func TestRemainingDuration(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	exp := &redskyv1beta1.Experiment{}
+	exp.CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Hour))
+	assert.Equal(t, time.Duration(0), RemainingDuration(exp, now))
+
+	exp.Spec.StoppingCriteria = &redskyv1beta1.StoppingCriteria{MaxDuration: &metav1.Duration{Duration: 3 * time.Hour}}
+	assert.Equal(t, time.Hour, RemainingDuration(exp, now))
+
+	exp.Spec.StoppingCriteria.MaxDuration.Duration = time.Hour
+	assert.Equal(t, time.Duration(0), RemainingDuration(exp, now))
+}
+
+func TestUpdateStatus_Stopped(t *testing.T) {
+	one := int32(1)
+	exp := &redskyv1beta1.Experiment{}
+	exp.Spec.StoppingCriteria = &redskyv1beta1.StoppingCriteria{MaxTrials: &one}
+	trialList := &redskyv1beta1.TrialList{Items: []redskyv1beta1.Trial{completedTrial(time.Now(), -1)}}
+	trialList.Items[0].Name = "one"
+
+	// Meeting the stopping criteria stops new trials and completes the experiment
+	assert.True(t, UpdateStatus(exp, trialList))
+	assert.Equal(t, int32(0), exp.Replicas())
+	assert.Equal(t, PhaseCompleted, exp.Status.Phase)
+	assert.Equal(t, ReasonMaxTrials, exp.Status.Reason)
+
+	// The experiment stays stopped even if the criteria are no longer met
+	exp.Spec.StoppingCriteria = nil
+	assert.False(t, UpdateStatus(exp, trialList))
+	assert.Equal(t, PhaseCompleted, exp.Status.Phase)
+
+	// Scaling the experiment back up clears the reason
+	exp.SetReplicas(1)
+	assert.True(t, UpdateStatus(exp, trialList))
+	assert.Equal(t, PhaseIdle, exp.Status.Phase)
+	assert.Empty(t, exp.Status.Reason)
+}
+
+func TestStopReason_DeletedTrials(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	five := int32(5)
+	two := int32(2)
+	exp := &redskyv1beta1.Experiment{}
+	exp.Spec.StoppingCriteria = &redskyv1beta1.StoppingCriteria{MaxTrials: &five, MaxConsecutiveFailures: &two}
+	trials := []redskyv1beta1.Trial{completedTrial(now, -2), failedTrial(now, -1, "")}
+
+	countTrials(exp, trials, now)
+	assert.Empty(t, StopReason(exp, trials, now))
+
+	// The finished trials expire, the next failure is still the second failure in a row
+	now = now.Add(time.Minute)
+	trials = []redskyv1beta1.Trial{failedTrial(now, -1, "")}
+	countTrials(exp, trials, now)
+	assert.Equal(t, int32(1), exp.Status.SucceededTrials)
+	assert.Equal(t, int32(2), exp.Status.FailedTrials)
+	assert.Equal(t, ReasonMaxConsecutiveFailures, StopReason(exp, trials, now))
+
+	// The deleted trials still count towards the maximum number of trials
+	exp.Spec.StoppingCriteria.MaxConsecutiveFailures = nil
+	trials = []redskyv1beta1.Trial{{}, {}}
+	countTrials(exp, trials, now)
+	assert.Equal(t, ReasonMaxTrials, StopReason(exp, trials, now))
+}
+
+func TestCountTrials_Retried(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	one := int32(1)
+	exp := &redskyv1beta1.Experiment{}
+	exp.Spec.StoppingCriteria = &redskyv1beta1.StoppingCriteria{MaxTrials: &one}
+	trials := []redskyv1beta1.Trial{failedTrial(now, -1, "Evicted")}
+	trials[0].Spec.RetryPolicy = &redskyv1beta1.RetryPolicy{}
+
+	// Failures which are replaced by a retry are neither counted nor considered failures
+	countTrials(exp, trials, now)
+	assert.Equal(t, int32(0), exp.Status.FailedTrials)
+	assert.Equal(t, int32(0), exp.Status.ConsecutiveFailures)
+	assert.Empty(t, StopReason(exp, trials, now))
+
+	// Once the retries are exhausted the failure is counted
+	trials[0].Annotations = map[string]string{redskyv1beta1.AnnotationRetries: "1"}
+	trials[0].Status.Conditions[0].LastTransitionTime = metav1.NewTime(now)
+	countTrials(exp, trials, now.Add(time.Second))
+	assert.Equal(t, int32(1), exp.Status.FailedTrials)
+	assert.Equal(t, int32(1), exp.Status.ConsecutiveFailures)
+	assert.Equal(t, ReasonMaxTrials, StopReason(exp, trials, now))
+}
+
+// completedTrial returns a trial which completed the specified number of minutes after the reference time
+func completedTrial(ref time.Time, minutes int, value ...string) redskyv1beta1.Trial {
+	t := redskyv1beta1.Trial{}
+	t.Status.Conditions = []redskyv1beta1.TrialCondition{{
+		Type:               redskyv1beta1.TrialComplete,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(ref.Add(time.Duration(minutes) * time.Minute)),
+	}}
+	for _, v := range value {
+		t.Spec.Values = append(t.Spec.Values, redskyv1beta1.Value{Name: "cost", Value: v})
+	}
+	return t
+}
+
+// failedTrial returns a trial which failed the specified number of minutes after the reference time
+func failedTrial(ref time.Time, minutes int, reason string) redskyv1beta1.Trial {
+	t := redskyv1beta1.Trial{}
+	t.Status.Conditions = []redskyv1beta1.TrialCondition{{
+		Type:               redskyv1beta1.TrialFailed,
+		Status:             corev1.ConditionTrue,
+		Reason:             reason,
+		LastTransitionTime: metav1.NewTime(ref.Add(time.Duration(minutes) * time.Minute)),
+	}}
+	return t
+}
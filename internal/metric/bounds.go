@@ -0,0 +1,54 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"fmt"
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// CheckBounds verifies the collected metric values satisfy the bounds on the metric definitions, the returned error
+// describes the first violated bound
+func CheckBounds(metrics []redskyv1beta1.Metric, values []redskyv1beta1.Value) error {
+	for i := range metrics {
+		m := &metrics[i]
+		if m.Min == nil && m.Max == nil {
+			continue
+		}
+
+		for _, v := range values {
+			if v.Name != m.Name {
+				continue
+			}
+
+			fv, err := strconv.ParseFloat(v.Value, 64)
+			if err != nil {
+				continue
+			}
+
+			if m.Min != nil && fv < float64(m.Min.MilliValue())/1000 {
+				return fmt.Errorf("metric '%s' value %s is less than the minimum %s", m.Name, v.Value, m.Min.String())
+			}
+			if m.Max != nil && fv > float64(m.Max.MilliValue())/1000 {
+				return fmt.Errorf("metric '%s' value %s is greater than the maximum %s", m.Name, v.Value, m.Max.String())
+			}
+		}
+	}
+	return nil
+}
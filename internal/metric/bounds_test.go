@@ -0,0 +1,76 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestCheckBounds(t *testing.T) {
+	min := resource.MustParse("10")
+	max := resource.MustParse("200m")
+	metrics := []redskyv1beta1.Metric{
+		{Name: "throughput", Min: &min},
+		{Name: "latency", Max: &max},
+		{Name: "cost"},
+	}
+
+	cases := []struct {
+		desc   string
+		values []redskyv1beta1.Value
+		err    string
+	}{
+		{
+			desc: "feasible",
+			values: []redskyv1beta1.Value{
+				{Name: "throughput", Value: "10"},
+				{Name: "latency", Value: "0.2"},
+				{Name: "cost", Value: "1000"},
+			},
+		},
+		{
+			desc: "minimum",
+			values: []redskyv1beta1.Value{
+				{Name: "throughput", Value: "9.5"},
+				{Name: "latency", Value: "0.1"},
+			},
+			err: "metric 'throughput' value 9.5 is less than the minimum 10",
+		},
+		{
+			desc: "maximum",
+			values: []redskyv1beta1.Value{
+				{Name: "throughput", Value: "20"},
+				{Name: "latency", Value: "0.25"},
+			},
+			err: "metric 'latency' value 0.25 is greater than the maximum 200m",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := CheckBounds(metrics, c.values)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testCollector returns the number of items in the target list
+type testCollector struct {
+	collector.PodTarget
+}
+
+func (c *testCollector) Capture(m *redskyv1beta1.Metric, _ *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
+	return float64(len(target.(*corev1.PodList).Items)), 0, nil
+}
+
+func TestCollector(t *testing.T) {
+	collector.Register("test", &testCollector{})
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	r := fake.NewFakeClientWithScheme(scheme,
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default", Labels: map[string]string{"app": "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default", Labels: map[string]string{"app": "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default", Labels: map[string]string{"app": "other"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "other", Labels: map[string]string{"app": "test"}}},
+	)
+
+	m := &redskyv1beta1.Metric{
+		Name:     "test",
+		Type:     "test",
+		Query:    "ignored",
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+	}
+	trial := &redskyv1beta1.Trial{}
+
+	target, err := collector.Target(context.TODO(), r, "default", m)
+	require.NoError(t, err)
+	value, stddev, err := CaptureMetric(m, trial, target)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), value)
+	assert.Equal(t, float64(0), stddev)
+}
+
+func TestForMetric_BuiltIn(t *testing.T) {
+	cases := []struct {
+		desc       string
+		metricType redskyv1beta1.MetricType
+		expected   collector.Collector
+		err        string
+	}{
+		{
+			desc:     "default",
+			expected: &localCollector{},
+		},
+		{
+			desc:       "pods",
+			metricType: redskyv1beta1.MetricPods,
+			expected:   &podsCollector{},
+		},
+		{
+			desc:       "prometheus",
+			metricType: redskyv1beta1.MetricPrometheus,
+			expected:   &prometheusCollector{},
+		},
+		{
+			desc:       "unknown",
+			metricType: "unknown",
+			err:        "unknown metric type: unknown",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, err := collector.ForMetric(&redskyv1beta1.Metric{Type: c.metricType})
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.IsType(t, c.expected, actual)
+			}
+		})
+	}
+}
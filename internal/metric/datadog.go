@@ -22,9 +22,26 @@ import (
 	"os"
 	"time"
 
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
 	datadog "github.com/zorkian/go-datadog-api"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+func init() {
+	collector.Register(redskyv1beta1.MetricDatadog, &datadogCollector{})
+}
+
+// datadogCollector queries the Datadog service over the duration of the trial run
+type datadogCollector struct {
+	collector.NoTarget
+}
+
+// Capture queries Datadog using the metric scheme as the aggregator
+func (c *datadogCollector) Capture(m *redskyv1beta1.Metric, t *redskyv1beta1.Trial, _ runtime.Object) (float64, float64, error) {
+	return captureDatadogMetric(m.Scheme, m.Query, t.Status.StartTime.Time, t.Status.CompletionTime.Time)
+}
+
 func captureDatadogMetric(aggregator, query string, startTime, completionTime time.Time) (float64, float64, error) {
 	apiKey := os.Getenv("DATADOG_API_KEY")
 	if apiKey == "" {
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/jsonpath"
 )
@@ -34,22 +35,29 @@ import (
 // TODO Combine it with the Prometheus clients?
 var httpClient = &http.Client{Timeout: 10 * time.Second}
 
-func captureJSONPathMetric(m *redskyv1beta1.Metric, target runtime.Object) (value float64, stddev float64, err error) {
-	var urls []string
+func init() {
+	collector.Register(redskyv1beta1.MetricJSONPath, &jsonPathCollector{})
+}
 
-	if urls, err = toURL(target, m); err != nil {
-		return value, stddev, err
-	}
+// jsonPathCollector evaluates a JSON path expression against a JSON resource fetched from a matched service or pod
+type jsonPathCollector struct {
+	collector.EndpointTarget
+}
 
-	for _, u := range urls {
-		if value, stddev, err = captureOneJSONPathMetric(u, m.Name, m.Query); err != nil {
-			continue
-		}
+// Capture fetches the JSON resource and evaluates the query
+func (c *jsonPathCollector) Capture(m *redskyv1beta1.Metric, _ *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
+	return captureJSONPathMetric(m, target)
+}
 
-		return value, stddev, nil
+func captureJSONPathMetric(m *redskyv1beta1.Metric, target runtime.Object) (float64, float64, error) {
+	urls, err := toURL(target, m)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return value, stddev, err
+	return captureURLs(m, urls, func(u string) (float64, float64, error) {
+		return captureOneJSONPathMetric(u, m.Name, m.Query)
+	})
 }
 
 func captureOneJSONPathMetric(url, name, query string) (float64, float64, error) {
@@ -79,14 +87,23 @@ func captureOneJSONPathMetric(url, name, query string) (float64, float64, error)
 		return 0, 0, err
 	}
 
+	value, err := evaluateJSONPath(name, query, data)
+	if err != nil {
+		return 0, 0, err
+	}
+	return value, 0, nil
+}
+
+// evaluateJSONPath evaluates a JSON path expression against generic JSON data, the result must be a single number
+func evaluateJSONPath(name, query string, data interface{}) (float64, error) {
 	// Evaluate the JSON path
 	jp := jsonpath.New(name)
 	if err := jp.Parse(query); err != nil {
-		return 0, 0, err
+		return 0, err
 	}
 	values, err := jp.FindResults(data)
 	if err != nil {
-		return 0, 0, err
+		return 0, err
 	}
 
 	// Convert the result to a float
@@ -94,18 +111,14 @@ func captureOneJSONPathMetric(url, name, query string) (float64, float64, error)
 		v := reflect.ValueOf(values[0][0].Interface())
 		switch v.Kind() {
 		case reflect.Float64:
-			return v.Float(), 0, nil
+			return v.Float(), nil
 		case reflect.String:
-			v, err := strconv.ParseFloat(v.String(), 64)
-			if err != nil {
-				return 0, 0, err
-			}
-			return v, 0, nil
+			return strconv.ParseFloat(v.String(), 64)
 		default:
-			return 0, 0, fmt.Errorf("could not convert match to a floating point number")
+			return 0, fmt.Errorf("could not convert match to a floating point number")
 		}
 	}
 
 	// If we made it this far we weren't able to extract the value
-	return 0, 0, fmt.Errorf("query '%s' did not match", query)
+	return 0, fmt.Errorf("query '%s' did not match", query)
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	collector.Register(redskyv1beta1.MetricLocal, &localCollector{})
+	collector.Register(redskyv1beta1.MetricPods, &podsCollector{})
+}
+
+// localCollector evaluates the metric query against the trial itself
+type localCollector struct {
+	collector.NoTarget
+}
+
+// Capture just parses the rendered query as a float
+func (c *localCollector) Capture(m *redskyv1beta1.Metric, _ *redskyv1beta1.Trial, _ runtime.Object) (float64, float64, error) {
+	return captureLocalMetric(m)
+}
+
+// podsCollector evaluates the metric query against the trial and the list of matching pods
+type podsCollector struct {
+	collector.PodTarget
+}
+
+// Capture just parses the rendered query as a float
+func (c *podsCollector) Capture(m *redskyv1beta1.Metric, _ *redskyv1beta1.Trial, _ runtime.Object) (float64, float64, error) {
+	return captureLocalMetric(m)
+}
+
+func captureLocalMetric(m *redskyv1beta1.Metric) (float64, float64, error) {
+	value, err := strconv.ParseFloat(m.Query, 64)
+	return value, 0, err
+}
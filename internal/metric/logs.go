@@ -0,0 +1,136 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// logsCollector extracts values from the logs of the trial run container
+type logsCollector struct {
+	collector.NoTarget
+	pods corev1client.PodsGetter
+}
+
+// NewLogsCollector returns a collector for "logs" metrics which reads the trial run logs using the supplied client
+func NewLogsCollector(pods corev1client.PodsGetter) collector.Collector {
+	return &logsCollector{pods: pods}
+}
+
+// Capture streams the trial run logs and extracts the value and error
+func (c *logsCollector) Capture(m *redskyv1beta1.Metric, t *redskyv1beta1.Trial, _ runtime.Object) (float64, float64, error) {
+	if c.pods == nil {
+		return 0, 0, fmt.Errorf("unable to read logs for metric '%s'", m.Name)
+	}
+
+	logs, err := trial.StreamRunLogs(c.pods, t, m.Container, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer logs.Close()
+
+	return captureLogsMetric(m.Name, m.Query, m.ErrorQuery, logs)
+}
+
+// captureLogsMetric evaluates the queries against each line of the logs, returning the last values found; the logs
+// are only scanned once so they do not need to fit in memory
+func captureLogsMetric(name, query, errorQuery string, logs io.Reader) (float64, float64, error) {
+	match, err := logsMatcher(name, query)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var matchError func(line []byte) (float64, bool)
+	if errorQuery != "" {
+		if matchError, err = logsMatcher(name, errorQuery); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	var value, stddev float64
+	var found, foundError bool
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if v, ok := match(line); ok {
+			value, found = v, true
+		}
+		if matchError != nil {
+			if v, ok := matchError(line); ok {
+				stddev, foundError = v, true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	if !found {
+		return 0, 0, fmt.Errorf("query '%s' did not match the logs", query)
+	}
+	if matchError != nil && !foundError {
+		return 0, 0, fmt.Errorf("query '%s' did not match the logs", errorQuery)
+	}
+	return value, stddev, nil
+}
+
+// logsMatcher returns a function which extracts a value from a single line of the logs using the supplied query
+func logsMatcher(name, query string) (func(line []byte) (float64, bool), error) {
+	if strings.HasPrefix(query, "{") && strings.HasSuffix(query, "}") {
+		// JSON path expressions are evaluated against lines which contain JSON objects
+		return func(line []byte) (float64, bool) {
+			data := make(map[string]interface{})
+			if err := json.Unmarshal(line, &data); err != nil {
+				return 0, false
+			}
+			v, err := evaluateJSONPath(name, query, data)
+			return v, err == nil
+		}, nil
+	}
+
+	// Regular expressions use the first capture group (or the whole match if there are no groups)
+	re, err := regexp.Compile(query)
+	if err != nil {
+		return nil, err
+	}
+	return func(line []byte) (float64, bool) {
+		sm := re.FindSubmatch(line)
+		if sm == nil {
+			return 0, false
+		}
+		s := sm[0]
+		if len(sm) > 1 {
+			s = sm[1]
+		}
+		v, err := strconv.ParseFloat(string(s), 64)
+		return v, err == nil
+	}, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureLogsMetric(t *testing.T) {
+	wrk := `Running 30s test @ http://frontend
+  2 threads and 10 connections
+  Thread Stats   Avg      Stdev     Max   +/- Stdev
+    Latency    12.51ms    4.12ms  60.01ms   88.52%
+Requests/sec:   1598.42
+Transfer/sec:    312.18KB
+`
+	k6 := `{"type":"Point","metric":"http_req_duration","data":{"value":10.5}}
+not json
+{"type":"Point","metric":"http_req_duration","data":{"value":"11.25"}}
+{"type":"Metric","metric":"iterations"}
+`
+
+	cases := []struct {
+		desc          string
+		query         string
+		errorQuery    string
+		logs          string
+		expected      float64
+		expectedError float64
+		err           bool
+	}{
+		{
+			desc:     "regex capture group",
+			query:    `Requests/sec:\s+([0-9.]+)`,
+			logs:     wrk,
+			expected: 1598.42,
+		},
+		{
+			desc:     "regex whole match",
+			query:    `[0-9]+\.[0-9]+`,
+			logs:     "ignored\n1.5\n2.5 seconds\n",
+			expected: 2.5,
+		},
+		{
+			desc:     "json path last match",
+			query:    `{.data.value}`,
+			logs:     k6,
+			expected: 11.25,
+		},
+		{
+			desc:          "error query",
+			query:         `Latency\s+([0-9.]+)ms`,
+			errorQuery:    `Latency\s+[0-9.]+ms\s+([0-9.]+)ms`,
+			logs:          wrk,
+			expected:      12.51,
+			expectedError: 4.12,
+		},
+		{
+			desc:       "error query no match",
+			query:      `Requests/sec:\s+([0-9.]+)`,
+			errorQuery: `Errors:\s+([0-9]+)`,
+			logs:       wrk,
+			err:        true,
+		},
+		{
+			desc:  "no match",
+			query: `Errors:\s+([0-9]+)`,
+			logs:  wrk,
+			err:   true,
+		},
+		{
+			desc:  "invalid regex",
+			query: `Requests/sec:\s+([0-9.]+`,
+			logs:  wrk,
+			err:   true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, actualError, err := captureLogsMetric("test", c.query, c.errorQuery, strings.NewReader(c.logs))
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, actual)
+				assert.Equal(t, c.expectedError, actualError)
+			}
+		})
+	}
+}
@@ -17,13 +17,17 @@ limitations under the License.
 package metric
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net"
 	"strconv"
 	"strings"
 	"time"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/redskyops/redskyops-controller/internal/template"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -46,42 +50,99 @@ func (e *CaptureError) Error() string {
 	return e.Message
 }
 
+// IsUnreachable checks to see if the supplied error occurred because the metric endpoint could not be reached
+func IsUnreachable(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
 // CaptureMetric captures a point-in-time metric value and it's error (standard deviation)
 func CaptureMetric(metric *redskyv1beta1.Metric, trial *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
+	return Capture(template.New(), metric, trial, target)
+}
+
+// Capture captures a point-in-time metric value and it's error (standard deviation) using the supplied template engine
+// to render the metric queries
+func Capture(te *template.Engine, metric *redskyv1beta1.Metric, trial *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
 	// Work on a copy so we can render the queries in place
 	metric = metric.DeepCopy()
 
 	// Execute the query as a template against the current state of the trial
 	var err error
-	if metric.Query, metric.ErrorQuery, err = template.New().RenderMetricQueries(metric, trial, target); err != nil {
+	if metric.Query, metric.ErrorQuery, err = te.RenderMetricQueries(metric, trial, target); err != nil {
 		return 0, 0, err
 	}
 
-	// Capture the value based on the metric type
-	switch metric.Type {
-	case redskyv1beta1.MetricLocal, redskyv1beta1.MetricPods, "":
-		// Just parse the query as a float
-		value, err := strconv.ParseFloat(metric.Query, 64)
-		return value, 0, err
-	case redskyv1beta1.MetricPrometheus:
-		return capturePrometheusMetric(metric, target, trial.Status.CompletionTime.Time)
-	case redskyv1beta1.MetricDatadog:
-		return captureDatadogMetric(metric.Scheme, metric.Query, trial.Status.StartTime.Time, trial.Status.CompletionTime.Time)
-	case redskyv1beta1.MetricJSONPath:
-		return captureJSONPathMetric(metric, target)
-	default:
-		return 0, 0, fmt.Errorf("unknown metric type: %s", metric.Type)
+	// Capture the value using the collector for the metric type
+	c, err := collector.ForMetric(metric)
+	if err != nil {
+		return 0, 0, err
 	}
+	return c.Capture(metric, trial, target)
 }
 
-func toURL(target runtime.Object, m *redskyv1beta1.Metric) ([]string, error) {
-	// Make sure we got a service list
-	// TODO We can probably handle a pod list by addressing it directly
-	list, ok := target.(*corev1.ServiceList)
-	if !ok {
-		return nil, fmt.Errorf("expected target to be a service list")
+// captureURLs captures a metric value from each URL; depending on the metric aggregation, either the first successfully
+// captured value is returned or all of the values are combined
+func captureURLs(m *redskyv1beta1.Metric, urls []string, capture func(string) (float64, float64, error)) (float64, float64, error) {
+	aggregation := m.Aggregation
+	if aggregation == "" && m.TargetType == redskyv1beta1.MetricTargetPods {
+		aggregation = redskyv1beta1.MetricAggregationAvg
+	}
+
+	// Without an aggregation, the first URL to produce a value wins
+	if aggregation == "" {
+		var value, stddev float64
+		var err error
+		for _, u := range urls {
+			if value, stddev, err = capture(u); err != nil {
+				continue
+			}
+			return value, stddev, nil
+		}
+		return value, stddev, err
+	}
+
+	// Capture every value so they can be combined
+	values := make([]float64, len(urls))
+	stddevs := make([]float64, len(urls))
+	for i, u := range urls {
+		var err error
+		if values[i], stddevs[i], err = capture(u); err != nil {
+			return 0, 0, err
+		}
 	}
+	return aggregate(aggregation, values, stddevs)
+}
+
+// aggregate combines a non-empty list of values (and their errors); errors are assumed to be independent
+func aggregate(aggregation redskyv1beta1.MetricAggregation, values, stddevs []float64) (float64, float64, error) {
+	switch aggregation {
+	case redskyv1beta1.MetricAggregationSum, redskyv1beta1.MetricAggregationAvg:
+		var sum, variance float64
+		for i := range values {
+			sum += values[i]
+			variance += stddevs[i] * stddevs[i]
+		}
+		if aggregation == redskyv1beta1.MetricAggregationAvg {
+			n := float64(len(values))
+			return sum / n, math.Sqrt(variance) / n, nil
+		}
+		return sum, math.Sqrt(variance), nil
+	case redskyv1beta1.MetricAggregationMax:
+		max := 0
+		for i := range values {
+			if values[i] > values[max] {
+				max = i
+			}
+		}
+		return values[max], stddevs[max], nil
+	default:
+		return 0, 0, fmt.Errorf("unknown metric aggregation: %s", aggregation)
+	}
+}
 
+// toURL returns the URLs used to collect the metric from each of the services or pods in the target list
+func toURL(target runtime.Object, m *redskyv1beta1.Metric) ([]string, error) {
 	// Get URL components
 	scheme := strings.ToLower(m.Scheme)
 	if scheme == "" {
@@ -91,14 +152,41 @@ func toURL(target runtime.Object, m *redskyv1beta1.Metric) ([]string, error) {
 	}
 	path := "/" + strings.TrimLeft(m.Path, "/")
 
-	// Construct a URL for each service (use IP literals instead of host names to avoid DNS lookups)
+	// Resolve the host and port of each target (use IP literals instead of host names to avoid DNS lookups)
+	var hostPorts []string
+	var err error
+	switch list := target.(type) {
+	case *corev1.ServiceList:
+		hostPorts, err = serviceHostPorts(list, m)
+	case *corev1.PodList:
+		hostPorts, err = podHostPorts(list, m)
+	default:
+		err = fmt.Errorf("expected target to be a service or pod list")
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	var urls []string
+	for _, hp := range hostPorts {
+		urls = append(urls, fmt.Sprintf("%s://%s%s", scheme, hp, path))
+	}
+
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("unable to find metric targets for '%s'", m.Name)
+	}
+	return urls, nil
+}
+
+// serviceHostPorts returns the cluster IP and port of each service
+func serviceHostPorts(list *corev1.ServiceList, m *redskyv1beta1.Metric) ([]string, error) {
+	var hostPorts []string
 	for _, s := range list.Items {
 		// When debugging in minikube, use `minikube tunnel` to expose the cluster IP on the host
 		// TODO How do we setup port forwarding in GCP?
 		host := s.Spec.ClusterIP
 		if host == "None" {
-			// Only actual clusterIPs are support
+			// Only actual clusterIPs are support, use a pod target type for headless services
 			continue
 		}
 		port := m.Port.IntValue()
@@ -117,11 +205,41 @@ func toURL(target runtime.Object, m *redskyv1beta1.Metric) ([]string, error) {
 			return nil, fmt.Errorf("metric '%s' has unresolvable port: %s", m.Name, m.Port.String())
 		}
 
-		urls = append(urls, fmt.Sprintf("%s://%s:%d%s", scheme, host, port, path))
+		hostPorts = append(hostPorts, net.JoinHostPort(host, strconv.Itoa(port)))
 	}
+	return hostPorts, nil
+}
 
-	if len(urls) == 0 {
-		return nil, fmt.Errorf("unable to find metric targets for '%s'", m.Name)
+// podHostPorts returns the pod IP and container port of each running pod
+func podHostPorts(list *corev1.PodList, m *redskyv1beta1.Metric) ([]string, error) {
+	var hostPorts []string
+	for _, p := range list.Items {
+		host := p.Status.PodIP
+		if host == "" || p.Status.Phase != corev1.PodRunning {
+			// Only running pods with an assigned IP can be addressed
+			continue
+		}
+		port := m.Port.IntValue()
+
+		if port < 1 {
+			portName := m.Port.StrVal
+			var ports []corev1.ContainerPort
+			for _, c := range p.Spec.Containers {
+				ports = append(ports, c.Ports...)
+			}
+			for _, cp := range ports {
+				if cp.Name == portName || len(ports) == 1 {
+					port = int(cp.ContainerPort)
+					break
+				}
+			}
+		}
+
+		if port < 1 {
+			return nil, fmt.Errorf("metric '%s' has unresolvable port: %s", m.Name, m.Port.String())
+		}
+
+		hostPorts = append(hostPorts, net.JoinHostPort(host, strconv.Itoa(port)))
 	}
-	return urls, nil
+	return hostPorts, nil
 }
@@ -33,6 +33,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestCaptureMetric(t *testing.T) {
@@ -144,8 +145,197 @@ func TestCaptureMetric(t *testing.T) {
 	}
 }
 
+func TestIsUnreachable(t *testing.T) {
+	refused := &url.Error{Op: "Get", URL: "http://prometheus:9090/api/v1/targets", Err: &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}}
+
+	assert.True(t, IsUnreachable(refused))
+	assert.True(t, IsUnreachable(fmt.Errorf("capture failed: %w", refused)))
+	assert.False(t, IsUnreachable(&CaptureError{Message: "metric data not available"}))
+	assert.False(t, IsUnreachable(fmt.Errorf("unable to find service")))
+}
+
+func TestCapturePrometheusRange(t *testing.T) {
+	now := metav1.NewTime(time.Now().Add(time.Duration(-10) * time.Minute))
+	later := metav1.NewTime(now.Add(time.Minute))
+
+	promHttpTest := prometheusHttpTestServer(1, 2, 3, 4, 5)
+	defer promHttpTest.Close()
+	purl, err := url.Parse(promHttpTest.URL)
+	require.NoError(t, err)
+	promHttpTestIP, sPort, err := net.SplitHostPort(purl.Host)
+	require.NoError(t, err)
+	promHttpTestPort, err := strconv.ParseInt(sPort, 10, 32)
+	require.NoError(t, err)
+
+	obj := &corev1.ServiceList{
+		Items: []corev1.Service{
+			{
+				Spec: corev1.ServiceSpec{
+					ClusterIP: promHttpTestIP,
+					Ports: []corev1.ServicePort{
+						{
+							Name:     "testPort",
+							Protocol: corev1.ProtocolTCP,
+							Port:     int32(promHttpTestPort),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		reducer  redskyv1beta1.MetricReducer
+		expected float64
+	}{
+		{desc: "default", expected: 3},
+		{desc: "max", reducer: redskyv1beta1.MetricReducerMax, expected: 5},
+		{desc: "min", reducer: redskyv1beta1.MetricReducerMin, expected: 1},
+		{desc: "p50", reducer: redskyv1beta1.MetricReducerP50, expected: 3},
+		{desc: "last", reducer: redskyv1beta1.MetricReducerLast, expected: 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			trial := &redskyv1beta1.Trial{
+				Status: redskyv1beta1.TrialStatus{
+					StartTime:      &now,
+					CompletionTime: &later,
+				},
+			}
+			m := &redskyv1beta1.Metric{
+				Name:    "testMetric",
+				Query:   "up",
+				Type:    redskyv1beta1.MetricPrometheus,
+				Step:    &metav1.Duration{Duration: 15 * time.Second},
+				Reducer: tc.reducer,
+			}
+
+			value, stddev, err := CaptureMetric(m, trial, obj)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+			assert.InDelta(t, 1.414, stddev, 0.001)
+		})
+	}
+}
+
+func TestCaptureMetricPods(t *testing.T) {
+	var pods []corev1.Pod
+	for _, v := range []int{5, 7, 12} {
+		ts := jsonPathHttpTestServerWithValue(v)
+		defer ts.Close()
+		u, err := url.Parse(ts.URL)
+		require.NoError(t, err)
+		ip, sPort, err := net.SplitHostPort(u.Host)
+		require.NoError(t, err)
+		port, err := strconv.ParseInt(sPort, 10, 32)
+		require.NoError(t, err)
+
+		pods = append(pods, corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: int32(port)}}}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+		})
+	}
+
+	// Pods that are not running are ignored
+	pods = append(pods, corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}})
+
+	testCases := []struct {
+		desc        string
+		aggregation redskyv1beta1.MetricAggregation
+		expected    float64
+	}{
+		{desc: "default", expected: 8},
+		{desc: "sum", aggregation: redskyv1beta1.MetricAggregationSum, expected: 24},
+		{desc: "avg", aggregation: redskyv1beta1.MetricAggregationAvg, expected: 8},
+		{desc: "max", aggregation: redskyv1beta1.MetricAggregationMax, expected: 12},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := &redskyv1beta1.Metric{
+				Name:        "testMetric",
+				Query:       "{.current_response_time_percentile_95}",
+				Type:        redskyv1beta1.MetricJSONPath,
+				TargetType:  redskyv1beta1.MetricTargetPods,
+				Aggregation: tc.aggregation,
+			}
+
+			value, _, err := CaptureMetric(m, &redskyv1beta1.Trial{}, &corev1.PodList{Items: pods})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestPodHostPorts(t *testing.T) {
+	pod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}, {Name: "metrics", ContainerPort: 9090}}},
+				{Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9091}}},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+	}
+
+	testCases := []struct {
+		desc     string
+		port     intstr.IntOrString
+		expected []string
+		err      bool
+	}{
+		{desc: "number", port: intstr.FromInt(8081), expected: []string{"10.0.0.1:8081"}},
+		{desc: "name", port: intstr.FromString("http"), expected: []string{"10.0.0.1:8080"}},
+		{desc: "first named match", port: intstr.FromString("metrics"), expected: []string{"10.0.0.1:9090"}},
+		{desc: "unknown name", port: intstr.FromString("admin"), err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := &redskyv1beta1.Metric{Name: "testMetric", Port: tc.port}
+			hostPorts, err := podHostPorts(&corev1.PodList{Items: []corev1.Pod{pod}}, m)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expected, hostPorts)
+			}
+		})
+	}
+}
+
+func prometheusHttpTestServer(values ...float64) *httptest.Server {
+	start := time.Now().Unix()
+	series := make([][]interface{}, len(values))
+	for i, v := range values {
+		series[i] = []interface{}{start + int64(i*15), strconv.FormatFloat(v, 'f', -1, 64)}
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch r.URL.Path {
+		case "/api/v1/targets":
+			data = map[string]interface{}{"activeTargets": []interface{}{}, "droppedTargets": []interface{}{}}
+		case "/api/v1/query_range":
+			data = map[string]interface{}{
+				"resultType": "matrix",
+				"result":     []interface{}{map[string]interface{}{"metric": map[string]string{}, "values": series}},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": data})
+	}))
+}
+
 func jsonPathHttpTestServer() *httptest.Server {
-	response := map[string]int{"current_response_time_percentile_95": 5}
+	return jsonPathHttpTestServerWithValue(5)
+}
+
+func jsonPathHttpTestServerWithValue(value int) *httptest.Server {
+	response := map[string]int{"current_response_time_percentile_95": value}
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		json.NewEncoder(w).Encode(response)
 		return
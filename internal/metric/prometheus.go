@@ -27,28 +27,40 @@ import (
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-func capturePrometheusMetric(m *redskyv1beta1.Metric, target runtime.Object, completionTime time.Time) (value float64, stddev float64, err error) {
-	var urls []string
+// DefaultStep is the resolution used for range queries that do not specify a step
+const DefaultStep = 15 * time.Second
 
-	if urls, err = toURL(target, m); err != nil {
-		return value, stddev, err
-	}
+func init() {
+	collector.Register(redskyv1beta1.MetricPrometheus, &prometheusCollector{})
+}
 
-	for _, u := range urls {
-		if value, stddev, err = captureOnePrometheusMetric(u, m.Query, m.ErrorQuery, completionTime); err != nil {
-			continue
-		}
+// prometheusCollector issues PromQL queries to a matched service or pod
+type prometheusCollector struct {
+	collector.EndpointTarget
+}
+
+// Capture executes the Prometheus query at the completion time of the trial or, for range queries, over the duration
+// of the trial run
+func (c *prometheusCollector) Capture(m *redskyv1beta1.Metric, t *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
+	return capturePrometheusMetric(m, target, t.Status.StartTime.Time, t.Status.CompletionTime.Time)
+}
 
-		return value, stddev, nil
+func capturePrometheusMetric(m *redskyv1beta1.Metric, target runtime.Object, startTime, completionTime time.Time) (float64, float64, error) {
+	urls, err := toURL(target, m)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return value, stddev, err
+	return captureURLs(m, urls, func(u string) (float64, float64, error) {
+		return captureOnePrometheusMetric(u, m, startTime, completionTime)
+	})
 }
 
-func captureOnePrometheusMetric(address, query, errorQuery string, completionTime time.Time) (float64, float64, error) {
+func captureOnePrometheusMetric(address string, m *redskyv1beta1.Metric, startTime, completionTime time.Time) (float64, float64, error) {
 	// Get the Prometheus client based on the metric URL
 	// TODO Cache these by URL
 	c, err := prom.NewClient(prom.Config{Address: address})
@@ -74,22 +86,20 @@ func captureOnePrometheusMetric(address, query, errorQuery string, completionTim
 		}
 	}
 
+	// Range queries are evaluated over the duration of the trial run
+	if isRangeQuery(m) {
+		r := promv1.Range{Start: startTime, End: completionTime, Step: rangeStep(m)}
+		return capturePrometheusRange(promAPI, address, m, r)
+	}
+
 	// Execute query
-	v, _, err := promAPI.Query(context.TODO(), query, completionTime)
+	result, err := queryPrometheusScalar(promAPI, m.Query, completionTime)
 	if err != nil {
 		return 0, 0, err
 	}
-
-	// Only accept scalar results
-	if v.Type() != model.ValScalar {
-		return 0, 0, fmt.Errorf("expected scalar query result, got %s", v.Type())
-	}
-
-	// Scalar result
-	result := float64(v.(*model.Scalar).Value)
 	if math.IsNaN(result) {
-		err := &CaptureError{Message: "metric data not available", Address: address, Query: query, CompletionTime: completionTime}
-		if strings.HasPrefix(query, "scalar(") {
+		err := &CaptureError{Message: "metric data not available", Address: address, Query: m.Query, CompletionTime: completionTime}
+		if strings.HasPrefix(m.Query, "scalar(") {
 			err.Message += " (the scalar function may have received an input vector whose size is not 1)"
 		}
 		return 0, 0, err
@@ -97,15 +107,11 @@ func captureOnePrometheusMetric(address, query, errorQuery string, completionTim
 
 	// Execute the error query (if configured)
 	var errorResult float64
-	if errorQuery != "" {
-		ev, _, err := promAPI.Query(context.TODO(), errorQuery, completionTime)
+	if m.ErrorQuery != "" {
+		errorResult, err = queryPrometheusScalar(promAPI, m.ErrorQuery, completionTime)
 		if err != nil {
 			return 0, 0, err
 		}
-		if ev.Type() != model.ValScalar {
-			return 0, 0, fmt.Errorf("expected scalar error query result, got %s", v.Type())
-		}
-		errorResult = float64(v.(*model.Scalar).Value)
 		if math.IsNaN(errorResult) {
 			errorResult = 0
 		}
@@ -113,3 +119,92 @@ func captureOnePrometheusMetric(address, query, errorQuery string, completionTim
 
 	return result, errorResult, nil
 }
+
+// queryPrometheusScalar executes an instant query which must evaluate to a scalar
+func queryPrometheusScalar(promAPI promv1.API, query string, ts time.Time) (float64, error) {
+	v, _, err := promAPI.Query(context.TODO(), query, ts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only accept scalar results
+	if v.Type() != model.ValScalar {
+		return 0, fmt.Errorf("expected scalar query result, got %s", v.Type())
+	}
+
+	return float64(v.(*model.Scalar).Value), nil
+}
+
+// capturePrometheusRange executes a range query and reduces the resulting series to a single value; the standard
+// deviation of the series is used as the error unless an error query is configured
+func capturePrometheusRange(promAPI promv1.API, address string, m *redskyv1beta1.Metric, r promv1.Range) (float64, float64, error) {
+	samples, err := queryPrometheusRange(promAPI, m.Query, r)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(samples) == 0 {
+		return 0, 0, &CaptureError{Message: "metric data not available", Address: address, Query: m.Query, CompletionTime: r.End}
+	}
+
+	result, err := reduce(m.Reducer, samples)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Use the error query (if configured) with the same reducer
+	if m.ErrorQuery != "" {
+		errorSamples, err := queryPrometheusRange(promAPI, m.ErrorQuery, r)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(errorSamples) == 0 {
+			return result, 0, nil
+		}
+		errorResult, err := reduce(m.Reducer, errorSamples)
+		return result, errorResult, err
+	}
+
+	return result, stddev(samples), nil
+}
+
+// queryPrometheusRange executes a range query which must evaluate to at most one series, the values of the series
+// (excluding any NaN values) are returned
+func queryPrometheusRange(promAPI promv1.API, query string, r promv1.Range) ([]float64, error) {
+	v, _, err := promAPI.QueryRange(context.TODO(), query, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only accept matrix results with a single series
+	if v.Type() != model.ValMatrix {
+		return nil, fmt.Errorf("expected matrix query result, got %s", v.Type())
+	}
+	matrix := v.(model.Matrix)
+	if len(matrix) == 0 {
+		return nil, nil
+	}
+	if len(matrix) > 1 {
+		return nil, fmt.Errorf("expected a single series from range query, got %d", len(matrix))
+	}
+
+	samples := make([]float64, 0, len(matrix[0].Values))
+	for _, sp := range matrix[0].Values {
+		if v := float64(sp.Value); !math.IsNaN(v) {
+			samples = append(samples, v)
+		}
+	}
+	return samples, nil
+}
+
+// isRangeQuery checks to see if the metric should be evaluated as a range query
+func isRangeQuery(m *redskyv1beta1.Metric) bool {
+	return m.Step != nil || m.Reducer != ""
+}
+
+// rangeStep returns the resolution of a range query
+func rangeStep(m *redskyv1beta1.Metric) time.Duration {
+	if m.Step != nil && m.Step.Duration > 0 {
+		return m.Step.Duration
+	}
+	return DefaultStep
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// reduce returns a single value from a non-empty series of samples
+func reduce(reducer redskyv1beta1.MetricReducer, samples []float64) (float64, error) {
+	switch reducer {
+	case redskyv1beta1.MetricReducerMean, "":
+		return mean(samples), nil
+	case redskyv1beta1.MetricReducerMax:
+		max := samples[0]
+		for _, v := range samples[1:] {
+			max = math.Max(max, v)
+		}
+		return max, nil
+	case redskyv1beta1.MetricReducerMin:
+		min := samples[0]
+		for _, v := range samples[1:] {
+			min = math.Min(min, v)
+		}
+		return min, nil
+	case redskyv1beta1.MetricReducerP50:
+		return percentile(samples, 0.50), nil
+	case redskyv1beta1.MetricReducerP95:
+		return percentile(samples, 0.95), nil
+	case redskyv1beta1.MetricReducerP99:
+		return percentile(samples, 0.99), nil
+	case redskyv1beta1.MetricReducerLast:
+		return samples[len(samples)-1], nil
+	default:
+		return 0, fmt.Errorf("unknown metric reducer: %s", reducer)
+	}
+}
+
+// mean returns the arithmetic mean of the samples
+func mean(samples []float64) float64 {
+	var sum float64
+	for _, v := range samples {
+		sum += v
+	}
+	return sum / float64(len(samples))
+}
+
+// stddev returns the population standard deviation of the samples
+func stddev(samples []float64) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	m := mean(samples)
+	var ss float64
+	for _, v := range samples {
+		ss += (v - m) * (v - m)
+	}
+	return math.Sqrt(ss / float64(len(samples)))
+}
+
+// percentile returns the p-quantile of the samples using linear interpolation between the closest ranks
+func percentile(samples []float64, p float64) float64 {
+	sorted := make([]float64, len(samples))
+	copy(sorted, samples)
+	sort.Float64s(sorted)
+
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduce(t *testing.T) {
+	samples := []float64{4, 1, 3, 2, 5, 6, 8, 7, 10, 9}
+	cases := []struct {
+		desc     string
+		reducer  redskyv1beta1.MetricReducer
+		expected float64
+		err      string
+	}{
+		{desc: "default", expected: 5.5},
+		{desc: "mean", reducer: redskyv1beta1.MetricReducerMean, expected: 5.5},
+		{desc: "max", reducer: redskyv1beta1.MetricReducerMax, expected: 10},
+		{desc: "min", reducer: redskyv1beta1.MetricReducerMin, expected: 1},
+		{desc: "p50", reducer: redskyv1beta1.MetricReducerP50, expected: 5.5},
+		{desc: "p95", reducer: redskyv1beta1.MetricReducerP95, expected: 9.55},
+		{desc: "p99", reducer: redskyv1beta1.MetricReducerP99, expected: 9.91},
+		{desc: "last", reducer: redskyv1beta1.MetricReducerLast, expected: 9},
+		{desc: "unknown", reducer: "sum", err: "unknown metric reducer: sum"},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, err := reduce(c.reducer, samples)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.InDelta(t, c.expected, actual, 0.0001)
+			}
+		})
+	}
+}
+
+func TestStddev(t *testing.T) {
+	assert.Equal(t, float64(0), stddev([]float64{1}))
+	assert.Equal(t, float64(2), stddev([]float64{2, 4, 4, 4, 5, 5, 7, 9}))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/trial"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// resultsCollector extracts values from the results file collected by the trial run sidecar
+type resultsCollector struct {
+	collector.NoTarget
+	pods corev1client.PodsGetter
+}
+
+// NewResultsCollector returns a collector for "results" metrics which reads the results using the supplied client
+func NewResultsCollector(pods corev1client.PodsGetter) collector.Collector {
+	return &resultsCollector{pods: pods}
+}
+
+// Capture reads the results file from the sidecar logs and extracts the value and error
+func (c *resultsCollector) Capture(m *redskyv1beta1.Metric, t *redskyv1beta1.Trial, _ runtime.Object) (float64, float64, error) {
+	if c.pods == nil {
+		return 0, 0, fmt.Errorf("unable to read results for metric '%s'", m.Name)
+	}
+	if t.Spec.Results == nil {
+		return 0, 0, fmt.Errorf("trial '%s' does not collect results for metric '%s'", t.Name, m.Name)
+	}
+
+	// The sidecar writes the results file to its logs once the trial run is complete, earlier attempts are ignored
+	results, err := trial.SucceededRunLogs(c.pods, t, trial.ResultsContainerName, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	value, err := captureResultsMetric(m.Name, m.Query, results)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var stddev float64
+	if m.ErrorQuery != "" {
+		if stddev, err = captureResultsMetric(m.Name, m.ErrorQuery, results); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return value, stddev, nil
+}
+
+// captureResultsMetric evaluates a JSON path query against the results file
+func captureResultsMetric(name, query string, results []byte) (float64, error) {
+	if len(bytes.TrimSpace(results)) == 0 {
+		return 0, fmt.Errorf("no results were collected for metric '%s'", name)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(results, &data); err != nil {
+		return 0, fmt.Errorf("invalid results for metric '%s': %w", name, err)
+	}
+
+	return evaluateJSONPath(name, query, data)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureResultsMetric(t *testing.T) {
+	k6 := `{
+  "metrics": {
+    "http_req_duration": {"avg": 12.5, "p(95)": "31.75"},
+    "http_reqs": {"count": 4800, "rate": 160}
+  }
+}
+`
+
+	cases := []struct {
+		desc     string
+		query    string
+		results  string
+		expected float64
+		err      bool
+	}{
+		{
+			desc:     "number",
+			query:    `{.metrics.http_req_duration.avg}`,
+			results:  k6,
+			expected: 12.5,
+		},
+		{
+			desc:     "string",
+			query:    `{.metrics.http_req_duration.p\(95\)}`,
+			results:  k6,
+			expected: 31.75,
+		},
+		{
+			desc:    "no results",
+			query:   `{.metrics.http_reqs.rate}`,
+			results: "\n",
+			err:     true,
+		},
+		{
+			desc:    "invalid results",
+			query:   `{.metrics.http_reqs.rate}`,
+			results: "Sleeping for 2m0s...",
+			err:     true,
+		},
+		{
+			desc:    "no match",
+			query:   `{.metrics.iterations.count}`,
+			results: k6,
+			err:     true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, err := captureResultsMetric("test", c.query, []byte(c.results))
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optimizer
+
+import (
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// feasible checks to see if the assignments satisfy all of the experiment constraints; constraints referencing
+// parameters without a numeric assignment are ignored
+func feasible(exp *redskyv1beta1.Experiment, assignments []redskyv1beta1.Assignment) bool {
+	values := make(map[string]float64, len(assignments))
+	for _, a := range assignments {
+		if v, err := strconv.ParseFloat(a.Value.String(), 64); err == nil {
+			values[a.Name] = v
+		}
+	}
+
+	for _, c := range exp.Spec.Constraints {
+		switch {
+		case c.Order != nil:
+			lower, lok := values[c.Order.LowerParameter]
+			upper, uok := values[c.Order.UpperParameter]
+			if lok && uok && lower > upper {
+				return false
+			}
+
+		case c.Sum != nil:
+			var sum float64
+			for _, p := range c.Sum.Parameters {
+				if v, ok := values[p.Name]; ok {
+					sum += v * float64(p.Weight.MilliValue()) / 1000
+				}
+			}
+			bound := float64(c.Sum.Bound.MilliValue()) / 1000
+			if (c.Sum.IsUpperBound && sum > bound) || (!c.Sum.IsUpperBound && sum < bound) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optimizer
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// The local optimizer keeps it's state in the experiment annotations: the number of suggestions made so far and the
+// position in the sampling plan of the next suggestion. Sampling plans (e.g. grid points) are deterministic for the
+// lifetime of the experiment, so suggestions do not depend on which trials are still present in the cluster.
+
+const (
+	// Finalizer is used to ensure failed trials are retried when the trial template includes a retry policy
+	Finalizer = "optimizerFinalizer.redskyops.dev"
+)
+
+const (
+	// OptimizationAlgorithm is the name of the optimization setting used to select a local algorithm
+	OptimizationAlgorithm = "localOptimizer"
+	// OptimizationBudget is the name of the optimization setting used to limit the number of trials
+	OptimizationBudget = "experimentBudget"
+	// OptimizationGridLevels is the name of the optimization setting used to control the number of grid levels per parameter
+	OptimizationGridLevels = "gridLevels"
+)
+
+const (
+	// AlgorithmRandom samples each parameter uniformly at random
+	AlgorithmRandom = "random"
+	// AlgorithmGrid enumerates evenly spaced levels of each parameter
+	AlgorithmGrid = "grid"
+	// AlgorithmLatinHypercube stratifies each parameter into "experimentBudget" intervals
+	AlgorithmLatinHypercube = "latinHypercube"
+)
+
+const (
+	// defaultGridLevels is the number of grid levels used for each parameter when "gridLevels" is not specified
+	defaultGridLevels = 5
+	// defaultLatinHypercubeSamples is the number of samples when "experimentBudget" is not specified
+	defaultLatinHypercubeSamples = 10
+	// maxRandomAttempts is the number of random samples to try before giving up on satisfying the constraints
+	maxRandomAttempts = 1000
+)
+
+// ErrExhausted is returned when the local optimizer has no further suggestions
+var ErrExhausted = errors.New("no more suggestions")
+
+// IsLocal checks to see if the experiment should use the local optimizer
+func IsLocal(exp *redskyv1beta1.Experiment) bool {
+	return optimization(exp, OptimizationAlgorithm) != ""
+}
+
+// Suggest returns the assignments for the next trial of the supplied experiment; the suggestion is recorded in the
+// experiment annotations, the experiment must be updated before the trial is created to avoid duplicate suggestions
+func Suggest(exp *redskyv1beta1.Experiment) ([]redskyv1beta1.Assignment, error) {
+	a, next, err := suggest(exp)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp.GetAnnotations() == nil {
+		exp.SetAnnotations(make(map[string]string))
+	}
+	exp.GetAnnotations()[redskyv1beta1.AnnotationSuggestions] = strconv.Itoa(intAnnotation(exp, redskyv1beta1.AnnotationSuggestions) + 1)
+	exp.GetAnnotations()[redskyv1beta1.AnnotationSamplingIndex] = strconv.Itoa(next)
+	return a, nil
+}
+
+// Exhausted checks to see if the local optimizer has run out of suggestions for the experiment
+func Exhausted(exp *redskyv1beta1.Experiment) bool {
+	_, _, err := suggest(exp)
+	return err == ErrExhausted
+}
+
+// suggest returns the assignments for the next trial along with the position in the sampling plan of the suggestion
+// that follows it
+func suggest(exp *redskyv1beta1.Experiment) ([]redskyv1beta1.Assignment, int, error) {
+	budget, err := intOptimization(exp, OptimizationBudget, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	count := intAnnotation(exp, redskyv1beta1.AnnotationSuggestions)
+	index := intAnnotation(exp, redskyv1beta1.AnnotationSamplingIndex)
+	if budget > 0 && count >= budget {
+		return nil, index, ErrExhausted
+	}
+
+	// The first trial always uses the baseline (if there is one)
+	baseline := exp.BaselineAssignments()
+	if baseline != nil && count == 0 {
+		return baseline, index, nil
+	}
+
+	// Seed the random number generator so the sampling plan is stable for the lifetime of the experiment
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(exp.Namespace + "/" + exp.Name + "/" + string(exp.UID)))
+	seed := int64(h.Sum64())
+
+	switch alg := optimization(exp, OptimizationAlgorithm); alg {
+	case AlgorithmRandom:
+		r := rand.New(rand.NewSource(seed + int64(count)))
+		for i := 0; i < maxRandomAttempts; i++ {
+			a := randomPoint(r, exp.Spec.Parameters)
+			if feasible(exp, a) {
+				return a, index, nil
+			}
+		}
+		return nil, index, fmt.Errorf("unable to satisfy constraints after %d random samples", maxRandomAttempts)
+
+	case AlgorithmGrid:
+		levels, err := intOptimization(exp, OptimizationGridLevels, defaultGridLevels)
+		if err != nil {
+			return nil, index, err
+		}
+		g := newGrid(exp.Spec.Parameters, levels)
+		return nextFeasible(exp, baseline, index, g.size(), g.point)
+
+	case AlgorithmLatinHypercube:
+		samples := budget
+		if samples <= 0 {
+			samples = defaultLatinHypercubeSamples
+		}
+		r := rand.New(rand.NewSource(seed))
+		points := latinHypercubePoints(r, exp.Spec.Parameters, samples)
+		return nextFeasible(exp, baseline, index, len(points), func(i int) []redskyv1beta1.Assignment { return points[i] })
+
+	default:
+		return nil, index, fmt.Errorf("unknown local optimizer algorithm: %s", alg)
+	}
+}
+
+// nextFeasible returns the first feasible point of the sampling plan at or after the specified index, skipping the
+// baseline assignments (if any) since they are always suggested first; the position of the following point is also
+// returned
+func nextFeasible(exp *redskyv1beta1.Experiment, baseline []redskyv1beta1.Assignment, index, size int, point func(int) []redskyv1beta1.Assignment) ([]redskyv1beta1.Assignment, int, error) {
+	var skip string
+	if baseline != nil {
+		skip = key(baseline)
+	}
+
+	for i := index; i < size; i++ {
+		if c := point(i); feasible(exp, c) && key(c) != skip {
+			return c, i + 1, nil
+		}
+	}
+	return nil, size, ErrExhausted
+}
+
+// intAnnotation returns the integer value of the named experiment annotation, or zero if it is not set
+func intAnnotation(exp *redskyv1beta1.Experiment, name string) int {
+	i, err := strconv.Atoi(exp.GetAnnotations()[name])
+	if err != nil || i < 0 {
+		return 0
+	}
+	return i
+}
+
+// key returns a string which uniquely identifies a list of assignments
+func key(assignments []redskyv1beta1.Assignment) string {
+	var k string
+	for _, a := range assignments {
+		k += a.Name + "=" + a.Value.String() + ";"
+	}
+	return k
+}
+
+// optimization returns the value of the named optimization setting
+func optimization(exp *redskyv1beta1.Experiment, name string) string {
+	for _, o := range exp.Spec.Optimization {
+		if o.Name == name {
+			return o.Value
+		}
+	}
+	return ""
+}
+
+// intOptimization returns the integer value of the named optimization setting
+func intOptimization(exp *redskyv1beta1.Experiment, name string, def int) (int, error) {
+	v := optimization(exp, name)
+	if v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for optimization setting %s: %w", name, err)
+	}
+	return i, nil
+}
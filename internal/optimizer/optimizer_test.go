@@ -0,0 +1,263 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optimizer
+
+import (
+	"strconv"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestSuggest(t *testing.T) {
+	cases := []struct {
+		desc     string
+		spec     redskyv1beta1.ExperimentSpec
+		expected []string
+	}{
+		{
+			desc: "grid",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(3)},
+					{Name: "two", Values: []string{"a", "b"}},
+				},
+			},
+			expected: []string{
+				"one=1;two=a;", "one=1;two=b;",
+				"one=2;two=a;", "one=2;two=b;",
+				"one=3;two=a;", "one=3;two=b;",
+			},
+		},
+		{
+			desc: "grid levels",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+					{Name: OptimizationGridLevels, Value: "3"},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromString("0"), Max: redskyv1beta1.FromString("0.5")},
+				},
+			},
+			expected: []string{"one=0;", "one=0.25;", "one=0.5;"},
+		},
+		{
+			desc: "grid order constraint",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(2)},
+					{Name: "two", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(2)},
+				},
+				Constraints: []redskyv1beta1.Constraint{
+					{Order: &redskyv1beta1.OrderConstraint{LowerParameter: "one", UpperParameter: "two"}},
+				},
+			},
+			expected: []string{"one=1;two=1;", "one=1;two=2;", "one=2;two=2;"},
+		},
+		{
+			desc: "grid sum constraint",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(2)},
+					{Name: "two", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(2)},
+				},
+				Constraints: []redskyv1beta1.Constraint{
+					{Sum: &redskyv1beta1.SumConstraint{
+						IsUpperBound: true,
+						Bound:        resource.MustParse("3"),
+						Parameters: []redskyv1beta1.SumConstraintParameter{
+							{Name: "one", Weight: resource.MustParse("1")},
+							{Name: "two", Weight: resource.MustParse("1")},
+						},
+					}},
+				},
+			},
+			expected: []string{"one=1;two=1;", "one=1;two=2;", "one=2;two=1;"},
+		},
+		{
+			desc: "budget",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+					{Name: OptimizationBudget, Value: "2"},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(3)},
+				},
+			},
+			expected: []string{"one=1;", "one=2;"},
+		},
+		{
+			desc: "latin hypercube",
+			spec: redskyv1beta1.ExperimentSpec{
+				Optimization: []redskyv1beta1.Optimization{
+					{Name: OptimizationAlgorithm, Value: AlgorithmLatinHypercube},
+					{Name: OptimizationBudget, Value: "3"},
+				},
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(3)},
+				},
+			},
+			// The order is random, so these are sorted before comparing
+			expected: []string{"one=1;", "one=2;", "one=3;"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			exp := &redskyv1beta1.Experiment{Spec: c.spec}
+			var actual []string
+			for {
+				a, err := Suggest(exp)
+				if err == ErrExhausted {
+					break
+				}
+				require.NoError(t, err)
+				require.True(t, len(actual) <= len(c.expected), "too many suggestions")
+				actual = append(actual, key(a))
+			}
+			if c.spec.Optimization[0].Value == AlgorithmLatinHypercube {
+				assert.ElementsMatch(t, c.expected, actual)
+			} else {
+				assert.Equal(t, c.expected, actual)
+			}
+			assert.True(t, Exhausted(exp))
+			assert.Equal(t, strconv.Itoa(len(c.expected)), exp.GetAnnotations()[redskyv1beta1.AnnotationSuggestions])
+		})
+	}
+}
+
+func TestSuggestBaseline(t *testing.T) {
+	baseline := redskyv1beta1.FromInt64(2)
+	exp := &redskyv1beta1.Experiment{
+		Spec: redskyv1beta1.ExperimentSpec{
+			Optimization: []redskyv1beta1.Optimization{
+				{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+			},
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(3), Baseline: &baseline},
+			},
+		},
+	}
+
+	var actual []string
+	for {
+		a, err := Suggest(exp)
+		if err == ErrExhausted {
+			break
+		}
+		require.NoError(t, err)
+		require.True(t, len(actual) < 3, "too many suggestions")
+		actual = append(actual, key(a))
+	}
+	assert.Equal(t, []string{"one=2;", "one=1;", "one=3;"}, actual)
+}
+
+func TestSuggestRandom(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{
+		Spec: redskyv1beta1.ExperimentSpec{
+			Optimization: []redskyv1beta1.Optimization{
+				{Name: OptimizationAlgorithm, Value: AlgorithmRandom},
+			},
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(100)},
+				{Name: "two", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(100)},
+				{Name: "three", Type: redskyv1beta1.ParameterTypeDouble, Min: redskyv1beta1.FromInt64(0), Max: redskyv1beta1.FromInt64(1)},
+			},
+			Constraints: []redskyv1beta1.Constraint{
+				{Order: &redskyv1beta1.OrderConstraint{LowerParameter: "one", UpperParameter: "two"}},
+			},
+		},
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < 50; i++ {
+		a, err := Suggest(exp)
+		require.NoError(t, err)
+		require.Len(t, a, 3)
+		assert.True(t, a[0].Value.Int64Value() <= a[1].Value.Int64Value(), "order constraint violated: %v", a)
+		assert.Equal(t, intstr.String, a[2].Value.Type)
+		assert.False(t, seen[key(a)], "duplicate suggestion: %v", a)
+		seen[key(a)] = true
+	}
+	assert.False(t, Exhausted(exp))
+}
+
+func TestSuggestState(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				redskyv1beta1.AnnotationSuggestions:   "2",
+				redskyv1beta1.AnnotationSamplingIndex: "2",
+			},
+		},
+		Spec: redskyv1beta1.ExperimentSpec{
+			Optimization: []redskyv1beta1.Optimization{
+				{Name: OptimizationAlgorithm, Value: AlgorithmGrid},
+				{Name: OptimizationBudget, Value: "3"},
+			},
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(5)},
+			},
+		},
+	}
+
+	// Suggestions continue from the recorded state regardless of how many trials exist
+	a, err := Suggest(exp)
+	require.NoError(t, err)
+	assert.Equal(t, "one=3;", key(a))
+	assert.Equal(t, "3", exp.GetAnnotations()[redskyv1beta1.AnnotationSuggestions])
+	assert.Equal(t, "3", exp.GetAnnotations()[redskyv1beta1.AnnotationSamplingIndex])
+
+	// The budget counts suggestions, not trials
+	_, err = Suggest(exp)
+	assert.Equal(t, ErrExhausted, err)
+}
+
+func TestGrid(t *testing.T) {
+	g := newGrid([]redskyv1beta1.Parameter{
+		{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(3)},
+		{Name: "two", Values: []string{"a", "b"}},
+	}, 5)
+	require.Equal(t, 6, g.size())
+	assert.Equal(t, "one=1;two=a;", key(g.point(0)))
+	assert.Equal(t, "one=2;two=b;", key(g.point(3)))
+	assert.Equal(t, "one=3;two=b;", key(g.point(5)))
+
+	// Large grids are never enumerated
+	var parameters []redskyv1beta1.Parameter
+	for i := 0; i < 100; i++ {
+		parameters = append(parameters, redskyv1beta1.Parameter{Name: strconv.Itoa(i), Min: redskyv1beta1.FromInt64(0), Max: redskyv1beta1.FromInt64(1 << 40)})
+	}
+	g = newGrid(parameters, 5)
+	assert.Equal(t, maxInt, g.size())
+	assert.Len(t, g.point(maxInt-1), 100)
+}
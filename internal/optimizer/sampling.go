@@ -0,0 +1,157 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optimizer
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
+
+// randomPoint samples each parameter uniformly
+func randomPoint(r *rand.Rand, parameters []redskyv1beta1.Parameter) []redskyv1beta1.Assignment {
+	a := make([]redskyv1beta1.Assignment, len(parameters))
+	for i := range parameters {
+		a[i] = redskyv1beta1.Assignment{Name: parameters[i].Name, Value: sample(&parameters[i], r.Float64())}
+	}
+	return a
+}
+
+// maxInt is the largest value of an int
+const maxInt = int(^uint(0) >> 1)
+
+// grid represents every combination of evenly spaced parameter levels, the points are indexed so the first parameter
+// changes least frequently; individual points are computed on demand instead of enumerating the entire grid
+type grid struct {
+	names  []string
+	levels [][]redskyv1beta1.Int64OrString
+}
+
+// newGrid returns the grid for the supplied parameters
+func newGrid(parameters []redskyv1beta1.Parameter, levels int) *grid {
+	g := &grid{
+		names:  make([]string, len(parameters)),
+		levels: make([][]redskyv1beta1.Int64OrString, len(parameters)),
+	}
+	for i := range parameters {
+		g.names[i] = parameters[i].Name
+		g.levels[i] = gridLevels(&parameters[i], levels)
+	}
+	return g
+}
+
+// size returns the number of points in the grid, very large grids are truncated to the maximum int value
+func (g *grid) size() int {
+	size := 1
+	for _, l := range g.levels {
+		if len(l) == 0 {
+			return 0
+		}
+		if size > maxInt/len(l) {
+			return maxInt
+		}
+		size *= len(l)
+	}
+	return size
+}
+
+// point returns the assignments at the specified index of the grid
+func (g *grid) point(index int) []redskyv1beta1.Assignment {
+	a := make([]redskyv1beta1.Assignment, len(g.levels))
+	for i := len(g.levels) - 1; i >= 0; i-- {
+		n := len(g.levels[i])
+		a[i] = redskyv1beta1.Assignment{Name: g.names[i], Value: g.levels[i][index%n]}
+		index /= n
+	}
+	return a
+}
+
+// gridLevels returns the distinct levels of a single parameter
+func gridLevels(p *redskyv1beta1.Parameter, levels int) []redskyv1beta1.Int64OrString {
+	if p.GetType() == redskyv1beta1.ParameterTypeCategorical {
+		values := make([]redskyv1beta1.Int64OrString, len(p.Values))
+		for i := range p.Values {
+			values[i] = redskyv1beta1.FromString(p.Values[i])
+		}
+		return values
+	}
+
+	if levels < 2 {
+		return []redskyv1beta1.Int64OrString{sample(p, 0)}
+	}
+
+	var values []redskyv1beta1.Int64OrString
+	seen := make(map[string]bool, levels)
+	for i := 0; i < levels; i++ {
+		v := sample(p, float64(i)/float64(levels-1))
+		if !seen[v.String()] {
+			seen[v.String()] = true
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// latinHypercubePoints returns a sample where each parameter is divided into equally probable intervals, each of
+// which is sampled exactly once
+func latinHypercubePoints(r *rand.Rand, parameters []redskyv1beta1.Parameter, samples int) [][]redskyv1beta1.Assignment {
+	points := make([][]redskyv1beta1.Assignment, samples)
+	for i := range points {
+		points[i] = make([]redskyv1beta1.Assignment, len(parameters))
+	}
+
+	for j := range parameters {
+		perm := r.Perm(samples)
+		for i := range points {
+			f := (float64(perm[i]) + r.Float64()) / float64(samples)
+			points[i][j] = redskyv1beta1.Assignment{Name: parameters[j].Name, Value: sample(&parameters[j], f)}
+		}
+	}
+	return points
+}
+
+// sample returns the parameter value at the specified fraction of the domain, the fraction must be in the range [0,1]
+func sample(p *redskyv1beta1.Parameter, f float64) redskyv1beta1.Int64OrString {
+	switch p.GetType() {
+	case redskyv1beta1.ParameterTypeCategorical:
+		if len(p.Values) == 0 {
+			return redskyv1beta1.FromString("")
+		}
+		return redskyv1beta1.FromString(p.Values[int(math.Min(f*float64(len(p.Values)), float64(len(p.Values)-1)))])
+
+	case redskyv1beta1.ParameterTypeDouble:
+		min, max := floatBounds(p)
+		return redskyv1beta1.FromString(strconv.FormatFloat(min+f*(max-min), 'f', -1, 64))
+
+	default:
+		min, max := p.Min.Int64Value(), p.Max.Int64Value()
+		v := min + int64(math.Floor(f*float64(max-min+1)))
+		if v > max {
+			v = max
+		}
+		return redskyv1beta1.FromInt64(v)
+	}
+}
+
+// floatBounds returns the bounds of a parameter as floating point numbers
+func floatBounds(p *redskyv1beta1.Parameter) (float64, float64) {
+	min, _ := strconv.ParseFloat(p.Min.String(), 64)
+	max, _ := strconv.ParseFloat(p.Max.String(), 64)
+	return min, max
+}
@@ -247,6 +247,9 @@ func (r *ReadinessChecker) podFailed(ctx context.Context, obj *unstructured.Unst
 						if cc.RestartCount > 0 && cc.State.Waiting.Reason == "CrashLoopBackOff" {
 							return &ReadinessError{error: "container crash loop back off", Reason: cc.State.Waiting.Reason, Message: cc.State.Waiting.Message}
 						}
+						if cc.State.Waiting.Reason == "ImagePullBackOff" {
+							return &ReadinessError{error: "container image pull back off", Reason: cc.State.Waiting.Reason, Message: cc.State.Waiting.Message}
+						}
 
 					case cc.State.Terminated != nil:
 						if p.Spec.RestartPolicy == corev1.RestartPolicyNever && cc.RestartCount == 0 && cc.State.Terminated.Reason == "Error" {
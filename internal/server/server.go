@@ -53,19 +53,47 @@ func FromCluster(in *redskyv1beta1.Experiment) (redskyapi.ExperimentName, *redsk
 
 	out.Parameters = nil
 	for _, p := range in.Spec.Parameters {
-		// This is a special case to omit parameters client side
-		if p.Min == p.Max {
-			continue
-		}
+		switch p.GetType() {
+		case redskyv1beta1.ParameterTypeCategorical:
+			// This is a special case to omit parameters client side
+			if len(p.Values) == 1 {
+				continue
+			}
 
-		out.Parameters = append(out.Parameters, redskyapi.Parameter{
-			Type: redskyapi.ParameterTypeInteger,
-			Name: p.Name,
-			Bounds: redskyapi.Bounds{
-				Min: json.Number(strconv.FormatInt(p.Min, 10)),
-				Max: json.Number(strconv.FormatInt(p.Max, 10)),
-			},
-		})
+			out.Parameters = append(out.Parameters, redskyapi.Parameter{
+				Type:   redskyapi.ParameterTypeCategorical,
+				Name:   p.Name,
+				Values: p.Values,
+			})
+		case redskyv1beta1.ParameterTypeDouble:
+			// This is a special case to omit parameters client side
+			if p.Min.String() == p.Max.String() {
+				continue
+			}
+
+			out.Parameters = append(out.Parameters, redskyapi.Parameter{
+				Type: redskyapi.ParameterTypeDouble,
+				Name: p.Name,
+				Bounds: &redskyapi.Bounds{
+					Min: json.Number(p.Min.String()),
+					Max: json.Number(p.Max.String()),
+				},
+			})
+		default:
+			// This is a special case to omit parameters client side
+			if p.Min.Int64Value() == p.Max.Int64Value() {
+				continue
+			}
+
+			out.Parameters = append(out.Parameters, redskyapi.Parameter{
+				Type: redskyapi.ParameterTypeInteger,
+				Name: p.Name,
+				Bounds: &redskyapi.Bounds{
+					Min: json.Number(strconv.FormatInt(p.Min.Int64Value(), 10)),
+					Max: json.Number(strconv.FormatInt(p.Max.Int64Value(), 10)),
+				},
+			})
+		}
 	}
 
 	out.Constraints = nil
@@ -110,6 +138,7 @@ func FromCluster(in *redskyv1beta1.Experiment) (redskyapi.ExperimentName, *redsk
 		out.Metrics = append(out.Metrics, redskyapi.Metric{
 			Name:     m.Name,
 			Minimize: m.Minimize,
+			Optimize: m.Optimize,
 		})
 	}
 
@@ -117,6 +146,74 @@ func FromCluster(in *redskyv1beta1.Experiment) (redskyapi.ExperimentName, *redsk
 	return n, out
 }
 
+// FromClusterBaseline converts the baseline assignments of the cluster experiment to API state, returns nil if the
+// experiment does not have a baseline
+func FromClusterBaseline(in *redskyv1beta1.Experiment) *redskyapi.TrialAssignments {
+	assignments := in.BaselineAssignments()
+	if assignments == nil {
+		return nil
+	}
+
+	// Only include assignments for parameters which are not omitted client side
+	_, e := FromCluster(in)
+	parameterTypes := make(map[string]redskyapi.ParameterType, len(e.Parameters))
+	for _, p := range e.Parameters {
+		parameterTypes[p.Name] = p.Type
+	}
+
+	out := &redskyapi.TrialAssignments{}
+	for _, a := range assignments {
+		var v redskyapi.NumberOrString
+		switch parameterTypes[a.Name] {
+		case "":
+			continue
+		case redskyapi.ParameterTypeCategorical:
+			v = redskyapi.FromString(a.Value.String())
+		default:
+			v = redskyapi.FromNumber(json.Number(a.Value.String()))
+		}
+
+		out.Assignments = append(out.Assignments, redskyapi.Assignment{
+			ParameterName: a.Name,
+			Value:         v,
+		})
+	}
+	return out
+}
+
+// IsBaseline checks if the supplied trial assignments match the baseline assignments
+func IsBaseline(baseline, ta *redskyapi.TrialAssignments) bool {
+	if baseline == nil || ta == nil || len(baseline.Assignments) != len(ta.Assignments) {
+		return false
+	}
+
+	values := make(map[string]redskyapi.NumberOrString, len(ta.Assignments))
+	for _, a := range ta.Assignments {
+		values[a.ParameterName] = a.Value
+	}
+
+	for _, a := range baseline.Assignments {
+		v, ok := values[a.ParameterName]
+		if !ok || v.IsString != a.Value.IsString {
+			return false
+		}
+		if v.IsString {
+			if v.StrVal != a.Value.StrVal {
+				return false
+			}
+			continue
+		}
+		bf, err := a.Value.Float64()
+		if err != nil {
+			return false
+		}
+		if vf, err := v.Float64(); err != nil || vf != bf {
+			return false
+		}
+	}
+	return true
+}
+
 // ToCluster converts API state to cluster state
 func ToCluster(exp *redskyv1beta1.Experiment, ee *redskyapi.Experiment) {
 	if exp.GetAnnotations() == nil {
@@ -152,12 +249,17 @@ func ToClusterTrial(t *redskyv1beta1.Trial, suggestion *redskyapi.TrialAssignmen
 	}
 
 	for _, a := range suggestion.Assignments {
-		if v, err := a.Value.Int64(); err == nil {
-			t.Spec.Assignments = append(t.Spec.Assignments, redskyv1beta1.Assignment{
-				Name:  a.ParameterName,
-				Value: v,
-			})
+		v := redskyv1beta1.FromString(a.Value.String())
+		if !a.Value.IsString {
+			if iv, err := a.Value.Int64(); err == nil {
+				v = redskyv1beta1.FromInt64(iv)
+			}
 		}
+
+		t.Spec.Assignments = append(t.Spec.Assignments, redskyv1beta1.Assignment{
+			Name:  a.ParameterName,
+			Value: v,
+		})
 	}
 
 	trial.UpdateStatus(t)
@@ -169,10 +271,12 @@ func ToClusterTrial(t *redskyv1beta1.Trial, suggestion *redskyapi.TrialAssignmen
 func FromClusterTrial(in *redskyv1beta1.Trial) *redskyapi.TrialValues {
 	out := &redskyapi.TrialValues{}
 
-	// Check to see if the trial failed
+	// Check to see if the trial failed (this includes trials which are infeasible due to metric constraints)
 	for _, c := range in.Status.Conditions {
 		if c.Type == redskyv1beta1.TrialFailed && c.Status == corev1.ConditionTrue {
 			out.Failed = true
+			out.FailureReason = c.Reason
+			out.FailureMessage = c.Message
 		}
 	}
 
@@ -31,6 +31,9 @@ import (
 )
 
 func TestFromCluster(t *testing.T) {
+	optimizeTrue := true
+	optimizeFalse := false
+
 	now := time.Now()
 	cases := []struct {
 		desc string
@@ -84,10 +87,10 @@ func TestFromCluster(t *testing.T) {
 			in: &redskyv1beta1.Experiment{
 				Spec: redskyv1beta1.ExperimentSpec{
 					Parameters: []redskyv1beta1.Parameter{
-						{Name: "one", Min: 111, Max: 222},
-						{Name: "two", Min: 1111, Max: 2222},
-						{Name: "three", Min: 11111, Max: 22222},
-						{Name: "test_case", Min: 1, Max: 1},
+						{Name: "one", Min: redskyv1beta1.FromInt64(111), Max: redskyv1beta1.FromInt64(222)},
+						{Name: "two", Min: redskyv1beta1.FromInt64(1111), Max: redskyv1beta1.FromInt64(2222)},
+						{Name: "three", Min: redskyv1beta1.FromInt64(11111), Max: redskyv1beta1.FromInt64(22222)},
+						{Name: "test_case", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(1)},
 					},
 				},
 			},
@@ -96,7 +99,7 @@ func TestFromCluster(t *testing.T) {
 					{
 						Type: redskyapi.ParameterTypeInteger,
 						Name: "one",
-						Bounds: redskyapi.Bounds{
+						Bounds: &redskyapi.Bounds{
 							Min: json.Number(strconv.FormatInt(111, 10)),
 							Max: json.Number(strconv.FormatInt(222, 10)),
 						},
@@ -104,7 +107,7 @@ func TestFromCluster(t *testing.T) {
 					{
 						Type: redskyapi.ParameterTypeInteger,
 						Name: "two",
-						Bounds: redskyapi.Bounds{
+						Bounds: &redskyapi.Bounds{
 							Min: json.Number(strconv.FormatInt(1111, 10)),
 							Max: json.Number(strconv.FormatInt(2222, 10)),
 						},
@@ -112,7 +115,7 @@ func TestFromCluster(t *testing.T) {
 					{
 						Type: redskyapi.ParameterTypeInteger,
 						Name: "three",
-						Bounds: redskyapi.Bounds{
+						Bounds: &redskyapi.Bounds{
 							Min: json.Number(strconv.FormatInt(11111, 10)),
 							Max: json.Number(strconv.FormatInt(22222, 10)),
 						},
@@ -120,6 +123,44 @@ func TestFromCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "double and categorical parameters",
+			in: &redskyv1beta1.Experiment{
+				Spec: redskyv1beta1.ExperimentSpec{
+					Parameters: []redskyv1beta1.Parameter{
+						{Name: "one", Type: redskyv1beta1.ParameterTypeDouble, Min: redskyv1beta1.FromInt64(0), Max: redskyv1beta1.FromString("0.5")},
+						{Name: "two", Min: redskyv1beta1.FromString("0.25"), Max: redskyv1beta1.FromString("0.75")},
+						{Name: "three", Values: []string{"G1", "Parallel"}},
+						{Name: "test_case", Type: redskyv1beta1.ParameterTypeCategorical, Values: []string{"Serial"}},
+					},
+				},
+			},
+			out: &redskyapi.Experiment{
+				Parameters: []redskyapi.Parameter{
+					{
+						Type: redskyapi.ParameterTypeDouble,
+						Name: "one",
+						Bounds: &redskyapi.Bounds{
+							Min: json.Number("0"),
+							Max: json.Number("0.5"),
+						},
+					},
+					{
+						Type: redskyapi.ParameterTypeDouble,
+						Name: "two",
+						Bounds: &redskyapi.Bounds{
+							Min: json.Number("0.25"),
+							Max: json.Number("0.75"),
+						},
+					},
+					{
+						Type:   redskyapi.ParameterTypeCategorical,
+						Name:   "three",
+						Values: []string{"G1", "Parallel"},
+					},
+				},
+			},
+		},
 		{
 			desc: "orderConstraints",
 			in: &redskyv1beta1.Experiment{
@@ -214,6 +255,25 @@ func TestFromCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "metrics optimize",
+			in: &redskyv1beta1.Experiment{
+				Spec: redskyv1beta1.ExperimentSpec{
+					Metrics: []redskyv1beta1.Metric{
+						{Name: "one", Minimize: true, Optimize: &optimizeTrue},
+						{Name: "two", Minimize: false, Optimize: &optimizeFalse},
+						{Name: "three", Minimize: true},
+					},
+				},
+			},
+			out: &redskyapi.Experiment{
+				Metrics: []redskyapi.Metric{
+					{Name: "one", Minimize: true, Optimize: &optimizeTrue},
+					{Name: "two", Minimize: false, Optimize: &optimizeFalse},
+					{Name: "three", Minimize: true},
+				},
+			},
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.desc, func(t *testing.T) {
@@ -224,6 +284,124 @@ func TestFromCluster(t *testing.T) {
 	}
 }
 
+func TestFromClusterBaseline(t *testing.T) {
+	one := redskyv1beta1.FromInt64(1)
+	half := redskyv1beta1.FromString("0.5")
+	red := redskyv1beta1.FromString("red")
+	constant := redskyv1beta1.FromInt64(5)
+
+	cases := []struct {
+		desc string
+		in   *redskyv1beta1.Experiment
+		out  *redskyapi.TrialAssignments
+	}{
+		{
+			desc: "no baseline",
+			in: &redskyv1beta1.Experiment{
+				Spec: redskyv1beta1.ExperimentSpec{
+					Parameters: []redskyv1beta1.Parameter{
+						{Name: "one", Min: redskyv1beta1.FromInt64(0), Max: redskyv1beta1.FromInt64(2), Baseline: &one},
+						{Name: "two", Min: redskyv1beta1.FromString("0"), Max: redskyv1beta1.FromString("1")},
+					},
+				},
+			},
+		},
+		{
+			desc: "baseline",
+			in: &redskyv1beta1.Experiment{
+				Spec: redskyv1beta1.ExperimentSpec{
+					Parameters: []redskyv1beta1.Parameter{
+						{Name: "one", Min: redskyv1beta1.FromInt64(0), Max: redskyv1beta1.FromInt64(2), Baseline: &one},
+						{Name: "two", Min: redskyv1beta1.FromString("0"), Max: redskyv1beta1.FromString("1"), Baseline: &half},
+						{Name: "three", Values: []string{"red", "green"}, Baseline: &red},
+						{Name: "four", Min: redskyv1beta1.FromInt64(5), Max: redskyv1beta1.FromInt64(5), Baseline: &constant},
+					},
+				},
+			},
+			out: &redskyapi.TrialAssignments{
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber("1")},
+					{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+					{ParameterName: "three", Value: redskyapi.FromString("red")},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			out := FromClusterBaseline(c.in)
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
+
+func TestIsBaseline(t *testing.T) {
+	baseline := &redskyapi.TrialAssignments{
+		Assignments: []redskyapi.Assignment{
+			{ParameterName: "one", Value: redskyapi.FromNumber("1")},
+			{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+			{ParameterName: "three", Value: redskyapi.FromString("red")},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		ta       *redskyapi.TrialAssignments
+		expected bool
+	}{
+		{
+			desc: "no assignments",
+			ta:   &redskyapi.TrialAssignments{},
+		},
+		{
+			desc: "match",
+			ta: &redskyapi.TrialAssignments{
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "three", Value: redskyapi.FromString("red")},
+					{ParameterName: "one", Value: redskyapi.FromNumber("1.0")},
+					{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+				},
+			},
+			expected: true,
+		},
+		{
+			desc: "different number",
+			ta: &redskyapi.TrialAssignments{
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber("2")},
+					{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+					{ParameterName: "three", Value: redskyapi.FromString("red")},
+				},
+			},
+		},
+		{
+			desc: "different string",
+			ta: &redskyapi.TrialAssignments{
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber("1")},
+					{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+					{ParameterName: "three", Value: redskyapi.FromString("green")},
+				},
+			},
+		},
+		{
+			desc: "different parameter",
+			ta: &redskyapi.TrialAssignments{
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber("1")},
+					{ParameterName: "two", Value: redskyapi.FromNumber("0.5")},
+					{ParameterName: "four", Value: redskyapi.FromString("red")},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.expected, IsBaseline(baseline, c.ta))
+		})
+	}
+}
+
 func TestToCluster(t *testing.T) {
 	cases := []struct {
 		desc   string
@@ -297,9 +475,9 @@ func TestToClusterTrial(t *testing.T) {
 					SelfURL: "some/path/1",
 				},
 				Assignments: []redskyapi.Assignment{
-					{ParameterName: "one", Value: json.Number("111")},
-					{ParameterName: "two", Value: json.Number("222")},
-					{ParameterName: "three", Value: json.Number("333")},
+					{ParameterName: "one", Value: redskyapi.FromNumber(json.Number("111"))},
+					{ParameterName: "two", Value: redskyapi.FromNumber(json.Number("222"))},
+					{ParameterName: "three", Value: redskyapi.FromNumber(json.Number("333"))},
 				},
 			},
 			trialOut: &redskyv1beta1.Trial{
@@ -319,9 +497,9 @@ func TestToClusterTrial(t *testing.T) {
 				},
 				Spec: redskyv1beta1.TrialSpec{
 					Assignments: []redskyv1beta1.Assignment{
-						{Name: "one", Value: 111},
-						{Name: "two", Value: 222},
-						{Name: "three", Value: 333},
+						{Name: "one", Value: redskyv1beta1.FromInt64(111)},
+						{Name: "two", Value: redskyv1beta1.FromInt64(222)},
+						{Name: "three", Value: redskyv1beta1.FromInt64(333)},
 					},
 				},
 			},
@@ -339,9 +517,9 @@ func TestToClusterTrial(t *testing.T) {
 					SelfURL: "some/path/one",
 				},
 				Assignments: []redskyapi.Assignment{
-					{ParameterName: "one", Value: json.Number("111")},
-					{ParameterName: "two", Value: json.Number("222")},
-					{ParameterName: "three", Value: json.Number("333")},
+					{ParameterName: "one", Value: redskyapi.FromNumber(json.Number("111"))},
+					{ParameterName: "two", Value: redskyapi.FromNumber(json.Number("222"))},
+					{ParameterName: "three", Value: redskyapi.FromNumber(json.Number("333"))},
 				},
 			},
 			trialOut: &redskyv1beta1.Trial{
@@ -361,9 +539,85 @@ func TestToClusterTrial(t *testing.T) {
 				},
 				Spec: redskyv1beta1.TrialSpec{
 					Assignments: []redskyv1beta1.Assignment{
-						{Name: "one", Value: 111},
-						{Name: "two", Value: 222},
-						{Name: "three", Value: 333},
+						{Name: "one", Value: redskyv1beta1.FromInt64(111)},
+						{Name: "two", Value: redskyv1beta1.FromInt64(222)},
+						{Name: "three", Value: redskyv1beta1.FromInt64(333)},
+					},
+				},
+			},
+		},
+		{
+			desc: "double and categorical assignments",
+			trial: &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "name",
+					Annotations: map[string]string{},
+				},
+			},
+			suggestion: &redskyapi.TrialAssignments{
+				TrialMeta: redskyapi.TrialMeta{
+					SelfURL: "some/path/1",
+				},
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber(json.Number("0.25"))},
+					{ParameterName: "two", Value: redskyapi.FromString("G1")},
+				},
+			},
+			trialOut: &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "name",
+					Annotations: map[string]string{
+						redskyv1beta1.AnnotationReportTrialURL: "some/path/1",
+					},
+					Finalizers: []string{
+						Finalizer,
+					},
+				},
+				Status: redskyv1beta1.TrialStatus{
+					Phase:       "Created",
+					Assignments: "one=0.25, two=G1",
+				},
+				Spec: redskyv1beta1.TrialSpec{
+					Assignments: []redskyv1beta1.Assignment{
+						{Name: "one", Value: redskyv1beta1.FromString("0.25")},
+						{Name: "two", Value: redskyv1beta1.FromString("G1")},
+					},
+				},
+			},
+		},
+		{
+			desc: "large integer assignments",
+			trial: &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "name",
+					Annotations: map[string]string{},
+				},
+			},
+			suggestion: &redskyapi.TrialAssignments{
+				TrialMeta: redskyapi.TrialMeta{
+					SelfURL: "some/path/1",
+				},
+				Assignments: []redskyapi.Assignment{
+					{ParameterName: "one", Value: redskyapi.FromNumber(json.Number("8589934592"))},
+				},
+			},
+			trialOut: &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "name",
+					Annotations: map[string]string{
+						redskyv1beta1.AnnotationReportTrialURL: "some/path/1",
+					},
+					Finalizers: []string{
+						Finalizer,
+					},
+				},
+				Status: redskyv1beta1.TrialStatus{
+					Phase:       "Created",
+					Assignments: "one=8589934592",
+				},
+				Spec: redskyv1beta1.TrialSpec{
+					Assignments: []redskyv1beta1.Assignment{
+						{Name: "one", Value: redskyv1beta1.FromInt64(8589934592)},
 					},
 				},
 			},
@@ -416,6 +670,27 @@ func TestFromClusterTrial(t *testing.T) {
 				Failed: true,
 			},
 		},
+		{
+			desc: "infeasible",
+			in: &redskyv1beta1.Trial{
+				Status: redskyv1beta1.TrialStatus{
+					Conditions: []redskyv1beta1.TrialCondition{
+						{Type: redskyv1beta1.TrialObserved, Status: corev1.ConditionTrue},
+						{Type: redskyv1beta1.TrialFailed, Status: corev1.ConditionTrue, Reason: "Infeasible", Message: "metric 'one' value 5 is less than the minimum 10"},
+					},
+				},
+				Spec: redskyv1beta1.TrialSpec{
+					Values: []redskyv1beta1.Value{
+						{Name: "one", Value: "5"},
+					},
+				},
+			},
+			expectedOut: &redskyapi.TrialValues{
+				Failed:         true,
+				FailureReason:  "Infeasible",
+				FailureMessage: "metric 'one' value 5 is less than the minimum 10",
+			},
+		},
 		{
 			desc: "conditions not failed",
 			in: &redskyv1beta1.Trial{
@@ -19,8 +19,10 @@ package setup
 import (
 	"encoding/base64"
 	"fmt"
+	"math/big"
 	"os"
 	"path"
+	"strings"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/redskyops/redskyops-controller/internal/template"
@@ -28,6 +30,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -81,8 +84,9 @@ func NewJob(t *redskyv1beta1.Trial, mode string) (*batchv1.Job, error) {
 	}
 
 	// Create containers for each of the setup tasks
+	te := template.New()
 	for _, task := range t.Spec.SetupTasks {
-		if (mode == ModeCreate && task.SkipCreate) || (mode == ModeDelete && task.SkipDelete) {
+		if (mode != ModeDelete && task.SkipCreate) || (mode == ModeDelete && skipDelete(&task)) {
 			continue
 		}
 		c := corev1.Container{
@@ -92,7 +96,7 @@ func NewJob(t *redskyv1beta1.Trial, mode string) (*batchv1.Job, error) {
 			Env: []corev1.EnvVar{
 				{Name: "NAMESPACE", Value: t.Namespace},
 				{Name: "NAME", Value: task.Name},
-				{Name: "TRIAL", Value: t.Name},
+				{Name: "MODE", Value: mode},
 			},
 			SecurityContext: &corev1.SecurityContext{
 				RunAsUser:                &id,
@@ -113,17 +117,86 @@ func NewJob(t *redskyv1beta1.Trial, mode string) (*batchv1.Job, error) {
 			c.ImagePullPolicy = corev1.PullPolicy(ImagePullPolicy)
 		}
 
+		// Setup shared by multiple trials must not be labeled with the trial that created it
+		if !IsNamespaceLifecycle(t) {
+			c.Env = append(c.Env, corev1.EnvVar{Name: "TRIAL", Value: t.Name})
+		}
+
 		// Add the trial assignments to the environment
 		c.Env = trial.AppendAssignmentEnv(t, c.Env)
 
 		// Add the configured volume mounts
 		c.VolumeMounts = append(c.VolumeMounts, task.VolumeMounts...)
 
+		// For arbitrary commands, evaluate the arguments instead of applying resources
+		if len(task.Command) > 0 || len(task.Args) > 0 || len(task.DeleteArgs) > 0 {
+			c.Command = task.Command
+			args, argsName := task.Args, "args"
+			if mode == ModeDelete {
+				args, argsName = task.DeleteArgs, "deleteArgs"
+			}
+			if len(args) > 0 {
+				c.Args = make([]string, len(args))
+				for i := range args {
+					arg, err := te.RenderSetupValue(fmt.Sprintf("%s-%s-%d", task.Name, argsName, i), args[i], t)
+					if err != nil {
+						return nil, err
+					}
+					c.Args[i] = arg
+				}
+			}
+			job.Spec.Template.Spec.Containers = append(job.Spec.Template.Spec.Containers, c)
+			continue
+		}
+
+		// For plain manifests, mount config maps and list the locations to include in the environment
+		if len(task.Manifests) > 0 {
+			var manifests []string
+			for _, m := range task.Manifests {
+				switch {
+				case m.ConfigMap != nil:
+					vm := corev1.VolumeMount{
+						Name:      m.ConfigMap.Name,
+						MountPath: path.Join("/workspace", "manifests", m.ConfigMap.Name),
+						ReadOnly:  true,
+					}
+					if _, ok := volumes[vm.Name]; !ok {
+						vs := corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: m.ConfigMap.Name},
+							},
+						}
+						volumes[vm.Name] = &corev1.Volume{Name: vm.Name, VolumeSource: vs}
+					}
+					c.VolumeMounts = append(c.VolumeMounts, vm)
+					manifests = append(manifests, vm.MountPath)
+
+				case m.URL != "":
+					u, err := te.RenderSetupValue(task.Name+"-url", m.URL, t)
+					if err != nil {
+						return nil, err
+					}
+					manifests = append(manifests, u)
+
+				default:
+					return nil, fmt.Errorf("unknown source for manifests of setup task '%s'", task.Name)
+				}
+			}
+			c.Env = append(c.Env, corev1.EnvVar{Name: "MANIFESTS", Value: strings.Join(manifests, "\n")})
+		}
+
+		// For Kustomize overlays, record the evaluated reference in the environment
+		if task.Kustomize != "" {
+			k, err := te.RenderSetupValue(task.Name+"-kustomize", task.Kustomize, t)
+			if err != nil {
+				return nil, err
+			}
+			c.Env = append(c.Env, corev1.EnvVar{Name: "KUSTOMIZE", Value: k})
+		}
+
 		// For Helm installs, serialize a Konjure configuration
 		helmConfig := newHelmGeneratorConfig(&task)
 		if helmConfig != nil {
-			te := template.New()
-
 			// Helm Values
 			for _, hv := range task.HelmValues {
 				hgv := helmGeneratorValue{
@@ -135,12 +208,25 @@ func NewJob(t *redskyv1beta1.Trial, mode string) (*batchv1.Job, error) {
 					// Evaluate the external value source
 					switch {
 					case hv.ValueFrom.ParameterRef != nil:
-						v, ok := t.GetAssignment(hv.ValueFrom.ParameterRef.Name)
-						if !ok {
-							return nil, fmt.Errorf("invalid parameter reference '%s' for Helm value '%s'", hv.ValueFrom.ParameterRef.Name, hv.Name)
+						v, err := parameterValue(t, hv.ValueFrom.ParameterRef)
+						if err != nil {
+							return nil, fmt.Errorf("invalid parameter reference '%s' for Helm value '%s': %w", hv.ValueFrom.ParameterRef.Name, hv.Name, err)
 						}
 						hgv.Value = v
 
+					case hv.ValueFrom.FieldRef != nil:
+						v, err := fieldValue(t, hv.ValueFrom.FieldRef.FieldPath)
+						if err != nil {
+							return nil, fmt.Errorf("invalid field reference '%s' for Helm value '%s': %w", hv.ValueFrom.FieldRef.FieldPath, hv.Name, err)
+						}
+						hgv.Value = v
+
+					case hv.ValueFrom.SecretKeyRef != nil:
+						// Secret values are never part of the job definition, the key is read from a mounted file instead
+						dir := mountSecret(volumes, &c, hv.ValueFrom.SecretKeyRef.Name)
+						hgv.File = path.Join(dir, hv.ValueFrom.SecretKeyRef.Key)
+						hgv.LoadFile = true
+
 					default:
 						return nil, fmt.Errorf("unknown source for Helm value '%s'", hv.Name)
 					}
@@ -158,6 +244,11 @@ func NewJob(t *redskyv1beta1.Trial, mode string) (*batchv1.Job, error) {
 
 			// Helm Values From
 			for _, hvf := range task.HelmValuesFrom {
+				if hvf.Secret != nil {
+					dir := mountSecret(volumes, &c, hvf.Secret.Name)
+					helmConfig.Values = append(helmConfig.Values, helmGeneratorValue{File: path.Join(dir, "*values.yaml")})
+				}
+
 				if hvf.ConfigMap != nil {
 					hgv := helmGeneratorValue{
 						File: path.Join("/workspace", "helm-values", hvf.ConfigMap.Name, "*values.yaml"),
@@ -205,6 +296,7 @@ type helmGeneratorValue struct {
 	Name        string      `json:"name,omitempty"`
 	Value       interface{} `json:"value,omitempty"`
 	ForceString bool        `json:"forceString,omitempty"`
+	LoadFile    bool        `json:"loadFile,omitempty"`
 }
 
 type helmGeneratorConfig struct {
@@ -233,3 +325,83 @@ func newHelmGeneratorConfig(task *redskyv1beta1.SetupTask) *helmGeneratorConfig
 
 	return cfg
 }
+
+// mountSecret ensures the named secret is mounted into the container, returning the mount path
+func mountSecret(volumes map[string]*corev1.Volume, c *corev1.Container, name string) string {
+	vm := corev1.VolumeMount{
+		Name:      "secret-" + name,
+		MountPath: path.Join("/workspace", "helm-secrets", name),
+		ReadOnly:  true,
+	}
+
+	if _, ok := volumes[vm.Name]; !ok {
+		vs := corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: name,
+			},
+		}
+		volumes[vm.Name] = &corev1.Volume{Name: vm.Name, VolumeSource: vs}
+	}
+
+	for i := range c.VolumeMounts {
+		if c.VolumeMounts[i].Name == vm.Name {
+			return vm.MountPath
+		}
+	}
+	c.VolumeMounts = append(c.VolumeMounts, vm)
+	return vm.MountPath
+}
+
+// parameterValue returns the value of the selected parameter assignment after applying any computations
+func parameterValue(t *redskyv1beta1.Trial, sel *redskyv1beta1.ParameterSelector) (interface{}, error) {
+	v, ok := t.GetAssignment(sel.Name)
+	if !ok {
+		return nil, fmt.Errorf("missing assignment")
+	}
+
+	// Use the value as-is unless there is something to compute
+	if sel.Percent == 0 && sel.Scale == "" && sel.Delta == 0 {
+		return v, nil
+	}
+	if v.Type != intstr.Int {
+		return nil, fmt.Errorf("cannot compute a value from non-numeric assignment '%s'", v.StrVal)
+	}
+
+	// Use rational numbers so decimal scale factors do not introduce rounding errors
+	r := big.NewRat(v.IntVal, 1)
+	if sel.Percent != 0 {
+		r.Mul(r, big.NewRat(sel.Percent, 100))
+	}
+	if sel.Scale != "" {
+		scale, ok := new(big.Rat).SetString(sel.Scale)
+		if !ok {
+			return nil, fmt.Errorf("invalid scale '%s'", sel.Scale)
+		}
+		r.Mul(r, scale)
+	}
+	r.Add(r, big.NewRat(sel.Delta, 1))
+
+	if r.IsInt() {
+		return r.Num().Int64(), nil
+	}
+	f, _ := r.Float64()
+	return f, nil
+}
+
+// fieldValue returns the value of a field on the trial using the same field paths as the downward API
+func fieldValue(t *redskyv1beta1.Trial, fieldPath string) (string, error) {
+	switch {
+	case fieldPath == "metadata.name":
+		return t.Name, nil
+	case fieldPath == "metadata.namespace":
+		return t.Namespace, nil
+	case fieldPath == "metadata.uid":
+		return string(t.UID), nil
+	case strings.HasPrefix(fieldPath, "metadata.labels['") && strings.HasSuffix(fieldPath, "']"):
+		return t.Labels[strings.TrimSuffix(strings.TrimPrefix(fieldPath, "metadata.labels['"), "']")], nil
+	case strings.HasPrefix(fieldPath, "metadata.annotations['") && strings.HasSuffix(fieldPath, "']"):
+		return t.Annotations[strings.TrimSuffix(strings.TrimPrefix(fieldPath, "metadata.annotations['"), "']")], nil
+	default:
+		return "", fmt.Errorf("unsupported field path")
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParameterValue(t *testing.T) {
+	trial := &redskyv1beta1.Trial{
+		Spec: redskyv1beta1.TrialSpec{
+			Assignments: []redskyv1beta1.Assignment{
+				{Name: "replicas", Value: redskyv1beta1.FromInt64(3)},
+				{Name: "memory", Value: redskyv1beta1.FromInt64(50)},
+				{Name: "mode", Value: redskyv1beta1.FromString("fast")},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		selector redskyv1beta1.ParameterSelector
+		expected interface{}
+		err      bool
+	}{
+		{
+			desc:     "as is",
+			selector: redskyv1beta1.ParameterSelector{Name: "replicas"},
+			expected: redskyv1beta1.FromInt64(3),
+		},
+		{
+			desc:     "string",
+			selector: redskyv1beta1.ParameterSelector{Name: "mode"},
+			expected: redskyv1beta1.FromString("fast"),
+		},
+		{
+			desc:     "percent",
+			selector: redskyv1beta1.ParameterSelector{Name: "memory", Percent: 2048},
+			expected: int64(1024),
+		},
+		{
+			desc:     "scale",
+			selector: redskyv1beta1.ParameterSelector{Name: "replicas", Scale: "0.1"},
+			expected: 0.3,
+		},
+		{
+			desc:     "delta",
+			selector: redskyv1beta1.ParameterSelector{Name: "replicas", Scale: "2", Delta: -1},
+			expected: int64(5),
+		},
+		{
+			desc:     "missing",
+			selector: redskyv1beta1.ParameterSelector{Name: "cpu"},
+			err:      true,
+		},
+		{
+			desc:     "non-numeric",
+			selector: redskyv1beta1.ParameterSelector{Name: "mode", Delta: 1},
+			err:      true,
+		},
+		{
+			desc:     "invalid scale",
+			selector: redskyv1beta1.ParameterSelector{Name: "replicas", Scale: "half"},
+			err:      true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, err := parameterValue(trial, &c.selector)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFieldValue(t *testing.T) {
+	trial := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-001",
+			Namespace:   "default",
+			Labels:      map[string]string{"redskyops.dev/experiment": "test"},
+			Annotations: map[string]string{"example.com/owner": "me"},
+		},
+	}
+
+	cases := []struct {
+		fieldPath string
+		expected  string
+		err       bool
+	}{
+		{fieldPath: "metadata.name", expected: "test-001"},
+		{fieldPath: "metadata.namespace", expected: "default"},
+		{fieldPath: "metadata.labels['redskyops.dev/experiment']", expected: "test"},
+		{fieldPath: "metadata.annotations['example.com/owner']", expected: "me"},
+		{fieldPath: "spec.assignments", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.fieldPath, func(t *testing.T) {
+			actual, err := fieldValue(trial, c.fieldPath)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewJob(t *testing.T) {
+	trial := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-001",
+			Namespace: "default",
+			Labels:    map[string]string{redskyv1beta1.LabelExperiment: "test"},
+		},
+		Spec: redskyv1beta1.TrialSpec{
+			Assignments: []redskyv1beta1.Assignment{{Name: "replicas", Value: redskyv1beta1.FromInt64(3)}},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		task            redskyv1beta1.SetupTask
+		mode            string
+		skipped         bool
+		expectedCommand []string
+		expectedArgs    []string
+		expectedEnv     map[string]string
+		expectedVolumes []string
+	}{
+		{
+			desc: "manifests",
+			task: redskyv1beta1.SetupTask{
+				Name: "app",
+				Manifests: []redskyv1beta1.ManifestSource{
+					{ConfigMap: &redskyv1beta1.ConfigMapManifestSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app-manifests"}}},
+					{URL: "https://example.com/{{ .Trial.Name }}.yaml"},
+				},
+			},
+			mode:         ModeCreate,
+			expectedArgs: []string{"create"},
+			expectedEnv: map[string]string{
+				"MODE":      "create",
+				"REPLICAS":  "3",
+				"TRIAL":     "test-001",
+				"MANIFESTS": "/workspace/manifests/app-manifests\nhttps://example.com/test-001.yaml",
+			},
+			expectedVolumes: []string{"app-manifests"},
+		},
+		{
+			desc: "kustomize",
+			task: redskyv1beta1.SetupTask{
+				Name:      "app",
+				Kustomize: "github.com/example/app//overlays/replicas-{{ .Values.replicas }}",
+			},
+			mode:         ModeDelete,
+			expectedArgs: []string{"delete"},
+			expectedEnv: map[string]string{
+				"MODE":      "delete",
+				"REPLICAS":  "3",
+				"KUSTOMIZE": "github.com/example/app//overlays/replicas-3",
+			},
+		},
+		{
+			desc: "command create",
+			task: redskyv1beta1.SetupTask{
+				Name:    "app",
+				Image:   "busybox",
+				Command: []string{"/bin/sh", "-c"},
+				Args:    []string{"echo {{ .Values.replicas }}"},
+			},
+			mode:            ModeCreate,
+			expectedCommand: []string{"/bin/sh", "-c"},
+			expectedArgs:    []string{"echo 3"},
+			expectedEnv:     map[string]string{"MODE": "create", "REPLICAS": "3"},
+		},
+		{
+			desc: "command without delete args",
+			task: redskyv1beta1.SetupTask{
+				Name:    "app",
+				Image:   "busybox",
+				Command: []string{"/bin/sh", "-c"},
+				Args:    []string{"echo {{ .Values.replicas }}"},
+			},
+			mode:    ModeDelete,
+			skipped: true,
+		},
+		{
+			desc: "command delete",
+			task: redskyv1beta1.SetupTask{
+				Name:       "app",
+				Image:      "busybox",
+				Command:    []string{"rm"},
+				Args:       []string{"-f"},
+				DeleteArgs: []string{"{{ .Trial.Name }}"},
+			},
+			mode:            ModeDelete,
+			expectedCommand: []string{"rm"},
+			expectedArgs:    []string{"test-001"},
+			expectedEnv:     map[string]string{"MODE": "delete"},
+		},
+		{
+			desc: "command only",
+			task: redskyv1beta1.SetupTask{
+				Name:    "app",
+				Image:   "example.com/setup",
+				Command: []string{"/setup.sh"},
+			},
+			mode:            ModeDelete,
+			expectedCommand: []string{"/setup.sh"},
+			expectedArgs:    []string{"delete"},
+			expectedEnv:     map[string]string{"MODE": "delete"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			tt := trial.DeepCopy()
+			tt.Spec.SetupTasks = []redskyv1beta1.SetupTask{c.task}
+
+			job, err := NewJob(tt, c.mode)
+			if !assert.NoError(t, err) {
+				return
+			}
+			if c.skipped {
+				assert.Empty(t, job.Spec.Template.Spec.Containers)
+				return
+			}
+			if !assert.Len(t, job.Spec.Template.Spec.Containers, 1) {
+				return
+			}
+
+			container := job.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, c.expectedCommand, container.Command)
+			assert.Equal(t, c.expectedArgs, container.Args)
+			assert.Equal(t, c.mode, containerMode(&container))
+
+			env := make(map[string]string, len(container.Env))
+			for _, e := range container.Env {
+				env[e.Name] = e.Value
+			}
+			for k, v := range c.expectedEnv {
+				assert.Equal(t, v, env[k], k)
+			}
+
+			var volumes []string
+			for _, v := range job.Spec.Template.Spec.Volumes {
+				volumes = append(volumes, v.Name)
+			}
+			assert.Equal(t, c.expectedVolumes, volumes)
+			for _, name := range c.expectedVolumes {
+				assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: name, MountPath: "/workspace/manifests/" + name, ReadOnly: true})
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/experiment"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// StateChecksum is the key of the setup state used to record the checksum of the applied setup job
+	StateChecksum = "checksum"
+	// StateTrial is the key of the setup state used to record the name of the trial currently holding the setup
+	StateTrial = "trial"
+	// StateAssignments is the key of the setup state used to record the assignments of the trial holding the setup
+	StateAssignments = "assignments"
+
+	// StateFinalizer is used to prevent the experiment deletion until the setup shared by its trials is deleted
+	StateFinalizer = "setupStateFinalizer.redskyops.dev"
+)
+
+// IsNamespaceLifecycle returns true if the setup of the trial is shared with other trials in the same namespace
+func IsNamespaceLifecycle(t *redskyv1beta1.Trial) bool {
+	return t.Spec.SetupLifecycle == redskyv1beta1.SetupLifecycleNamespace
+}
+
+// StateKey returns the name of the config map used to record the setup shared by trials in the namespace of the trial
+func StateKey(t *redskyv1beta1.Trial) types.NamespacedName {
+	return types.NamespacedName{Namespace: t.Namespace, Name: "redsky-setup-" + t.ExperimentNamespacedName().Name}
+}
+
+// NewState returns a new config map recording the setup held by the supplied trial
+func NewState(t *redskyv1beta1.Trial, checksum string) (*corev1.ConfigMap, error) {
+	// The assignments are needed to delete the setup after the trial is gone
+	assignments, err := json.Marshal(t.Spec.Assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	key := StateKey(t)
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = key.Namespace
+	cm.Name = key.Name
+	cm.Labels = map[string]string{
+		redskyv1beta1.LabelExperiment: t.ExperimentNamespacedName().Name,
+		redskyv1beta1.LabelTrialRole:  "trialSetup",
+	}
+	cm.Data = map[string]string{
+		StateChecksum:    checksum,
+		StateTrial:       t.Name,
+		StateAssignments: string(assignments),
+	}
+	return cm, nil
+}
+
+// NewTeardownJob returns the job which deletes the setup recorded by the supplied state; the trial holding the setup
+// is reconstructed from the experiment since it may have already been deleted
+func NewTeardownJob(exp *redskyv1beta1.Experiment, state *corev1.ConfigMap) (*batchv1.Job, error) {
+	t := &redskyv1beta1.Trial{}
+	experiment.PopulateTrialFromTemplate(exp, t)
+	t.Name = state.Data[StateTrial]
+	t.Namespace = state.Namespace
+	if a := state.Data[StateAssignments]; a != "" {
+		if err := json.Unmarshal([]byte(a), &t.Spec.Assignments); err != nil {
+			return nil, err
+		}
+	}
+
+	job, err := NewJob(t, ModeDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	// The job belongs to the state instead of a trial
+	job.Name = state.Name + "-" + ModeDelete
+	delete(job.Labels, redskyv1beta1.LabelTrial)
+	delete(job.Spec.Template.Labels, redskyv1beta1.LabelTrial)
+	return job, nil
+}
+
+// Checksum returns a value which only changes when the setup job used to apply shared setup would change. Since the
+// trial assignments are part of the setup job, any change to the assignments will produce a different checksum.
+func Checksum(t *redskyv1beta1.Trial) (string, error) {
+	job, err := NewJob(t, ModeApply)
+	if err != nil {
+		return "", err
+	}
+
+	// The job name (and therefore the container names) includes the trial name, only hash what is passed to the tools
+	h := sha256.New()
+	e := json.NewEncoder(h)
+	for _, c := range job.Spec.Template.Spec.Containers {
+		if err := e.Encode([]interface{}{c.Image, c.Command, c.Args, c.Env, c.VolumeMounts}); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
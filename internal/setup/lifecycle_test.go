@@ -0,0 +1,54 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestChecksum(t *testing.T) {
+	newTrial := func(name string, replicas int) *redskyv1beta1.Trial {
+		return &redskyv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: redskyv1beta1.TrialSpec{
+				Assignments:    []redskyv1beta1.Assignment{{Name: "replicas", Value: redskyv1beta1.FromInt64(int64(replicas))}},
+				SetupLifecycle: redskyv1beta1.SetupLifecycleNamespace,
+				SetupTasks: []redskyv1beta1.SetupTask{{
+					Name:       "postgres",
+					HelmChart:  "stable/postgresql",
+					HelmValues: []redskyv1beta1.HelmValue{{Name: "replicaCount", Value: intstr.FromString("{{ .Values.replicas }}")}},
+				}},
+			},
+		}
+	}
+
+	c1, err := Checksum(newTrial("test-001", 1))
+	require.NoError(t, err)
+	c2, err := Checksum(newTrial("test-002", 1))
+	require.NoError(t, err)
+	c3, err := Checksum(newTrial("test-003", 2))
+	require.NoError(t, err)
+
+	assert.Equal(t, c1, c2, "trials with the same assignments should share setup")
+	assert.NotEqual(t, c1, c3, "trials with different assignments should update setup")
+}
@@ -28,6 +28,9 @@ import (
 const (
 	// ModeCreate is the primary argument to the setup tools container when the task is creating objects
 	ModeCreate = "create"
+	// ModeApply is the primary argument to the setup tools container when the task is creating or updating objects
+	// which are shared by multiple trials
+	ModeApply = "apply"
 	// ModeDelete is the primary argument to the setup tools container when the task is deleting objects
 	ModeDelete = "delete"
 
@@ -40,9 +43,9 @@ const (
 // UpdateStatus returns true if there are setup tasks
 func UpdateStatus(t *redskyv1beta1.Trial, probeTime *metav1.Time) bool {
 	var needsCreate, needsDelete bool
-	for _, task := range t.Spec.SetupTasks {
-		needsCreate = needsCreate || !task.SkipCreate
-		needsDelete = needsDelete || !task.SkipDelete
+	for i := range t.Spec.SetupTasks {
+		needsCreate = needsCreate || !t.Spec.SetupTasks[i].SkipCreate
+		needsDelete = needsDelete || !skipDelete(&t.Spec.SetupTasks[i])
 	}
 
 	// Short circuit, there are no setup tasks
@@ -87,21 +90,43 @@ func UpdateStatus(t *redskyv1beta1.Trial, probeTime *metav1.Time) bool {
 // GetTrialConditionType returns the trial condition type used to report status for the specified job
 func GetTrialConditionType(j *batchv1.Job) (redskyv1beta1.TrialConditionType, error) {
 	// TODO This should just be a label or annotation on the job
-	for _, c := range j.Spec.Template.Spec.Containers {
-		if len(c.Args) > 0 {
-			switch c.Args[0] {
-			case ModeCreate:
-				return redskyv1beta1.TrialSetupCreated, nil
-			case ModeDelete:
-				return redskyv1beta1.TrialSetupDeleted, nil
-			default:
-				return "", fmt.Errorf("unknown setup job container argument: %s", c.Args[0])
-			}
+	for i := range j.Spec.Template.Spec.Containers {
+		mode := containerMode(&j.Spec.Template.Spec.Containers[i])
+		if mode == "" {
+			continue
+		}
+		switch mode {
+		case ModeCreate, ModeApply:
+			return redskyv1beta1.TrialSetupCreated, nil
+		case ModeDelete:
+			return redskyv1beta1.TrialSetupDeleted, nil
+		default:
+			return "", fmt.Errorf("unknown setup job container mode: %s", mode)
 		}
 	}
 	return "", fmt.Errorf("unable to determine setup job type")
 }
 
+// containerMode returns the mode of a setup job container; the mode is normally the first argument, however tasks
+// using custom arguments only have the mode in the environment
+func containerMode(c *corev1.Container) string {
+	for _, e := range c.Env {
+		if e.Name == "MODE" {
+			return e.Value
+		}
+	}
+	if len(c.Args) > 0 {
+		return c.Args[0]
+	}
+	return ""
+}
+
+// skipDelete checks to see if the deletion part of a setup task should be skipped; custom arguments are only used to
+// create unless separate delete arguments are also specified
+func skipDelete(task *redskyv1beta1.SetupTask) bool {
+	return task.SkipDelete || (len(task.Args) > 0 && len(task.DeleteArgs) == 0)
+}
+
 // GetConditionStatus returns condition True for a finished job or condition False for an job in progress
 func GetConditionStatus(j *batchv1.Job) (corev1.ConditionStatus, string) {
 	// Never return "ConditionUnknown", that is reserved to mean "a setup task exists"
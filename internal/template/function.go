@@ -18,13 +18,18 @@ package template
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
 	"github.com/Masterminds/sprig"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // FuncMap returns the functions used for template evaluation
@@ -37,6 +42,12 @@ func FuncMap() template.FuncMap {
 		"duration":         duration,
 		"percent":          percent,
 		"resourceRequests": resourceRequests,
+		"resourceLimits":   resourceLimits,
+		"containers":       containers,
+		"replicaRequests":  replicaRequests,
+		"replicaLimits":    replicaLimits,
+		"quantity":         quantity,
+		"formatQuantity":   formatQuantity,
 	}
 
 	for k, v := range extra {
@@ -61,24 +72,251 @@ func percent(value int64, percent int64) string {
 
 // resourceRequests uses a map of resource types to weights to calculate a weighted sum of the resource requests
 func resourceRequests(pods corev1.PodList, weights string) (float64, error) {
-	var totalResources float64
-	parsedWeights := make(map[string]float64)
+	return weightedPodResources(pods, weights, requests)
+}
 
-	for _, singleEntry := range strings.Split(weights, ",") {
-		parsedEntry := strings.Split(singleEntry, "=")
-		weight, err := strconv.ParseFloat(parsedEntry[1], 64)
-		if err != nil {
-			return 0.0, fmt.Errorf("unable to parse weight for %s", parsedEntry[0])
+// resourceLimits uses a map of resource types to weights to calculate a weighted sum of the resource limits
+func resourceLimits(pods corev1.PodList, weights string) (float64, error) {
+	return weightedPodResources(pods, weights, limits)
+}
+
+// containers returns a copy of the pod list which only includes the named (comma separated) containers
+func containers(pods corev1.PodList, names string) corev1.PodList {
+	keep := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		keep[strings.TrimSpace(name)] = true
+	}
+
+	filter := func(cs []corev1.Container) []corev1.Container {
+		var result []corev1.Container
+		for i := range cs {
+			if keep[cs[i].Name] {
+				result = append(result, cs[i])
+			}
+		}
+		return result
+	}
+
+	result := *pods.DeepCopy()
+	for i := range result.Items {
+		result.Items[i].Spec.Containers = filter(result.Items[i].Spec.Containers)
+		result.Items[i].Spec.InitContainers = filter(result.Items[i].Spec.InitContainers)
+	}
+	return result
+}
+
+// replicaRequests calculates a weighted sum of the resource requests of a deployment or stateful set, accounting for
+// the number of replicas
+func replicaRequests(workload interface{}, weights string) (float64, error) {
+	return weightedReplicaResources(workload, weights, requests)
+}
+
+// replicaLimits calculates a weighted sum of the resource limits of a deployment or stateful set, accounting for the
+// number of replicas
+func replicaLimits(workload interface{}, weights string) (float64, error) {
+	return weightedReplicaResources(workload, weights, limits)
+}
+
+// quantity parses a Kubernetes quantity (e.g. "500m" or "1Gi") into a floating point value expressed in base units
+func quantity(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case resource.Quantity:
+		return float64(v.MilliValue()) / 1000, nil
+	}
+
+	q, err := resource.ParseQuantity(strings.TrimSpace(fmt.Sprint(value)))
+	if err != nil {
+		return 0, err
+	}
+	return float64(q.MilliValue()) / 1000, nil
+}
+
+// formatQuantity formats a value expressed in base units as a Kubernetes quantity using the supplied suffix (e.g. "m"
+// or "Mi"); the result is rounded to three decimal places
+func formatQuantity(suffix string, value interface{}) (string, error) {
+	v, err := quantity(value)
+	if err != nil {
+		return "", err
+	}
+	unit, err := resource.ParseQuantity("1" + suffix)
+	if err != nil {
+		return "", fmt.Errorf("invalid quantity suffix %q", suffix)
+	}
+	v = v / (float64(unit.MilliValue()) / 1000)
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64) + suffix, nil
+}
+
+// nodeCost returns a function which calculates the cost of running a list of pods using a table of prices (e.g. the
+// data from a config map). The table is keyed by the instance type of the node each pod is running on, falling back to
+// a "default" key; the values are weights (using the same format as `resourceRequests`) applied to the resource
+// requests in base units (e.g. cores or bytes). Nodes are resolved using the supplied function, which may be nil.
+func nodeCost(node func(string) (*corev1.Node, error)) func(corev1.PodList, map[string]string) (float64, error) {
+	return func(pods corev1.PodList, prices map[string]string) (float64, error) {
+		var total float64
+		for i := range pods.Items {
+			instanceType := ""
+			if pods.Items[i].Spec.NodeName != "" && node != nil {
+				n, err := node(pods.Items[i].Spec.NodeName)
+				if err != nil {
+					return 0, err
+				}
+				instanceType = n.Labels["node.kubernetes.io/instance-type"]
+				if instanceType == "" {
+					instanceType = n.Labels[corev1.LabelInstanceType]
+				}
+			}
+
+			price, ok := prices[instanceType]
+			if !ok || instanceType == "" {
+				if price, ok = prices["default"]; !ok {
+					return 0, fmt.Errorf("no price for instance type %q", instanceType)
+				}
+			}
+
+			w, err := parseWeights(price)
+			if err != nil {
+				return 0, err
+			}
+			for name, q := range podResources(&pods.Items[i].Spec, requests) {
+				total += w[name] * float64(q.MilliValue()) / 1000
+			}
+		}
+		return total, nil
+	}
+}
+
+// resourceList selects either the requests or limits of a container
+type resourceList func(*corev1.Container) corev1.ResourceList
+
+func requests(c *corev1.Container) corev1.ResourceList { return c.Resources.Requests }
+func limits(c *corev1.Container) corev1.ResourceList   { return c.Resources.Limits }
+
+// weightedPodResources calculates the weighted sum of resources (in milli-units) for a list of pods
+func weightedPodResources(pods corev1.PodList, weights string, rl resourceList) (float64, error) {
+	w, err := parseWeights(weights)
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+	for i := range pods.Items {
+		total += weightedSum(podResources(&pods.Items[i].Spec, rl), w)
+	}
+	return total, nil
+}
+
+// weightedReplicaResources calculates the weighted sum of resources (in milli-units) for the replicas of a workload
+func weightedReplicaResources(workload interface{}, weights string, rl resourceList) (float64, error) {
+	w, err := parseWeights(weights)
+	if err != nil {
+		return 0, err
+	}
+
+	spec, replicas, err := podTemplate(workload)
+	if err != nil {
+		return 0, err
+	}
+	return float64(replicas) * weightedSum(podResources(spec, rl), w), nil
+}
+
+// podTemplate returns the pod specification and number of replicas from a deployment or stateful set; the workload
+// may also be the unstructured content of either kind (e.g. the target of a patch)
+func podTemplate(workload interface{}) (*corev1.PodSpec, int32, error) {
+	if u, ok := workload.(*unstructured.Unstructured); ok {
+		workload = u.UnstructuredContent()
+	}
+	if m, ok := workload.(map[string]interface{}); ok {
+		var obj runtime.Object
+		switch kind, _, _ := unstructured.NestedString(m, "kind"); kind {
+		case "Deployment":
+			obj = &appsv1.Deployment{}
+		case "StatefulSet":
+			obj = &appsv1.StatefulSet{}
+		default:
+			return nil, 0, fmt.Errorf("unsupported workload kind %q", kind)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, obj); err != nil {
+			return nil, 0, err
+		}
+		workload = obj
+	}
+
+	var spec *corev1.PodSpec
+	var replicas *int32
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		spec, replicas = &w.Spec.Template.Spec, w.Spec.Replicas
+	case appsv1.Deployment:
+		spec, replicas = &w.Spec.Template.Spec, w.Spec.Replicas
+	case *appsv1.StatefulSet:
+		spec, replicas = &w.Spec.Template.Spec, w.Spec.Replicas
+	case appsv1.StatefulSet:
+		spec, replicas = &w.Spec.Template.Spec, w.Spec.Replicas
+	default:
+		return nil, 0, fmt.Errorf("unsupported workload type %T", workload)
+	}
+
+	// Replicas default to one
+	if replicas == nil {
+		return spec, 1, nil
+	}
+	return spec, *replicas, nil
+}
+
+// podResources returns the effective resources of a pod: for each resource the larger of the sum of the containers or
+// the largest of the init containers (init containers run sequentially before the other containers start)
+func podResources(spec *corev1.PodSpec, rl resourceList) corev1.ResourceList {
+	result := corev1.ResourceList{}
+	for i := range spec.Containers {
+		for name, q := range rl(&spec.Containers[i]) {
+			total := result[name]
+			total.Add(q)
+			result[name] = total
 		}
-		parsedWeights[parsedEntry[0]] = weight
 	}
-	for _, pod := range pods.Items {
-		for _, container := range pod.Spec.Containers {
-			for resourceType, weight := range parsedWeights {
-				resourceValue := container.Resources.Requests[corev1.ResourceName(resourceType)]
-				totalResources += weight * float64(resourceValue.MilliValue())
+	for i := range spec.InitContainers {
+		for name, q := range rl(&spec.InitContainers[i]) {
+			if total, ok := result[name]; !ok || q.Cmp(total) > 0 {
+				result[name] = q.DeepCopy()
 			}
 		}
 	}
-	return totalResources, nil
+	return result
+}
+
+// weightedSum returns the sum of the weighted resource values (in milli-units)
+func weightedSum(resources corev1.ResourceList, weights map[corev1.ResourceName]float64) float64 {
+	var total float64
+	for name, weight := range weights {
+		q := resources[name]
+		total += weight * float64(q.MilliValue())
+	}
+	return total
+}
+
+// parseWeights parses a comma separated list of "resource=weight" pairs
+func parseWeights(weights string) (map[corev1.ResourceName]float64, error) {
+	result := make(map[corev1.ResourceName]float64)
+	for _, entry := range strings.Split(weights, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid weight %q, expected resource=weight", entry)
+		}
+		name := strings.TrimSpace(kv[0])
+		weight, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse weight for %s", name)
+		}
+		result[corev1.ResourceName(name)] = weight
+	}
+	return result, nil
 }
@@ -0,0 +1,176 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestResourceRequests(t *testing.T) {
+	pods := corev1.PodList{Items: []corev1.Pod{{Spec: testPodSpec()}}}
+
+	cases := []struct {
+		desc     string
+		weights  string
+		limits   bool
+		expected float64
+		err      bool
+	}{
+		{desc: "requests", weights: "cpu=1,memory=0.001", expected: 300 + 2000},
+		{desc: "limits", weights: "cpu=1", limits: true, expected: 1000},
+		{desc: "spaces", weights: " cpu = 1 , ", expected: 300},
+		{desc: "missing weight", weights: "cpu", err: true},
+		{desc: "invalid weight", weights: "cpu=x", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var actual float64
+			var err error
+			if c.limits {
+				actual, err = resourceLimits(pods, c.weights)
+			} else {
+				actual, err = resourceRequests(pods, c.weights)
+			}
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.InDelta(t, c.expected, actual, 0.0001)
+			}
+		})
+	}
+}
+
+func TestContainers(t *testing.T) {
+	pods := corev1.PodList{Items: []corev1.Pod{{Spec: testPodSpec()}}}
+
+	actual, err := resourceRequests(containers(pods, "app"), "cpu=1")
+	if assert.NoError(t, err) {
+		assert.InDelta(t, 200, actual, 0.0001)
+	}
+	assert.Len(t, pods.Items[0].Spec.Containers, 2, "original pods should not be modified")
+}
+
+func TestReplicaRequests(t *testing.T) {
+	replicas := int32(3)
+	d := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: testPodSpec()},
+		},
+	}
+
+	actual, err := replicaRequests(d, "cpu=1")
+	if assert.NoError(t, err) {
+		assert.InDelta(t, 900, actual, 0.0001)
+	}
+
+	u := &unstructured.Unstructured{}
+	u.Object, err = runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+	if assert.NoError(t, err) {
+		actual, err = replicaRequests(u.Object, "cpu=1")
+		if assert.NoError(t, err) {
+			assert.InDelta(t, 900, actual, 0.0001)
+		}
+	}
+
+	_, err = replicaRequests(&corev1.Pod{}, "cpu=1")
+	assert.Error(t, err)
+}
+
+func TestQuantity(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		suffix   string
+		expected string
+	}{
+		{value: "500m", suffix: "m", expected: "500m"},
+		{value: "1Gi", suffix: "Mi", expected: "1024Mi"},
+		{value: 1.5, suffix: "m", expected: "1500m"},
+		{value: "2", suffix: "", expected: "2"},
+		{value: 1073741824, suffix: "Gi", expected: "1Gi"},
+	}
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual, err := formatQuantity(c.suffix, c.value)
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, actual)
+			}
+		})
+	}
+
+	_, err := quantity("not a quantity")
+	assert.Error(t, err)
+}
+
+func TestNodeCost(t *testing.T) {
+	pods := corev1.PodList{Items: []corev1.Pod{
+		{Spec: corev1.PodSpec{NodeName: "big", Containers: []corev1.Container{testContainer("a", "1", "1Gi")}}},
+		{Spec: corev1.PodSpec{NodeName: "small", Containers: []corev1.Container{testContainer("b", "500m", "1Gi")}}},
+	}}
+	nodes := map[string]*corev1.Node{
+		"big":   {ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"node.kubernetes.io/instance-type": "m5.large"}}},
+		"small": {ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"node.kubernetes.io/instance-type": "t3.small"}}},
+	}
+	node := func(name string) (*corev1.Node, error) { return nodes[name], nil }
+	prices := map[string]string{
+		"m5.large": "cpu=0.04",
+		"default":  "cpu=0.02",
+	}
+
+	actual, err := nodeCost(node)(pods, prices)
+	if assert.NoError(t, err) {
+		assert.InDelta(t, 0.04+0.01, actual, 0.0001)
+	}
+
+	_, err = nodeCost(node)(pods, map[string]string{"m5.large": "cpu=0.04"})
+	assert.Error(t, err)
+}
+
+func testPodSpec() corev1.PodSpec {
+	c := testContainer("sidecar", "100m", "1000")
+	c.Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}
+	return corev1.PodSpec{
+		InitContainers: []corev1.Container{testContainer("init", "250m", "1000")},
+		Containers:     []corev1.Container{testContainer("app", "200m", "1000"), c},
+	}
+}
+
+func testContainer(name, cpu, memory string) corev1.Container {
+	return corev1.Container{
+		Name: name,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("500m"),
+			},
+		},
+	}
+}
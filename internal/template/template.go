@@ -18,24 +18,35 @@ package template
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"math"
+	"regexp"
+	"strings"
 	"text/template"
 	"time"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // PatchData represents a trial during patch evaluation
 type PatchData struct {
 	// Trial metadata
 	Trial metav1.ObjectMeta
-	// Trial assignments
-	Values map[string]int64
+	// Trial assignments (integer assignments are int64, double and categorical assignments are strings)
+	Values map[string]interface{}
+	// Current content of the object being patched (only available if the object exists)
+	Target map[string]interface{}
 }
 
 // MetricData represents a trial during metric evaluation
@@ -48,34 +59,49 @@ type MetricData struct {
 	CompletionTime time.Time
 	// The duration of the trial run expressed as a Prometheus range value
 	Range string
-	// Trial assignments
-	Values map[string]int64
+	// Trial assignments (integer assignments are int64, double and categorical assignments are strings)
+	Values map[string]interface{}
 	// List of pods from the trial namespace (only available for "pods" type metrics)
 	Pods *corev1.PodList
+	// Experiment parameters indexed by name (only available if the engine was created for an experiment)
+	Parameters map[string]*redskyv1beta1.Parameter
+	// The trial run job, including it's status (only available if the engine was created for a trial job)
+	Job *batchv1.Job
+	// List of pods created by the trial run job (only available if the engine was created for a trial job)
+	JobPods *corev1.PodList
 }
 
-func newPatchData(t *redskyv1beta1.Trial) *PatchData {
+func newPatchData(t *redskyv1beta1.Trial, target runtime.Object) *PatchData {
 	d := &PatchData{}
 
 	t.ObjectMeta.DeepCopyInto(&d.Trial)
 
-	d.Values = make(map[string]int64, len(t.Spec.Assignments))
-	for _, a := range t.Spec.Assignments {
-		d.Values[a.Name] = a.Value
+	d.Values = assignmentValues(t)
+
+	if u, ok := target.(*unstructured.Unstructured); ok && u != nil {
+		d.Target = u.UnstructuredContent()
 	}
 
 	return d
 }
 
-func newMetricData(t *redskyv1beta1.Trial, target runtime.Object) *MetricData {
+func newMetricData(e *Engine, t *redskyv1beta1.Trial, target runtime.Object) *MetricData {
 	d := &MetricData{}
 
+	if e.experiment != nil {
+		d.Parameters = make(map[string]*redskyv1beta1.Parameter, len(e.experiment.Spec.Parameters))
+		for i := range e.experiment.Spec.Parameters {
+			p := e.experiment.Spec.Parameters[i].DeepCopy()
+			d.Parameters[p.Name] = p
+		}
+	}
+
+	d.Job = e.job
+	d.JobPods = e.jobPods
+
 	t.ObjectMeta.DeepCopyInto(&d.Trial)
 
-	d.Values = make(map[string]int64, len(t.Spec.Assignments))
-	for _, a := range t.Spec.Assignments {
-		d.Values[a.Name] = a.Value
-	}
+	d.Values = assignmentValues(t)
 
 	if pods, ok := target.(*corev1.PodList); ok {
 		d.Pods = pods
@@ -94,27 +120,92 @@ func newMetricData(t *redskyv1beta1.Trial, target runtime.Object) *MetricData {
 	return d
 }
 
+func assignmentValues(t *redskyv1beta1.Trial) map[string]interface{} {
+	values := make(map[string]interface{}, len(t.Spec.Assignments))
+	for _, a := range t.Spec.Assignments {
+		if a.Value.Type == intstr.String {
+			values[a.Name] = a.Value.StrVal
+		} else {
+			values[a.Name] = int64(a.Value.IntVal)
+		}
+	}
+	return values
+}
+
 // Engine is used to render Go text templates
 type Engine struct {
 	FuncMap template.FuncMap
+
+	// Named templates which can be included from any of the rendered templates
+	templates *template.Template
+	// The experiment whose templates are being rendered
+	experiment *redskyv1beta1.Experiment
+	// The trial run job and it's pods
+	job     *batchv1.Job
+	jobPods *corev1.PodList
+	// Reader used to lookup cluster state (e.g. price tables or nodes)
+	ctx    context.Context
+	reader client.Reader
+	// The namespaces config maps can be read from
+	namespaces []string
 }
 
 // New creates a new template engine
 func New() *Engine {
 	f := FuncMap()
-	return &Engine{
+	e := &Engine{
 		FuncMap: f,
 	}
+	e.templates = template.New("").Funcs(f).Funcs(e.funcs(nil))
+	return e
 }
 
-// TODO Investigate better use of template names
-// Would it be possible to have the template engine hold more scope? e.g. create the template engine using the full list
-// of patch templates or metrics (or the experiment itself, trial for HelmValues) and then render the individual values by template name?
+// NewForExperiment creates a new template engine for rendering the patches and metrics of the supplied experiment. The
+// named templates (i.e. those created using "define") from all of the patches and metrics are shared, allowing any
+// rendered template to "include" them.
+func NewForExperiment(exp *redskyv1beta1.Experiment) (*Engine, error) {
+	e := New()
+	e.experiment = exp
+	for i := range exp.Spec.Patches {
+		if _, err := e.templates.New(patchName(&exp.Spec.Patches[i])).Parse(exp.Spec.Patches[i].Patch); err != nil {
+			return nil, err
+		}
+	}
+	for i := range exp.Spec.Metrics {
+		if _, err := e.templates.New(metricName(&exp.Spec.Metrics[i], false)).Parse(exp.Spec.Metrics[i].Query); err != nil {
+			return nil, err
+		}
+		if _, err := e.templates.New(metricName(&exp.Spec.Metrics[i], true)).Parse(exp.Spec.Metrics[i].ErrorQuery); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// ForTrialJob returns a copy of the engine which makes the supplied trial run job and it's pods available to metric
+// templates; either value may be nil
+func (e *Engine) ForTrialJob(job *batchv1.Job, pods *corev1.PodList) *Engine {
+	ee := *e
+	ee.job = job
+	ee.jobPods = pods
+	return &ee
+}
+
+// WithLookup returns a copy of the engine which allows templates to lookup cluster state using the supplied reader;
+// config maps can only be read from the supplied namespaces
+func (e *Engine) WithLookup(ctx context.Context, r client.Reader, namespaces ...string) *Engine {
+	ee := *e
+	ee.ctx = ctx
+	ee.reader = r
+	ee.namespaces = namespaces
+	return &ee
+}
 
-// RenderPatch returns the JSON representation of the supplied patch template (input can be a Go template that produces YAML)
-func (e *Engine) RenderPatch(patch *redskyv1beta1.PatchTemplate, trial *redskyv1beta1.Trial) ([]byte, error) {
-	data := newPatchData(trial)
-	b, err := e.render("patch", patch.Patch, data) // TODO What should we use for patch template names? Something from the targetRef?
+// RenderPatch returns the JSON representation of the supplied patch template (input can be a Go template that produces
+// YAML); the target is the current state of the patched object and may be nil
+func (e *Engine) RenderPatch(patch *redskyv1beta1.PatchTemplate, trial *redskyv1beta1.Trial, target runtime.Object) ([]byte, error) {
+	data := newPatchData(trial, target)
+	b, err := e.render(patchName(patch), patch.Patch, data)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +214,7 @@ func (e *Engine) RenderPatch(patch *redskyv1beta1.PatchTemplate, trial *redskyv1
 
 // RenderHelmValue returns a rendered string of the supplied Helm value
 func (e *Engine) RenderHelmValue(helmValue *redskyv1beta1.HelmValue, trial *redskyv1beta1.Trial) (string, error) {
-	data := newPatchData(trial)
+	data := newPatchData(trial, nil)
 	b, err := e.render(helmValue.Name, helmValue.Value.String(), data)
 	if err != nil {
 		return "", err
@@ -131,14 +222,24 @@ func (e *Engine) RenderHelmValue(helmValue *redskyv1beta1.HelmValue, trial *reds
 	return b.String(), nil
 }
 
+// RenderSetupValue returns a rendered string of the supplied setup task value (e.g. a command argument or URL)
+func (e *Engine) RenderSetupValue(name, value string, trial *redskyv1beta1.Trial) (string, error) {
+	data := newPatchData(trial, nil)
+	b, err := e.render(name, value, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
 // RenderMetricQueries returns the metric query and the metric error query
 func (e *Engine) RenderMetricQueries(metric *redskyv1beta1.Metric, trial *redskyv1beta1.Trial, target runtime.Object) (string, string, error) {
-	data := newMetricData(trial, target)
-	b1, err := e.render(metric.Name, metric.Query, data)
+	data := newMetricData(e, trial, target)
+	b1, err := e.render(metricName(metric, false), metric.Query, data)
 	if err != nil {
 		return "", "", err
 	}
-	b2, err := e.render(metric.Name, metric.ErrorQuery, data)
+	b2, err := e.render(metricName(metric, true), metric.ErrorQuery, data)
 	if err != nil {
 		return "", "", err
 	}
@@ -146,7 +247,14 @@ func (e *Engine) RenderMetricQueries(metric *redskyv1beta1.Metric, trial *redsky
 }
 
 func (e *Engine) render(name, text string, data interface{}) (*bytes.Buffer, error) {
-	tmpl, err := template.New(name).Funcs(e.FuncMap).Parse(text)
+	// Work on a copy of the named templates so the shared set is not modified
+	templates, err := e.templates.Clone()
+	if err != nil {
+		return nil, err
+	}
+	templates.Funcs(e.FuncMap).Funcs(e.funcs(templates))
+
+	tmpl, err := templates.New(name).Parse(text)
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +265,102 @@ func (e *Engine) render(name, text string, data interface{}) (*bytes.Buffer, err
 	}
 	return b, nil
 }
+
+// funcs returns the template functions which depend on the state of the engine
+func (e *Engine) funcs(templates *template.Template) template.FuncMap {
+	nodes := make(map[string]*corev1.Node)
+	return template.FuncMap{
+		// include renders a named template to a string so it can be used in a pipeline
+		"include": func(name string, data interface{}) (string, error) {
+			if templates == nil {
+				return "", fmt.Errorf("template %q not defined", name)
+			}
+			b := &bytes.Buffer{}
+			if err := templates.ExecuteTemplate(b, name, data); err != nil {
+				return "", err
+			}
+			return b.String(), nil
+		},
+
+		// configMap returns the data of the named config map, only the namespaces of the lookup can be read
+		"configMap": func(namespace, name string) (map[string]string, error) {
+			if e.reader == nil {
+				return nil, fmt.Errorf("config map lookup is not available")
+			}
+			if !e.canLookup(namespace) {
+				return nil, fmt.Errorf("config map lookup is not allowed in namespace %q", namespace)
+			}
+			cm := &corev1.ConfigMap{}
+			if err := e.reader.Get(e.ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+				return nil, err
+			}
+			return cm.Data, nil
+		},
+
+		// nodeCost calculates the cost of running pods using a price table for the instance type of each node
+		"nodeCost": nodeCost(func(name string) (*corev1.Node, error) {
+			if e.reader == nil {
+				return &corev1.Node{}, nil
+			}
+			if n, ok := nodes[name]; ok {
+				return n, nil
+			}
+			n := &corev1.Node{}
+			if err := e.reader.Get(e.ctx, types.NamespacedName{Name: name}, n); err != nil {
+				return nil, err
+			}
+			nodes[name] = n
+			return n, nil
+		}),
+	}
+}
+
+// canLookup checks to see if config maps in the supplied namespace can be read
+func (e *Engine) canLookup(namespace string) bool {
+	for _, ns := range e.namespaces {
+		if ns != "" && ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// execContextPattern matches the node being evaluated in the message of a template execution error
+	execContextPattern = regexp.MustCompile(`at <([^>]*)>`)
+	// trialStatePattern matches the nodes which depend on state that is only available while rendering for a trial
+	trialStatePattern = regexp.MustCompile(`(^|[\s($])\.(Target|Job|JobPods)\b|^(configMap|nodeCost)\b`)
+)
+
+// IsTrialStateError checks to see if the supplied error was caused by evaluating state that is only available when
+// rendering for an actual trial: the current patch target, the trial run job and it's pods, or a cluster lookup
+func IsTrialStateError(err error) bool {
+	var execErr template.ExecError
+	if !errors.As(err, &execErr) {
+		return false
+	}
+
+	// Errors from included templates are nested in the message, check each of the nodes being evaluated
+	for _, m := range execContextPattern.FindAllStringSubmatch(err.Error(), -1) {
+		if trialStatePattern.MatchString(m[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// patchName returns the template name used for a patch
+func patchName(patch *redskyv1beta1.PatchTemplate) string {
+	if patch.TargetRef != nil && patch.TargetRef.Name != "" {
+		return fmt.Sprintf("patch/%s/%s", strings.ToLower(patch.TargetRef.Kind), patch.TargetRef.Name)
+	}
+	return "patch"
+}
+
+// metricName returns the template name used for a metric query
+func metricName(metric *redskyv1beta1.Metric, errorQuery bool) string {
+	if errorQuery {
+		return fmt.Sprintf("metric/%s/error", metric.Name)
+	}
+	return fmt.Sprintf("metric/%s", metric.Name)
+}
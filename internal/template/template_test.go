@@ -17,17 +17,22 @@ limitations under the License.
 package template
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestEngine(t *testing.T) {
@@ -62,6 +67,39 @@ func TestEngine(t *testing.T) {
 			},
 			expected: `{"metadata":{"labels":{"app":"testApp"}}}`,
 		},
+		{
+			desc: "patch assignments",
+			trial: &redskyv1beta1.Trial{
+				Spec: redskyv1beta1.TrialSpec{
+					Assignments: []redskyv1beta1.Assignment{
+						{Name: "one", Value: redskyv1beta1.FromInt64(100)},
+						{Name: "two", Value: redskyv1beta1.FromString("0.25")},
+						{Name: "three", Value: redskyv1beta1.FromString("G1")},
+					},
+				},
+			},
+			input: &redskyv1beta1.PatchTemplate{
+				Patch: "metadata:\n  labels:\n    one: \"{{ add .Values.one 1 }}\"\n    two: \"{{ .Values.two }}\"\n    three: \"{{ .Values.three | lower }}\"\n",
+			},
+			expected: `{"metadata":{"labels":{"one":"101","three":"g1","two":"0.25"}}}`,
+		},
+		{
+			desc:  "patch target",
+			trial: &redskyv1beta1.Trial{},
+			input: &redskyv1beta1.PatchTemplate{
+				Patch: "metadata:\n  annotations:\n    app: \"{{ .Target.metadata.labels.app }}\"\n",
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"tier": "backend"},
+				},
+			},
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "testDeployment",
+					"labels": map[string]interface{}{"app": "testApp", "tier": "backend"},
+				},
+			}},
+			expected: `{"metadata":{"annotations":{"app":"testApp"}}}`,
+		},
 		{
 			desc: "default helm",
 			trial: &redskyv1beta1.Trial{
@@ -158,7 +196,7 @@ func TestEngine(t *testing.T) {
 
 			switch tc.input.(type) {
 			case *redskyv1beta1.PatchTemplate:
-				boutput, err = eng.RenderPatch(tc.input.(*redskyv1beta1.PatchTemplate), tc.trial)
+				boutput, err = eng.RenderPatch(tc.input.(*redskyv1beta1.PatchTemplate), tc.trial, tc.obj)
 				got = string(boutput)
 			case *redskyv1beta1.HelmValue:
 				got, err = eng.RenderHelmValue(tc.input.(*redskyv1beta1.HelmValue), tc.trial)
@@ -170,3 +208,146 @@ func TestEngine(t *testing.T) {
 		})
 	}
 }
+
+func TestNewForExperiment(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{
+		Spec: redskyv1beta1.ExperimentSpec{
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "cpu", Min: redskyv1beta1.FromInt64(100), Max: redskyv1beta1.FromInt64(4000)},
+			},
+			Patches: []redskyv1beta1.PatchTemplate{
+				{
+					TargetRef: &corev1.ObjectReference{Kind: "ConfigMap", Name: "one"},
+					Patch:     `{{ define "labels" }}{"app":"{{ .Trial.Name }}"}{{ end }}{"metadata":{"labels":{{ include "labels" . }}}}`,
+				},
+				{
+					TargetRef: &corev1.ObjectReference{Kind: "ConfigMap", Name: "two"},
+					Patch:     `{"metadata":{"labels":{{ include "labels" . }}}}`,
+				},
+			},
+			Metrics: []redskyv1beta1.Metric{
+				{Name: "max", Query: "{{ .Parameters.cpu.Max }}"},
+				{Name: "job", Query: "{{ .Job.Name }}-{{ len .JobPods.Items }}"},
+			},
+		},
+	}
+	trial := &redskyv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+
+	eng, err := NewForExperiment(exp)
+	require.NoError(t, err)
+
+	for i := range exp.Spec.Patches {
+		b, err := eng.RenderPatch(&exp.Spec.Patches[i], trial, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"metadata":{"labels":{"app":"test"}}}`, string(b))
+		}
+	}
+
+	q, _, err := eng.RenderMetricQueries(&exp.Spec.Metrics[0], trial, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4000", q)
+	}
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-job"}}
+	pods := &corev1.PodList{Items: []corev1.Pod{{}, {}}}
+	q, _, err = eng.ForTrialJob(job, pods).RenderMetricQueries(&exp.Spec.Metrics[1], trial, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "test-job-2", q)
+	}
+
+	// Named templates are not available without the experiment
+	_, err = New().RenderPatch(&exp.Spec.Patches[1], trial, nil)
+	assert.Error(t, err)
+}
+
+func TestEngine_ConfigMapLookup(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	reader := fake.NewFakeClientWithScheme(scheme,
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "prices"}, Data: map[string]string{"default": "cpu=1"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "secrets"}, Data: map[string]string{"default": "cpu=2"}},
+	)
+	trial := &redskyv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	cases := []struct {
+		desc      string
+		namespace string
+		name      string
+		expected  string
+		err       bool
+	}{
+		{
+			desc:      "trial namespace",
+			namespace: "default",
+			name:      "prices",
+			expected:  "cpu=1",
+		},
+		{
+			desc:      "other namespace",
+			namespace: "kube-system",
+			name:      "secrets",
+			err:       true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			metric := &redskyv1beta1.Metric{Name: "test", Query: fmt.Sprintf(`{{ index (configMap %q %q) "default" }}`, c.namespace, c.name)}
+			eng := New().WithLookup(context.TODO(), reader, "default")
+			q, _, err := eng.RenderMetricQueries(metric, trial, nil)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, q)
+			}
+		})
+	}
+}
+
+func TestIsTrialStateError(t *testing.T) {
+	cases := []struct {
+		desc     string
+		patch    string
+		expected bool
+	}{
+		{
+			desc:     "target",
+			patch:    `{{ len .Target.spec.containers }}`,
+			expected: true,
+		},
+		{
+			desc:     "target function argument",
+			patch:    `{{ index .Target.spec "replicas" }}`,
+			expected: true,
+		},
+		{
+			desc:     "lookup",
+			patch:    `{{ configMap "default" "prices" }}`,
+			expected: true,
+		},
+		{
+			desc:     "values",
+			patch:    `{{ len .Values.cpu }}`,
+			expected: false,
+		},
+		{
+			desc:     "function",
+			patch:    `{{ fail "nope" }}`,
+			expected: false,
+		},
+		{
+			desc:     "undefined include",
+			patch:    `{{ include "missing" . }}`,
+			expected: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			_, err := New().RenderPatch(&redskyv1beta1.PatchTemplate{Patch: c.patch}, &redskyv1beta1.Trial{}, nil)
+			if assert.Error(t, err) {
+				assert.Equal(t, c.expected, IsTrialStateError(err), err.Error())
+			}
+		})
+	}
+}
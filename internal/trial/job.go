@@ -19,6 +19,7 @@ package trial
 import (
 	"encoding/json"
 	"fmt"
+	"path"
 	"time"
 
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
@@ -30,6 +31,32 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 )
 
+const (
+	// ResultsContainerName is the name of the sidecar container used to collect the trial run results file
+	ResultsContainerName = "trial-results"
+	// DefaultResultsPath is the location of the trial run results file when one is not specified
+	DefaultResultsPath = "/var/run/redskyops/results.json"
+)
+
+// resultsScript waits for every other container in the (shared) process namespace to exit before writing the
+// results file to standard out where it can be read from the container logs; processes started by the container
+// runtime have a parent PID of 0 and PID 1 is the pod's pause container. Since the sidecar may start before the other
+// containers, it only stops waiting once another container was seen running (or has already written the results).
+const resultsScript = `running() {
+  for s in /proc/[0-9]*/stat ; do
+    read -r pid comm state ppid rest < "$s" 2>/dev/null || continue
+    if [ "$ppid" = "0" ] && [ "$pid" != "1" ] && [ "$pid" != "$$" ] ; then return 0 ; fi
+  done
+  return 1
+}
+seen=""
+while : ; do
+  if running ; then seen="1" ; elif [ -n "$seen" ] || [ -f "$RESULTS" ] ; then break ; fi
+  sleep 1
+done
+if [ -f "$RESULTS" ] ; then cat "$RESULTS" ; fi
+`
+
 // NewJob returns a new trial run job from the template on the trial
 func NewJob(t *redskyv1beta1.Trial) *batchv1.Job {
 	job := &batchv1.Job{}
@@ -77,6 +104,11 @@ func NewJob(t *redskyv1beta1.Trial) *batchv1.Job {
 		addDefaultContainer(t, job)
 	}
 
+	// Collect the results file using a sidecar
+	if t.Spec.Results != nil {
+		addResultsSidecar(t, job)
+	}
+
 	// Check to see if there is patch for the (as of yet, non-existent) trial job
 	job = patchSelf(t, job)
 
@@ -104,6 +136,40 @@ func addDefaultContainer(t *redskyv1beta1.Trial, job *batchv1.Job) {
 	}
 }
 
+func addResultsSidecar(t *redskyv1beta1.Trial, job *batchv1.Job) {
+	resultsPath := t.Spec.Results.Path
+	if resultsPath == "" {
+		resultsPath = DefaultResultsPath
+	}
+	image := t.Spec.Results.Image
+	if image == "" {
+		image = "busybox"
+	}
+
+	// Share the results directory with every container
+	job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name:         ResultsContainerName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	mount := corev1.VolumeMount{Name: ResultsContainerName, MountPath: path.Dir(resultsPath)}
+	for i := range job.Spec.Template.Spec.Containers {
+		c := &job.Spec.Template.Spec.Containers[i]
+		c.VolumeMounts = append(c.VolumeMounts, mount)
+	}
+
+	// The sidecar needs to see the other processes to know when the results are complete
+	shareProcessNamespace := true
+	job.Spec.Template.Spec.ShareProcessNamespace = &shareProcessNamespace
+
+	job.Spec.Template.Spec.Containers = append(job.Spec.Template.Spec.Containers, corev1.Container{
+		Name:         ResultsContainerName,
+		Image:        image,
+		Command:      []string{"/bin/sh", "-c", resultsScript},
+		Env:          []corev1.EnvVar{{Name: "RESULTS", Value: resultsPath}},
+		VolumeMounts: []corev1.VolumeMount{mount},
+	})
+}
+
 func patchSelf(t *redskyv1beta1.Trial, job *batchv1.Job) *batchv1.Job {
 	// Look for patch operations that match this trial and apply them
 	for i := range t.Status.PatchOperations {
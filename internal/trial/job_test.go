@@ -0,0 +1,101 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewJobResults(t *testing.T) {
+	loadTest := &batchv1beta1.JobTemplateSpec{}
+	loadTest.Spec.Template.Spec.Containers = []corev1.Container{{Name: "load-test", Image: "loadimpact/k6"}}
+
+	cases := []struct {
+		desc       string
+		template   *batchv1beta1.JobTemplateSpec
+		results    *redskyv1beta1.ResultsCollection
+		containers []string
+		mountPath  string
+		image      string
+	}{
+		{
+			desc:       "no results",
+			template:   loadTest,
+			containers: []string{"load-test"},
+		},
+		{
+			desc:       "default results",
+			template:   loadTest,
+			results:    &redskyv1beta1.ResultsCollection{},
+			containers: []string{"load-test", ResultsContainerName},
+			mountPath:  "/var/run/redskyops",
+			image:      "busybox",
+		},
+		{
+			desc:       "default container",
+			results:    &redskyv1beta1.ResultsCollection{Path: "/results/summary.json", Image: "alpine"},
+			containers: []string{"default-trial-run", ResultsContainerName},
+			mountPath:  "/results",
+			image:      "alpine",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			tr := &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-001", Namespace: "default"},
+				Spec:       redskyv1beta1.TrialSpec{JobTemplate: c.template, Results: c.results},
+			}
+
+			job := NewJob(tr)
+			pod := &job.Spec.Template.Spec
+
+			var names []string
+			for i := range pod.Containers {
+				names = append(names, pod.Containers[i].Name)
+			}
+			assert.Equal(t, c.containers, names)
+
+			if c.results == nil {
+				assert.Empty(t, pod.Volumes)
+				assert.Nil(t, pod.ShareProcessNamespace)
+				return
+			}
+
+			if assert.Len(t, pod.Volumes, 1) {
+				assert.NotNil(t, pod.Volumes[0].EmptyDir)
+			}
+			if assert.NotNil(t, pod.ShareProcessNamespace) {
+				assert.True(t, *pod.ShareProcessNamespace)
+			}
+			for i := range pod.Containers {
+				if assert.Len(t, pod.Containers[i].VolumeMounts, 1) {
+					assert.Equal(t, c.mountPath, pod.Containers[i].VolumeMounts[0].MountPath)
+				}
+			}
+			assert.Equal(t, c.image, pod.Containers[len(pod.Containers)-1].Image)
+		})
+	}
+
+	// The template must not be modified
+	assert.Empty(t, loadTest.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
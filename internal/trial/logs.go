@@ -0,0 +1,201 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// RunLogs returns the logs of a container from the trial run pods. If there are multiple pods (e.g. because the job
+// was retried), the logs are concatenated starting with the oldest pod. When the container name is empty, the first
+// container of each pod is used.
+func RunLogs(pods corev1client.PodsGetter, t *redskyv1beta1.Trial, container string, opts *corev1.PodLogOptions) ([]byte, error) {
+	list, err := runPods(pods, t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pods which never started (e.g. image pull failures) do not have logs, only fail if there are no logs at all
+	var logs []byte
+	var logErr error
+	for i := range list {
+		pod := &list[i]
+		b, err := pods.Pods(pod.Namespace).GetLogs(pod.Name, logOptions(pod, container, opts)).DoRaw()
+		if err != nil {
+			logErr = err
+			continue
+		}
+		logs = append(logs, b...)
+	}
+	if logs == nil && logErr != nil {
+		return nil, logErr
+	}
+	return logs, nil
+}
+
+// StreamRunLogs is like RunLogs except the logs are streamed instead of being read into memory; the logs of each pod
+// are only requested once the logs of the previous pod have been read. The caller must close the returned reader.
+func StreamRunLogs(pods corev1client.PodsGetter, t *redskyv1beta1.Trial, container string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	list, err := runPods(pods, t)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &runLogsReader{}
+	for i := range list {
+		pod := &list[i]
+		r.streams = append(r.streams, pods.Pods(pod.Namespace).GetLogs(pod.Name, logOptions(pod, container, opts)).Stream)
+	}
+	return r, nil
+}
+
+// runLogsReader concatenates the log streams of multiple pods
+type runLogsReader struct {
+	streams []func() (io.ReadCloser, error)
+	current io.ReadCloser
+	opened  bool
+	err     error
+}
+
+// Read reads from the current log stream, opening the next stream when the current stream is exhausted
+func (r *runLogsReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.streams) == 0 {
+				// Pods which never started do not have logs, only fail if there were no logs at all
+				if !r.opened && r.err != nil {
+					return 0, r.err
+				}
+				return 0, io.EOF
+			}
+
+			rc, err := r.streams[0]()
+			r.streams = r.streams[1:]
+			if err != nil {
+				r.err = err
+				continue
+			}
+			r.current, r.opened = rc, true
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			_ = r.current.Close()
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+// Close closes the current log stream
+func (r *runLogsReader) Close() error {
+	r.streams = nil
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return err
+}
+
+// SucceededRunLogs returns the logs of a container from the most recent trial run pod which succeeded. When the
+// container name is empty, the first container of the pod is used.
+func SucceededRunLogs(pods corev1client.PodsGetter, t *redskyv1beta1.Trial, container string, opts *corev1.PodLogOptions) ([]byte, error) {
+	list, err := runPods(pods, t)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := latestSucceeded(list)
+	if pod == nil {
+		return nil, fmt.Errorf("unable to find a successful trial run pod")
+	}
+	return pods.Pods(pod.Namespace).GetLogs(pod.Name, logOptions(pod, container, opts)).DoRaw()
+}
+
+// runPods returns the trial run pods ordered by creation time, starting with the oldest pod
+func runPods(pods corev1client.PodsGetter, t *redskyv1beta1.Trial) ([]corev1.Pod, error) {
+	// The trial run job always adds these labels to the pod template
+	selector := labels.SelectorFromSet(map[string]string{
+		redskyv1beta1.LabelTrial:     t.Name,
+		redskyv1beta1.LabelTrialRole: "trialRun",
+	})
+	list, err := pods.Pods(t.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("unable to find trial run pods")
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].CreationTimestamp.Before(&list.Items[j].CreationTimestamp)
+	})
+	return list.Items, nil
+}
+
+// latestSucceeded returns the last pod in the list which succeeded, or nil if none of the pods succeeded
+func latestSucceeded(pods []corev1.Pod) *corev1.Pod {
+	for i := len(pods) - 1; i >= 0; i-- {
+		if pods[i].Status.Phase == corev1.PodSucceeded {
+			return &pods[i]
+		}
+	}
+	return nil
+}
+
+// logOptions returns the options for reading the logs of a container from the supplied pod
+func logOptions(pod *corev1.Pod, container string, opts *corev1.PodLogOptions) *corev1.PodLogOptions {
+	o := &corev1.PodLogOptions{}
+	if opts != nil {
+		opts.DeepCopyInto(o)
+	}
+	o.Container = container
+	if o.Container == "" && len(pod.Spec.Containers) > 0 {
+		o.Container = pod.Spec.Containers[0].Name
+	}
+	return o
+}
+
+// Tail returns at most the specified number of lines (and bytes) from the end of the logs
+func Tail(logs []byte, lines int64, limitBytes int) string {
+	logs = bytes.TrimRight(logs, "\n")
+	for i, n := len(logs)-1, int64(0); i >= 0; i-- {
+		if logs[i] == '\n' {
+			if n++; n >= lines {
+				logs = logs[i+1:]
+				break
+			}
+		}
+	}
+	if len(logs) > limitBytes {
+		logs = logs[len(logs)-limitBytes:]
+	}
+	return string(logs)
+}
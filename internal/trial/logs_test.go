@@ -0,0 +1,149 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTail(t *testing.T) {
+	cases := []struct {
+		desc       string
+		logs       string
+		lines      int64
+		limitBytes int
+		expected   string
+	}{
+		{desc: "empty", logs: "", lines: 10, limitBytes: 100, expected: ""},
+		{desc: "short", logs: "a\nb\n", lines: 10, limitBytes: 100, expected: "a\nb"},
+		{desc: "lines", logs: "a\nb\nc\nd\n", lines: 2, limitBytes: 100, expected: "c\nd"},
+		{desc: "no trailing newline", logs: "a\nb\nc", lines: 1, limitBytes: 100, expected: "c"},
+		{desc: "bytes", logs: "aaaa\nbbbb\n", lines: 10, limitBytes: 6, expected: "a\nbbbb"},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.expected, Tail([]byte(c.logs), c.lines, c.limitBytes))
+		})
+	}
+}
+
+func TestLatestSucceeded(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: corev1.PodStatus{Phase: phase}}
+	}
+
+	cases := []struct {
+		desc     string
+		pods     []corev1.Pod
+		expected string
+	}{
+		{
+			desc: "empty",
+		},
+		{
+			desc:     "single",
+			pods:     []corev1.Pod{pod("a", corev1.PodSucceeded)},
+			expected: "a",
+		},
+		{
+			desc:     "retried",
+			pods:     []corev1.Pod{pod("a", corev1.PodFailed), pod("b", corev1.PodSucceeded)},
+			expected: "b",
+		},
+		{
+			desc:     "multiple succeeded",
+			pods:     []corev1.Pod{pod("a", corev1.PodSucceeded), pod("b", corev1.PodSucceeded), pod("c", corev1.PodFailed)},
+			expected: "b",
+		},
+		{
+			desc: "none succeeded",
+			pods: []corev1.Pod{pod("a", corev1.PodFailed), pod("b", corev1.PodRunning)},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := latestSucceeded(c.pods)
+			if c.expected == "" {
+				assert.Nil(t, actual)
+			} else if assert.NotNil(t, actual) {
+				assert.Equal(t, c.expected, actual.Name)
+			}
+		})
+	}
+}
+
+func TestRunLogsReader(t *testing.T) {
+	logs := func(s string) func() (io.ReadCloser, error) {
+		return func() (io.ReadCloser, error) { return ioutil.NopCloser(strings.NewReader(s)), nil }
+	}
+	missing := func() (io.ReadCloser, error) {
+		return nil, fmt.Errorf("container is waiting to start")
+	}
+
+	cases := []struct {
+		desc     string
+		streams  []func() (io.ReadCloser, error)
+		expected string
+		err      bool
+	}{
+		{
+			desc: "empty",
+		},
+		{
+			desc:     "single",
+			streams:  []func() (io.ReadCloser, error){logs("a\nb\n")},
+			expected: "a\nb\n",
+		},
+		{
+			desc:     "concatenated",
+			streams:  []func() (io.ReadCloser, error){logs("a\n"), logs(""), logs("b\n")},
+			expected: "a\nb\n",
+		},
+		{
+			desc:     "missing logs",
+			streams:  []func() (io.ReadCloser, error){missing, logs("b\n")},
+			expected: "b\n",
+		},
+		{
+			desc:    "no logs",
+			streams: []func() (io.ReadCloser, error){missing},
+			err:     true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			r := &runLogsReader{streams: c.streams}
+			actual, err := ioutil.ReadAll(r)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, string(actual))
+			}
+			assert.NoError(t, r.Close())
+		})
+	}
+}
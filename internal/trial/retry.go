@@ -0,0 +1,115 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ReasonMetricUnreachable is the reason used on the failed condition of a trial whose metric endpoint could not be reached
+const ReasonMetricUnreachable = "MetricUnreachable"
+
+// DefaultRetryReasons are the failure reasons which are retried when the retry policy does not specify any
+var DefaultRetryReasons = []string{
+	"ErrImagePull",
+	"ImagePullBackOff",
+	"Evicted",
+	"Preempting",
+	"NodeLost",
+	ReasonMetricUnreachable,
+}
+
+// NeedsRetry checks to see if the specified trial failed for a reason that allows the assignments to be re-run
+func NeedsRetry(t *redskyv1beta1.Trial) bool {
+	p := t.Spec.RetryPolicy
+	if p == nil {
+		return false
+	}
+
+	limit := int32(1)
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+	if Retries(t) >= limit {
+		return false
+	}
+
+	for _, c := range t.Status.Conditions {
+		if c.Type == redskyv1beta1.TrialFailed && c.Status == corev1.ConditionTrue && IsRetryReason(t, c.Reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryReason checks to see if the retry policy of the specified trial allows failures with the supplied reason
+// to be retried; it does not consider how many times the trial has already been retried
+func IsRetryReason(t *redskyv1beta1.Trial, reason string) bool {
+	p := t.Spec.RetryPolicy
+	if p == nil {
+		return false
+	}
+
+	reasons := p.Reasons
+	if len(reasons) == 0 {
+		reasons = DefaultRetryReasons
+	}
+	for _, r := range reasons {
+		if reason == r {
+			return true
+		}
+	}
+	return false
+}
+
+// Retries returns the number of times the assignments of the specified trial have already been re-run
+func Retries(t *redskyv1beta1.Trial) int32 {
+	if r, err := strconv.ParseInt(t.GetAnnotations()[redskyv1beta1.AnnotationRetries], 10, 32); err == nil {
+		return int32(r)
+	}
+	return 0
+}
+
+// NewRetry returns a new trial which re-runs the assignments of the specified failed trial
+func NewRetry(t *redskyv1beta1.Trial) *redskyv1beta1.Trial {
+	retries := Retries(t)
+
+	r := &redskyv1beta1.Trial{}
+	r.Namespace = t.Namespace
+	r.Name = fmt.Sprintf("%s-retry-%d", strings.TrimSuffix(t.Name, fmt.Sprintf("-retry-%d", retries)), retries+1)
+	r.Labels = make(map[string]string, len(t.Labels))
+	for k, v := range t.Labels {
+		r.Labels[k] = v
+	}
+	r.Annotations = make(map[string]string, len(t.Annotations)+1)
+	for k, v := range t.Annotations {
+		r.Annotations[k] = v
+	}
+	r.Annotations[redskyv1beta1.AnnotationRetries] = strconv.FormatInt(int64(retries+1), 10)
+
+	// Start from the original specification, but without any of the values collected from the failed run
+	t.Spec.DeepCopyInto(&r.Spec)
+	r.Spec.Values = nil
+
+	UpdateStatus(r)
+	return r
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNeedsRetry(t *testing.T) {
+	two := int32(2)
+
+	cases := []struct {
+		desc    string
+		policy  *redskyv1beta1.RetryPolicy
+		reason  string
+		retries string
+		retry   bool
+	}{
+		{
+			desc:   "no policy",
+			reason: "Evicted",
+		},
+		{
+			desc:   "default reasons",
+			policy: &redskyv1beta1.RetryPolicy{},
+			reason: "Evicted",
+			retry:  true,
+		},
+		{
+			desc:   "not retryable",
+			policy: &redskyv1beta1.RetryPolicy{},
+			reason: "BackoffLimitExceeded",
+		},
+		{
+			desc:   "custom reasons",
+			policy: &redskyv1beta1.RetryPolicy{Reasons: []string{"BackoffLimitExceeded"}},
+			reason: "BackoffLimitExceeded",
+			retry:  true,
+		},
+		{
+			desc:    "default limit",
+			policy:  &redskyv1beta1.RetryPolicy{},
+			reason:  "Evicted",
+			retries: "1",
+		},
+		{
+			desc:    "custom limit",
+			policy:  &redskyv1beta1.RetryPolicy{Limit: &two},
+			reason:  ReasonMetricUnreachable,
+			retries: "1",
+			retry:   true,
+		},
+		{
+			desc:   "not failed",
+			policy: &redskyv1beta1.RetryPolicy{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			tt := &redskyv1beta1.Trial{}
+			tt.Spec.RetryPolicy = c.policy
+			if c.retries != "" {
+				tt.Annotations = map[string]string{redskyv1beta1.AnnotationRetries: c.retries}
+			}
+			if c.reason != "" {
+				tt.Status.Conditions = []redskyv1beta1.TrialCondition{{Type: redskyv1beta1.TrialFailed, Status: corev1.ConditionTrue, Reason: c.reason}}
+			}
+			assert.Equal(t, c.retry, NeedsRetry(tt))
+		})
+	}
+}
+
+func TestNewRetry(t *testing.T) {
+	tt := &redskyv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-001",
+			Namespace:   "default",
+			Labels:      map[string]string{redskyv1beta1.LabelExperiment: "test"},
+			Annotations: map[string]string{redskyv1beta1.AnnotationReportTrialURL: "http://example.com/trials/1"},
+			Finalizers:  []string{"test"},
+		},
+		Spec: redskyv1beta1.TrialSpec{
+			Assignments: []redskyv1beta1.Assignment{{Name: "one", Value: redskyv1beta1.FromInt64(1)}},
+			Values:      []redskyv1beta1.Value{{Name: "cost", AttemptsRemaining: 2}},
+		},
+		Status: redskyv1beta1.TrialStatus{
+			Conditions: []redskyv1beta1.TrialCondition{{Type: redskyv1beta1.TrialFailed, Status: corev1.ConditionTrue, Reason: "Evicted"}},
+		},
+	}
+
+	r := NewRetry(tt)
+	assert.Equal(t, "test-001-retry-1", r.Name)
+	assert.Equal(t, "default", r.Namespace)
+	assert.Equal(t, "test", r.Labels[redskyv1beta1.LabelExperiment])
+	assert.Equal(t, "http://example.com/trials/1", r.Annotations[redskyv1beta1.AnnotationReportTrialURL])
+	assert.Equal(t, int32(1), Retries(r))
+	assert.Empty(t, r.Finalizers)
+	assert.Equal(t, tt.Spec.Assignments, r.Spec.Assignments)
+	assert.Empty(t, r.Spec.Values)
+	assert.False(t, IsFinished(r))
+
+	// Retries of retries keep the original name
+	r = NewRetry(r)
+	assert.Equal(t, "test-001-retry-2", r.Name)
+	assert.Equal(t, int32(2), Retries(r))
+
+	// The original trial is not modified
+	assert.Empty(t, tt.Annotations[redskyv1beta1.AnnotationRetries])
+	assert.Len(t, tt.Spec.Values, 1)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NeedsRollback checks to see if any of the trial's patch operations still need to be rolled back
+func NeedsRollback(t *redskyv1beta1.Trial) bool {
+	for i := range t.Status.PatchOperations {
+		if len(t.Status.PatchOperations[i].RollbackData) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RollbackData computes a merge patch which restores the patched object to its original state; only the content of
+// the object is considered, server maintained metadata and the status are ignored. Returns nil if the objects are
+// equivalent.
+func RollbackData(original, patched *unstructured.Unstructured) ([]byte, error) {
+	originalData, err := rollbackContent(original)
+	if err != nil {
+		return nil, err
+	}
+	patchedData, err := rollbackContent(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsonpatch.CreateMergePatch(patchedData, originalData)
+	if err != nil {
+		return nil, err
+	}
+	if string(data) == "{}" {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// rollbackContent returns the JSON content of the object that should be restored by a roll back
+func rollbackContent(u *unstructured.Unstructured) ([]byte, error) {
+	obj := u.DeepCopy()
+	for _, f := range []string{"resourceVersion", "generation", "managedFields", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	return json.Marshal(obj.Object)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trial
+
+import (
+	"testing"
+	"time"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRollbackData(t *testing.T) {
+	cases := []struct {
+		desc     string
+		original map[string]interface{}
+		patched  map[string]interface{}
+		expected string
+	}{
+		{
+			desc: "replicas",
+			original: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test", "resourceVersion": "1"},
+				"spec":     map[string]interface{}{"replicas": int64(1)},
+			},
+			patched: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test", "resourceVersion": "2"},
+				"spec":     map[string]interface{}{"replicas": int64(3)},
+			},
+			expected: `{"spec":{"replicas":1}}`,
+		},
+		{
+			desc: "added field",
+			original: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test"},
+				"data":     map[string]interface{}{"a": "1"},
+			},
+			patched: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test"},
+				"data":     map[string]interface{}{"a": "1", "b": "2"},
+			},
+			expected: `{"data":{"b":null}}`,
+		},
+		{
+			desc: "status only",
+			original: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test", "generation": int64(1)},
+				"status":   map[string]interface{}{"replicas": int64(1)},
+			},
+			patched: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test", "generation": int64(2)},
+				"status":   map[string]interface{}{"replicas": int64(3)},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			data, err := RollbackData(&unstructured.Unstructured{Object: c.original}, &unstructured.Unstructured{Object: c.patched})
+			if assert.NoError(t, err) {
+				if c.expected == "" {
+					assert.Nil(t, data)
+				} else {
+					assert.JSONEq(t, c.expected, string(data))
+				}
+			}
+		})
+	}
+}
+
+func TestNeedsCleanup_Rollback(t *testing.T) {
+	ttl := int32(0)
+	finished := metav1.NewTime(time.Now().Add(-1 * time.Minute))
+	cases := []struct {
+		desc         string
+		rollbackData []byte
+		expected     bool
+	}{
+		{
+			desc:     "rolled back",
+			expected: true,
+		},
+		{
+			desc:         "pending rollback",
+			rollbackData: []byte(`{"spec":{"replicas":1}}`),
+			expected:     false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			tt := &redskyv1beta1.Trial{
+				Spec: redskyv1beta1.TrialSpec{TTLSecondsAfterFinished: &ttl},
+				Status: redskyv1beta1.TrialStatus{
+					Conditions: []redskyv1beta1.TrialCondition{
+						{Type: redskyv1beta1.TrialComplete, Status: corev1.ConditionTrue, LastTransitionTime: finished},
+					},
+					PatchOperations: []redskyv1beta1.PatchOperation{
+						{AttemptsRemaining: 0, RollbackData: c.rollbackData},
+					},
+				},
+			}
+			assert.Equal(t, c.expected, NeedsCleanup(tt))
+		})
+	}
+}
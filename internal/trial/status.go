@@ -43,8 +43,12 @@ const (
 	capturing    = "Capturing"
 	completed    = "Completed"
 	failed       = "Failed"
+	infeasible   = "Infeasible"
 )
 
+// ReasonInfeasible is the reason used on the failed condition of a trial whose metric values violate the metric bounds
+const ReasonInfeasible = "Infeasible"
+
 var (
 	trialConditionTypeOrder = []redskyv1beta1.TrialConditionType{
 		redskyv1beta1.TrialSetupCreated,
@@ -52,6 +56,7 @@ var (
 		redskyv1beta1.TrialPatched,
 		redskyv1beta1.TrialReady,
 		redskyv1beta1.TrialObserved,
+		redskyv1beta1.TrialRolledBack,
 		redskyv1beta1.TrialComplete,
 		redskyv1beta1.TrialFailed,
 	}
@@ -165,6 +170,9 @@ func summarize(t *redskyv1beta1.Trial) string {
 		case redskyv1beta1.TrialFailed:
 			switch c.Status {
 			case corev1.ConditionTrue:
+				if c.Reason == ReasonInfeasible {
+					return infeasible
+				}
 				return failed
 			}
 		}
@@ -175,7 +183,7 @@ func summarize(t *redskyv1beta1.Trial) string {
 func assignments(t *redskyv1beta1.Trial) string {
 	assignments := make([]string, len(t.Spec.Assignments))
 	for i := range t.Spec.Assignments {
-		assignments[i] = fmt.Sprintf("%s=%d", t.Spec.Assignments[i].Name, t.Spec.Assignments[i].Value)
+		assignments[i] = fmt.Sprintf("%s=%s", t.Spec.Assignments[i].Name, t.Spec.Assignments[i].Value.String())
 	}
 	return strings.Join(assignments, ", ")
 }
@@ -112,6 +112,21 @@ func TestUpdateStatus_Summarize(t *testing.T) {
 			},
 			phase: failed,
 		},
+		{
+			desc: "Infeasible",
+			conditions: []redskyv1beta1.TrialCondition{
+				{
+					Type:   redskyv1beta1.TrialObserved,
+					Status: corev1.ConditionTrue,
+				},
+				{
+					Type:   redskyv1beta1.TrialFailed,
+					Status: corev1.ConditionTrue,
+					Reason: ReasonInfeasible,
+				},
+			},
+			phase: infeasible,
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.desc, func(t *testing.T) {
@@ -17,7 +17,7 @@ limitations under the License.
 package trial
 
 import (
-	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +38,22 @@ func IsFinished(t *redskyv1beta1.Trial) bool {
 	return false
 }
 
+// IsFailed checks to see if the specified trial has failed
+func IsFailed(t *redskyv1beta1.Trial) bool {
+	return CheckCondition(&t.Status, redskyv1beta1.TrialFailed, corev1.ConditionTrue)
+}
+
+// Value returns the observed value of the named metric from the supplied trial values
+func Value(values []redskyv1beta1.Value, name string) (float64, bool) {
+	for _, v := range values {
+		if v.Name == name {
+			fv, err := strconv.ParseFloat(v.Value, 64)
+			return fv, err == nil
+		}
+	}
+	return 0, false
+}
+
 // IsAbandoned checks to see if the specified trial is abandoned
 func IsAbandoned(t *redskyv1beta1.Trial) bool {
 	return !IsFinished(t) && !t.GetDeletionTimestamp().IsZero()
@@ -50,9 +66,10 @@ func IsActive(t *redskyv1beta1.Trial) bool {
 		return true
 	}
 
-	// Check if a setup delete task exists and has not yet completed (remember the TrialSetupDeleted status is optional!)
+	// Check if a setup delete task or a patch roll back exists and has not yet completed (remember the
+	// TrialSetupDeleted and TrialRolledBack statuses are optional!)
 	for _, c := range t.Status.Conditions {
-		if c.Type == redskyv1beta1.TrialSetupDeleted && c.Status != corev1.ConditionTrue {
+		if (c.Type == redskyv1beta1.TrialSetupDeleted || c.Type == redskyv1beta1.TrialRolledBack) && c.Status != corev1.ConditionTrue {
 			return true
 		}
 	}
@@ -91,11 +108,23 @@ func IsTrialJobReference(t *redskyv1beta1.Trial, ref *corev1.ObjectReference) bo
 	return true
 }
 
+// IsSharedTarget checks to see if the supplied reference points to an object which may be shared with other trials,
+// i.e. a cluster scoped object (with no namespace) or an object in a namespace other then the trial namespace
+func IsSharedTarget(t *redskyv1beta1.Trial, ref *corev1.ObjectReference) bool {
+	return ref.Namespace != t.Namespace
+}
+
+// IsSameTarget checks to see if the supplied references point to the same object
+func IsSameTarget(ref1, ref2 *corev1.ObjectReference) bool {
+	gk1, gk2 := ref1.GroupVersionKind().GroupKind(), ref2.GroupVersionKind().GroupKind()
+	return gk1 == gk2 && ref1.Namespace == ref2.Namespace && ref1.Name == ref2.Name
+}
+
 // AppendAssignmentEnv appends an environment variable for each trial assignment
 func AppendAssignmentEnv(t *redskyv1beta1.Trial, env []corev1.EnvVar) []corev1.EnvVar {
 	for _, a := range t.Spec.Assignments {
 		name := strings.ReplaceAll(strings.ToUpper(a.Name), ".", "_")
-		env = append(env, corev1.EnvVar{Name: name, Value: fmt.Sprintf("%d", a.Value)})
+		env = append(env, corev1.EnvVar{Name: name, Value: a.Value.String()})
 	}
 	return env
 }
@@ -107,6 +136,11 @@ func NeedsCleanup(t *redskyv1beta1.Trial) bool {
 		return false
 	}
 
+	// The trial holds the only record of the original state of the patched objects
+	if NeedsRollback(t) {
+		return false
+	}
+
 	// Try to determine effective finish time and TTL
 	finishTime := metav1.Time{}
 	ttlSeconds := t.Spec.TTLSecondsAfterFinished
@@ -137,7 +171,7 @@ func NeedsCleanup(t *redskyv1beta1.Trial) bool {
 // isFinishTimeCondition returns true if the condition is relevant to the "finish time"
 func isFinishTimeCondition(c *redskyv1beta1.TrialCondition) bool {
 	switch c.Type {
-	case redskyv1beta1.TrialComplete, redskyv1beta1.TrialFailed, redskyv1beta1.TrialSetupDeleted:
+	case redskyv1beta1.TrialComplete, redskyv1beta1.TrialFailed, redskyv1beta1.TrialSetupDeleted, redskyv1beta1.TrialRolledBack:
 		return c.Status == corev1.ConditionTrue
 	default:
 		return false
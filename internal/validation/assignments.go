@@ -16,7 +16,11 @@ limitations under the License.
 
 package validation
 
-import redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+import (
+	"strconv"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+)
 
 // AssignmentError is raised when trial assignments do not match the experiment parameter definitions
 type AssignmentError struct {
@@ -24,7 +28,7 @@ type AssignmentError struct {
 	Unassigned []string
 	// Parameter names for which there is no definition
 	Undefined []string
-	// Parameter names for which the assignment is out of bounds
+	// Parameter names for which the assignment is out of bounds (or not one of the allowed values)
 	OutOfBounds []string
 	// Parameter names for which multiple assignments exist
 	Duplicated []string
@@ -41,7 +45,7 @@ func CheckAssignments(t *redskyv1beta1.Trial, exp *redskyv1beta1.Experiment) err
 	err := &AssignmentError{}
 
 	// Index the assignments, checking for duplicates
-	assignments := make(map[string]int64, len(t.Spec.Assignments))
+	assignments := make(map[string]redskyv1beta1.Int64OrString, len(t.Spec.Assignments))
 	for _, a := range t.Spec.Assignments {
 		if _, ok := assignments[a.Name]; !ok {
 			assignments[a.Name] = a.Value
@@ -51,9 +55,10 @@ func CheckAssignments(t *redskyv1beta1.Trial, exp *redskyv1beta1.Experiment) err
 	}
 
 	// Verify against the parameter specifications
-	for _, p := range exp.Spec.Parameters {
+	for i := range exp.Spec.Parameters {
+		p := &exp.Spec.Parameters[i]
 		if a, ok := assignments[p.Name]; ok {
-			if a < p.Min || a > p.Max {
+			if !inBounds(p, a) {
 				err.OutOfBounds = append(err.OutOfBounds, p.Name)
 			}
 			delete(assignments, p.Name)
@@ -71,3 +76,38 @@ func CheckAssignments(t *redskyv1beta1.Trial, exp *redskyv1beta1.Experiment) err
 	}
 	return err
 }
+
+// inBounds checks to see if the assignment is in the domain of the parameter
+func inBounds(p *redskyv1beta1.Parameter, a redskyv1beta1.Int64OrString) bool {
+	switch p.GetType() {
+	case redskyv1beta1.ParameterTypeCategorical:
+		for _, v := range p.Values {
+			if a.String() == v {
+				return true
+			}
+		}
+		return false
+
+	case redskyv1beta1.ParameterTypeDouble:
+		v, err := strconv.ParseFloat(a.String(), 64)
+		if err != nil {
+			return false
+		}
+		min, err := strconv.ParseFloat(p.Min.String(), 64)
+		if err != nil {
+			return false
+		}
+		max, err := strconv.ParseFloat(p.Max.String(), 64)
+		if err != nil {
+			return false
+		}
+		return v >= min && v <= max
+
+	default:
+		v, err := strconv.ParseInt(a.String(), 10, 64)
+		if err != nil {
+			return false
+		}
+		return v >= p.Min.Int64Value() && v <= p.Max.Int64Value()
+	}
+}
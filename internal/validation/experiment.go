@@ -0,0 +1,389 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	// Register the built-in metric collectors
+	_ "github.com/redskyops/redskyops-controller/internal/metric"
+	"github.com/redskyops/redskyops-controller/internal/template"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// CheckExperiment reports any problems with the supplied experiment to the linter
+func CheckExperiment(lint Linter, experiment *redskyv1beta1.Experiment) {
+
+	if !checkTypeMeta(lint.For("metadata"), &experiment.TypeMeta) {
+		return
+	}
+
+	checkParameters(lint.For("spec", "parameters"), experiment.Spec.Parameters)
+	checkConstraints(lint.For("spec", "constraints"), experiment.Spec.Constraints, experiment.Spec.Parameters)
+
+	// Templates may include named templates defined anywhere in the experiment
+	te, err := template.NewForExperiment(experiment)
+	if err != nil {
+		lint.For("spec").Error().Failed("template", err)
+		te = template.New()
+	}
+
+	checkMetrics(lint.For("spec", "metrics"), te, experiment.Spec.Metrics)
+	checkPatches(lint.For("spec", "patches"), te, experiment.Spec.Patches)
+	checkPatchRollback(lint.For("spec"), experiment.Spec.PatchRollback)
+	checkTrialTemplate(lint.For("spec", "trialTemplate"), &experiment.Spec.TrialTemplate)
+	checkStoppingCriteria(lint.For("spec", "stoppingCriteria"), experiment.Spec.StoppingCriteria, experiment.Spec.Metrics)
+
+	// Results metrics can only be collected if the trial run job includes the results sidecar
+	for i := range experiment.Spec.Metrics {
+		if experiment.Spec.Metrics[i].Type == redskyv1beta1.MetricResults && experiment.Spec.TrialTemplate.Spec.Results == nil {
+			lint.For("spec", "trialTemplate", "spec").Error().Missing("results")
+			break
+		}
+	}
+
+	// TODO Some checks are higher level and need a combination of pieces: e.g. selector/template matching
+
+}
+
+func checkTypeMeta(lint Linter, typeMeta *metav1.TypeMeta) bool {
+	// TODO Should we have a "fatal" severity (i.e. -1) instead of trying to keep track of "ok"?
+	ok := true
+
+	if typeMeta.Kind != "Experiment" {
+		lint.For("metadata").Error().Invalid("kind", typeMeta.Kind, "Experiment")
+		ok = false
+	}
+
+	if typeMeta.APIVersion != redskyv1beta1.GroupVersion.String() {
+		lint.For("metadata").Error().Invalid("apiVersion", typeMeta.APIVersion, redskyv1beta1.GroupVersion.String())
+		ok = false
+	}
+
+	return ok
+}
+
+func checkParameters(lint Linter, parameters []redskyv1beta1.Parameter) {
+
+	if len(parameters) == 0 {
+		lint.Error().Missing("parameters")
+	}
+
+	var baselines int
+	for i := range parameters {
+		checkParameter(lint.For(i), &parameters[i])
+		if parameters[i].Baseline != nil {
+			baselines++
+		}
+	}
+
+	// A baseline must be specified for all of the parameters or none of them
+	if baselines > 0 && baselines < len(parameters) {
+		lint.Error().Missing("baseline for every parameter")
+	} else if baselines > 0 {
+		exp := &redskyv1beta1.Experiment{Spec: redskyv1beta1.ExperimentSpec{Parameters: parameters}}
+		t := &redskyv1beta1.Trial{Spec: redskyv1beta1.TrialSpec{Assignments: exp.BaselineAssignments()}}
+		if err, ok := CheckAssignments(t, exp).(*AssignmentError); ok {
+			for _, name := range err.OutOfBounds {
+				lint.Error().Failed("baseline", fmt.Errorf("value for parameter %s is out of bounds", name))
+			}
+		}
+	}
+
+}
+
+func checkParameter(lint Linter, parameter *redskyv1beta1.Parameter) {
+
+	switch parameter.GetType() {
+	case redskyv1beta1.ParameterTypeInteger:
+		if parameter.Min.Type == intstr.String || parameter.Max.Type == intstr.String {
+			lint.Error().Invalid("bounds", "string", "integer")
+		} else if parameter.Min.Int64Value() > parameter.Max.Int64Value() {
+			lint.Error().Failed("min", fmt.Errorf("%d is greater than max %d", parameter.Min.Int64Value(), parameter.Max.Int64Value()))
+		}
+	case redskyv1beta1.ParameterTypeDouble:
+		min, minErr := strconv.ParseFloat(parameter.Min.String(), 64)
+		if minErr != nil {
+			lint.Error().Failed("min", minErr)
+		}
+		max, maxErr := strconv.ParseFloat(parameter.Max.String(), 64)
+		if maxErr != nil {
+			lint.Error().Failed("max", maxErr)
+		}
+		if minErr == nil && maxErr == nil && min > max {
+			lint.Error().Failed("min", fmt.Errorf("%s is greater than max %s", parameter.Min.String(), parameter.Max.String()))
+		}
+	case redskyv1beta1.ParameterTypeCategorical:
+		if len(parameter.Values) == 0 {
+			lint.Error().Missing("values")
+		}
+	default:
+		lint.Error().Invalid("type", parameter.Type, redskyv1beta1.ParameterTypeInteger, redskyv1beta1.ParameterTypeDouble, redskyv1beta1.ParameterTypeCategorical)
+	}
+
+}
+
+func checkConstraints(lint Linter, constraints []redskyv1beta1.Constraint, parameters []redskyv1beta1.Parameter) {
+
+	names := make(map[string]bool, len(parameters))
+	for i := range parameters {
+		names[parameters[i].Name] = true
+	}
+
+	for i := range constraints {
+		checkConstraint(lint.For(i), &constraints[i], names)
+	}
+
+}
+
+func checkConstraint(lint Linter, constraint *redskyv1beta1.Constraint, names map[string]bool) {
+
+	if constraint.Order == nil && constraint.Sum == nil {
+		lint.Error().Missing("order or sum")
+	}
+
+	if constraint.Order != nil {
+		if !names[constraint.Order.LowerParameter] {
+			lint.For("order").Error().Failed("lowerParameter", fmt.Errorf("unknown parameter '%s'", constraint.Order.LowerParameter))
+		}
+		if !names[constraint.Order.UpperParameter] {
+			lint.For("order").Error().Failed("upperParameter", fmt.Errorf("unknown parameter '%s'", constraint.Order.UpperParameter))
+		}
+	}
+
+	if constraint.Sum != nil {
+		for i, p := range constraint.Sum.Parameters {
+			if !names[p.Name] {
+				lint.For("sum", "parameters", i).Error().Failed("name", fmt.Errorf("unknown parameter '%s'", p.Name))
+			}
+		}
+	}
+
+}
+
+func checkMetrics(lint Linter, te *template.Engine, metrics []redskyv1beta1.Metric) {
+
+	if len(metrics) == 0 {
+		lint.Error().Missing("metrics")
+	}
+
+	var optimized bool
+	for i := range metrics {
+		checkMetric(lint.For(i), te, &metrics[i])
+		optimized = optimized || metrics[i].IsOptimized()
+	}
+
+	if len(metrics) > 0 && !optimized {
+		lint.Error().Missing("optimized metric")
+	}
+
+}
+
+func checkMetric(lint Linter, te *template.Engine, metric *redskyv1beta1.Metric) {
+
+	if metric.Query == "" {
+		lint.Error().Missing("query")
+	}
+
+	if _, err := collector.ForMetric(metric); err != nil {
+		lint.Error().Failed("type", err)
+	}
+
+	if metric.Type == redskyv1beta1.MetricPrometheus && metric.Selector == nil {
+		lint.Error().Missing("selector for Prometheus metric")
+	}
+
+	if metric.Type == redskyv1beta1.MetricJSONPath || metric.Type == redskyv1beta1.MetricResults {
+		// TODO We need to render the template first
+		if !strings.Contains(metric.Query, "{") {
+			lint.Error().Invalid("query", metric.Query)
+		}
+	}
+
+	if metric.Type == redskyv1beta1.MetricLogs && !(strings.HasPrefix(metric.Query, "{") && strings.HasSuffix(metric.Query, "}")) {
+		if _, err := regexp.Compile(metric.Query); err != nil {
+			lint.Error().Failed("query", err)
+		}
+	}
+
+	if metric.Container != "" && metric.Type != redskyv1beta1.MetricLogs {
+		lint.Warning().Invalid("type", metric.Type, redskyv1beta1.MetricLogs)
+	}
+
+	if metric.Min != nil && metric.Max != nil && metric.Min.Cmp(*metric.Max) > 0 {
+		lint.Error().Failed("min", fmt.Errorf("%s is greater than max %s", metric.Min.String(), metric.Max.String()))
+	}
+
+	switch metric.TargetType {
+	case "", redskyv1beta1.MetricTargetService, redskyv1beta1.MetricTargetPods:
+	default:
+		lint.Error().Invalid("targetType", metric.TargetType, redskyv1beta1.MetricTargetService, redskyv1beta1.MetricTargetPods)
+	}
+
+	switch metric.Aggregation {
+	case "", redskyv1beta1.MetricAggregationSum, redskyv1beta1.MetricAggregationAvg, redskyv1beta1.MetricAggregationMax:
+	default:
+		lint.Error().Invalid("aggregation", metric.Aggregation, redskyv1beta1.MetricAggregationSum, redskyv1beta1.MetricAggregationAvg, redskyv1beta1.MetricAggregationMax)
+	}
+
+	if metric.Step != nil || metric.Reducer != "" {
+		if metric.Type != redskyv1beta1.MetricPrometheus {
+			lint.Warning().Invalid("type", metric.Type, redskyv1beta1.MetricPrometheus)
+		}
+
+		switch metric.Reducer {
+		case "", redskyv1beta1.MetricReducerMean, redskyv1beta1.MetricReducerMax, redskyv1beta1.MetricReducerMin,
+			redskyv1beta1.MetricReducerP50, redskyv1beta1.MetricReducerP95, redskyv1beta1.MetricReducerP99, redskyv1beta1.MetricReducerLast:
+		default:
+			lint.Error().Invalid("reducer", metric.Reducer, redskyv1beta1.MetricReducerMean, redskyv1beta1.MetricReducerMax, redskyv1beta1.MetricReducerMin,
+				redskyv1beta1.MetricReducerP50, redskyv1beta1.MetricReducerP95, redskyv1beta1.MetricReducerP99, redskyv1beta1.MetricReducerLast)
+		}
+	}
+
+	if metric.Scheme != "" && strings.ToLower(metric.Scheme) == "http" && strings.ToLower(metric.Scheme) != "https" {
+		lint.Error().Invalid("scheme", metric.Scheme, "http", "https")
+	}
+
+	if _, _, err := te.RenderMetricQueries(metric, &redskyv1beta1.Trial{}, nil); err != nil {
+		if template.IsTrialStateError(err) {
+			// Lookups and the trial run job are not available until the metric is collected for a trial
+			lint.Warning().Failed("query", err)
+		} else {
+			lint.Error().Failed("query", err)
+		}
+	}
+
+}
+
+func checkStoppingCriteria(lint Linter, sc *redskyv1beta1.StoppingCriteria, metrics []redskyv1beta1.Metric) {
+
+	if sc == nil {
+		return
+	}
+
+	if sc.MaxTrials != nil && *sc.MaxTrials < 1 {
+		lint.Error().Failed("maxTrials", fmt.Errorf("%d is not positive", *sc.MaxTrials))
+	}
+
+	if sc.MaxDuration != nil && sc.MaxDuration.Duration <= 0 {
+		lint.Error().Failed("maxDuration", fmt.Errorf("%s is not positive", sc.MaxDuration.Duration))
+	}
+
+	if sc.MaxConsecutiveFailures != nil && *sc.MaxConsecutiveFailures < 1 {
+		lint.Error().Failed("maxConsecutiveFailures", fmt.Errorf("%d is not positive", *sc.MaxConsecutiveFailures))
+	}
+
+	if sc.NoImprovementTrials != nil && *sc.NoImprovementTrials < 1 {
+		lint.Error().Failed("noImprovementTrials", fmt.Errorf("%d is not positive", *sc.NoImprovementTrials))
+	}
+
+	if sc.NoImprovementTrials != nil {
+		var optimized int
+		for i := range metrics {
+			if metrics[i].IsOptimized() {
+				optimized++
+			}
+		}
+		if optimized != 1 {
+			// There is no best trial to improve upon
+			lint.Warning().Failed("noImprovementTrials", fmt.Errorf("requires a single optimized metric, found %d", optimized))
+		}
+	}
+
+}
+
+func checkPatches(lint Linter, te *template.Engine, patches []redskyv1beta1.PatchTemplate) {
+
+	if len(patches) == 0 {
+		lint.Error().Missing("patches")
+	}
+
+	for i := range patches {
+		checkPatch(lint.For(i), te, &patches[i])
+	}
+
+}
+
+func checkPatch(lint Linter, te *template.Engine, patch *redskyv1beta1.PatchTemplate) {
+
+	if patch.TargetRef != nil {
+		if patch.TargetRef.APIVersion == "" {
+			// TODO Is is OK to skip this for the core kinds or should we still require "v1"?
+			if !isCoreKind(patch.TargetRef.Kind) {
+				lint.Error().Missing("API version")
+			}
+		}
+
+		if patch.TargetRef.Kind == "" {
+			lint.Error().Missing("kind")
+		}
+	}
+
+	if patch.Selector != nil {
+		if patch.TargetRef == nil {
+			lint.Error().Missing("targetRef")
+		} else if patch.TargetRef.Name != "" {
+			lint.Error().Failed("targetRef", fmt.Errorf("name is mutually exclusive with selector"))
+		}
+
+		if _, err := metav1.LabelSelectorAsSelector(patch.Selector); err != nil {
+			lint.Error().Failed("selector", err)
+		}
+	}
+
+	if _, err := te.RenderPatch(patch, &redskyv1beta1.Trial{}, nil); err != nil {
+		if template.IsTrialStateError(err) {
+			// The content of the target is not known until the patch is evaluated for a trial
+			lint.Warning().Failed("patch", err)
+		} else {
+			lint.Error().Failed("patch", err)
+		}
+	}
+
+}
+
+func checkPatchRollback(lint Linter, policy redskyv1beta1.PatchRollbackPolicy) {
+
+	switch policy {
+	case "", redskyv1beta1.PatchRollbackNever, redskyv1beta1.PatchRollbackAfterTrial, redskyv1beta1.PatchRollbackOnDelete:
+	default:
+		lint.Error().Invalid("patchRollback", policy, redskyv1beta1.PatchRollbackNever, redskyv1beta1.PatchRollbackAfterTrial, redskyv1beta1.PatchRollbackOnDelete)
+	}
+
+}
+
+func checkTrialTemplate(lint Linter, template *redskyv1beta1.TrialTemplateSpec) {
+	checkTrial(lint.For("spec"), &template.Spec)
+}
+
+// Check if a kind is one of the known core types
+func isCoreKind(kind string) bool {
+	for coreKind := range scheme.Scheme.KnownTypes(schema.GroupVersion{Version: "v1"}) {
+		if coreKind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package check
+package validation
 
 import (
 	"fmt"
@@ -46,6 +46,13 @@ type Lint interface {
 	Failed(thing string, err error)
 }
 
+const (
+	// SeverityError is the severity of lint which will cause problems
+	SeverityError = 0
+	// SeverityWarning is the severity of lint which might cause problems
+	SeverityWarning = 1
+)
+
 // LintError is an indication that something is wrong
 type LintError struct {
 	// Path is the dotted notation path leading to where the lint was encountered
@@ -108,8 +115,8 @@ func (l *lc) Failed(thing string, err error) {
 func (l *lc) For(elem ...interface{}) Linter { ll := &lc{a: l.a, p: l.p}; return ll.pp(elem...) }
 func (l *lc) WithDescription(d string) Lint  { l.d = d; return l }
 func (l *lc) Severity(s int) Lint            { return &lc{p: l.p, a: l.a, s: s} }
-func (l *lc) Error() Lint                    { return l.Severity(0) }
-func (l *lc) Warning() Lint                  { return l.Severity(1) }
+func (l *lc) Error() Lint                    { return l.Severity(SeverityError) }
+func (l *lc) Warning() Lint                  { return l.Severity(SeverityWarning) }
 
 // aa (accumulate) uses the supplied message format and arguments to construct a LintError using the current state of this context
 func (l *lc) aa(msg string, a ...interface{}) {
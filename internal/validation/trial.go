@@ -0,0 +1,159 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"math/big"
+	"path"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/batch/v1beta1"
+)
+
+// CheckTrial reports any problems with the supplied trial to the linter
+func CheckTrial(lint Linter, trial *redskyv1beta1.Trial) {
+	checkTrial(lint.For("spec"), &trial.Spec)
+}
+
+func checkTrial(lint Linter, trial *redskyv1beta1.TrialSpec) {
+
+	if trial.JobTemplate != nil {
+		checkJobTemplate(lint.For("jobTemplate"), trial.JobTemplate)
+	}
+
+	for i := range trial.ReadinessGates {
+		checkReadinessGate(lint.For("readinessGates", i), &trial.ReadinessGates[i])
+	}
+
+	if trial.RetryPolicy != nil {
+		checkRetryPolicy(lint.For("retryPolicy"), trial.RetryPolicy)
+	}
+
+	if trial.LogRetention != nil {
+		checkLogRetention(lint.For("logRetention"), trial.LogRetention)
+	}
+
+	if trial.Results != nil && trial.Results.Path != "" {
+		if !path.IsAbs(trial.Results.Path) || path.Dir(trial.Results.Path) == "/" {
+			lint.For("results").Error().Invalid("path", trial.Results.Path)
+		}
+	}
+
+	for i := range trial.SetupTasks {
+		checkSetupTask(lint.For("setupTasks", i), &trial.SetupTasks[i])
+	}
+
+	switch trial.SetupLifecycle {
+	case "", redskyv1beta1.SetupLifecycleTrial, redskyv1beta1.SetupLifecycleNamespace:
+	default:
+		lint.Error().Invalid("setupLifecycle", trial.SetupLifecycle, redskyv1beta1.SetupLifecycleTrial, redskyv1beta1.SetupLifecycleNamespace)
+	}
+
+}
+
+func checkSetupTask(lint Linter, task *redskyv1beta1.SetupTask) {
+
+	if len(task.Command) > 0 || len(task.Args) > 0 || len(task.DeleteArgs) > 0 {
+		if task.Image == "" && len(task.Command) > 0 {
+			lint.Error().Missing("image")
+		}
+
+		if task.HelmChart != "" || len(task.Manifests) > 0 || task.Kustomize != "" {
+			lint.Error().Failed("command", fmt.Errorf("command, args and deleteArgs cannot be combined with helmChart, manifests or kustomize"))
+		}
+	}
+
+	for i, m := range task.Manifests {
+		if (m.ConfigMap == nil) == (m.URL == "") {
+			lint.For("manifests", i).Error().Failed("source", fmt.Errorf("exactly one of configMap or url is required"))
+		}
+	}
+
+	for i := range task.HelmValues {
+		if task.HelmValues[i].ValueFrom != nil {
+			checkHelmValueSource(lint.For("helmValues", i, "valueFrom"), task.HelmValues[i].ValueFrom)
+		}
+	}
+
+	for i, hvf := range task.HelmValuesFrom {
+		if (hvf.ConfigMap == nil) == (hvf.Secret == nil) {
+			lint.For("helmValuesFrom", i).Error().Failed("source", fmt.Errorf("exactly one of configMap or secret is required"))
+		}
+	}
+
+}
+
+func checkHelmValueSource(lint Linter, src *redskyv1beta1.HelmValueSource) {
+
+	count := 0
+	for _, s := range []bool{src.ParameterRef != nil, src.FieldRef != nil, src.SecretKeyRef != nil} {
+		if s {
+			count++
+		}
+	}
+	if count != 1 {
+		lint.Error().Failed("source", fmt.Errorf("exactly one of parameterRef, fieldRef or secretKeyRef is required"))
+	}
+
+	if src.ParameterRef != nil && src.ParameterRef.Scale != "" {
+		if _, ok := new(big.Rat).SetString(src.ParameterRef.Scale); !ok {
+			lint.For("parameterRef").Error().Invalid("scale", src.ParameterRef.Scale)
+		}
+	}
+
+}
+
+func checkRetryPolicy(lint Linter, policy *redskyv1beta1.RetryPolicy) {
+
+	if policy.Limit != nil && *policy.Limit < 0 {
+		lint.Error().Failed("limit", fmt.Errorf("%d is negative", *policy.Limit))
+	}
+
+}
+
+func checkLogRetention(lint Linter, retention *redskyv1beta1.LogRetention) {
+
+	if retention.TailLines < 0 {
+		lint.Error().Failed("tailLines", fmt.Errorf("%d is negative", retention.TailLines))
+	}
+
+}
+
+func checkReadinessGate(lint Linter, gate *redskyv1beta1.TrialReadinessGate) {
+
+	if gate.Kind == "" {
+		lint.Error().Missing("kind")
+	}
+
+	if gate.Name != "" && gate.Selector != nil {
+		lint.Error().Failed("selector", fmt.Errorf("name and selector are mutually exclusive"))
+	}
+
+}
+
+func checkJobTemplate(lint Linter, template *v1beta1.JobTemplateSpec) {
+	checkJob(lint.For("spec"), &template.Spec)
+}
+
+func checkJob(lint Linter, job *batchv1.JobSpec) {
+	if job.BackoffLimit != nil && *job.BackoffLimit != 0 {
+		// TODO Instead of "Invalid" can we have "Suggested"?
+		lint.Warning().Invalid("backoffLimit", *job.BackoffLimit, 0)
+	}
+}
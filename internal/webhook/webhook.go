@@ -0,0 +1,188 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/validation"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/mutate-redskyops-dev-v1beta1-experiment,mutating=true,failurePolicy=fail,groups=redskyops.dev,resources=experiments,verbs=create;update,versions=v1beta1,name=mexperiment.redskyops.dev
+// +kubebuilder:webhook:path=/validate-redskyops-dev-v1beta1-experiment,mutating=false,failurePolicy=fail,groups=redskyops.dev,resources=experiments,verbs=create;update,versions=v1beta1,name=vexperiment.redskyops.dev
+// +kubebuilder:webhook:path=/mutate-redskyops-dev-v1beta1-trial,mutating=true,failurePolicy=fail,groups=redskyops.dev,resources=trials,verbs=create;update,versions=v1beta1,name=mtrial.redskyops.dev
+// +kubebuilder:webhook:path=/validate-redskyops-dev-v1beta1-trial,mutating=false,failurePolicy=fail,groups=redskyops.dev,resources=trials,verbs=create;update,versions=v1beta1,name=vtrial.redskyops.dev
+
+// ExperimentValidator rejects experiments which do not pass the lint rules
+type ExperimentValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &ExperimentValidator{}
+
+// SetupWithManager registers the defaulting and validating experiment webhooks
+func (v *ExperimentValidator) SetupWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register("/mutate-redskyops-dev-v1beta1-experiment", admission.DefaultingWebhookFor(&redskyv1beta1.Experiment{}))
+	mgr.GetWebhookServer().Register("/validate-redskyops-dev-v1beta1-experiment", &webhook.Admission{Handler: v})
+	return nil
+}
+
+// InjectDecoder injects the decoder used to read the experiments
+func (v *ExperimentValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle validates the experiment in the admission request
+func (v *ExperimentValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	exp := &redskyv1beta1.Experiment{}
+	if err := v.decoder.Decode(req, exp); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Do not block updates which leave the specification alone (e.g. status or finalizer changes)
+	if req.Operation == admissionv1beta1.Update {
+		old := &redskyv1beta1.Experiment{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(&old.Spec, &exp.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	linter := &validation.AllTheLint{}
+	validation.CheckExperiment(linter.For(), exp)
+	return lintResponse(linter)
+}
+
+// TrialValidator rejects trials which do not pass the lint rules or have assignments which are not valid for the
+// experiment they belong to
+type TrialValidator struct {
+	client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &TrialValidator{}
+
+// SetupWithManager registers the defaulting and validating trial webhooks
+func (v *TrialValidator) SetupWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register("/mutate-redskyops-dev-v1beta1-trial", admission.DefaultingWebhookFor(&redskyv1beta1.Trial{}))
+	mgr.GetWebhookServer().Register("/validate-redskyops-dev-v1beta1-trial", &webhook.Admission{Handler: v})
+	return nil
+}
+
+// InjectDecoder injects the decoder used to read the trials
+func (v *TrialValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle validates the trial in the admission request
+func (v *TrialValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	t := &redskyv1beta1.Trial{}
+	if err := v.decoder.Decode(req, t); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	switch req.Operation {
+	case admissionv1beta1.Create:
+		// Assignments are only checked when the trial is created, the experiment may change afterwards
+		exp := &redskyv1beta1.Experiment{}
+		if err := v.Get(ctx, t.ExperimentNamespacedName(), exp); err != nil && !errors.IsNotFound(err) {
+			return admission.Errored(http.StatusInternalServerError, err)
+		} else if err == nil {
+			if err := checkAssignments(t, exp); err != nil {
+				return admission.Denied(err.Error())
+			}
+		}
+	case admissionv1beta1.Update:
+		// Do not block updates which leave the specification alone (e.g. status or finalizer changes)
+		old := &redskyv1beta1.Trial{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(&old.Spec, &t.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	linter := &validation.AllTheLint{}
+	validation.CheckTrial(linter.For(), t)
+	return lintResponse(linter)
+}
+
+// checkAssignments validates the trial assignments, parameters with a single value may be left unassigned because they
+// are omitted from the server suggestions
+func checkAssignments(t *redskyv1beta1.Trial, exp *redskyv1beta1.Experiment) error {
+	err, ok := validation.CheckAssignments(t, exp).(*validation.AssignmentError)
+	if !ok {
+		return nil
+	}
+
+	unassigned := err.Unassigned[:0]
+	for _, name := range err.Unassigned {
+		for i := range exp.Spec.Parameters {
+			if exp.Spec.Parameters[i].Name == name && !isFixed(&exp.Spec.Parameters[i]) {
+				unassigned = append(unassigned, name)
+			}
+		}
+	}
+	err.Unassigned = unassigned
+
+	if len(err.Unassigned) == 0 && len(err.Undefined) == 0 && len(err.OutOfBounds) == 0 && len(err.Duplicated) == 0 {
+		return nil
+	}
+	return err
+}
+
+// isFixed checks if the parameter only has a single possible value
+func isFixed(p *redskyv1beta1.Parameter) bool {
+	switch p.GetType() {
+	case redskyv1beta1.ParameterTypeCategorical:
+		return len(p.Values) == 1
+	case redskyv1beta1.ParameterTypeDouble:
+		return p.Min.String() == p.Max.String()
+	default:
+		return p.Min.Int64Value() == p.Max.Int64Value()
+	}
+}
+
+// lintResponse denies the request if the linter found any errors, warnings are ignored
+func lintResponse(linter *validation.AllTheLint) admission.Response {
+	var msgs []string
+	for _, p := range linter.Problems {
+		if p.Severity == validation.SeverityError {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", p.Path, p.Message))
+		}
+	}
+	if len(msgs) > 0 {
+		return admission.Denied(strings.Join(msgs, "; "))
+	}
+	return admission.Allowed("")
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/optimizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestExperimentValidator(t *testing.T) {
+	valid := func() *redskyv1beta1.Experiment {
+		return &redskyv1beta1.Experiment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: redskyv1beta1.GroupVersion.String(), Kind: "Experiment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: redskyv1beta1.ExperimentSpec{
+				Parameters: []redskyv1beta1.Parameter{
+					{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(5)},
+					{Name: "two", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(5)},
+				},
+				Metrics: []redskyv1beta1.Metric{{Name: "cost", Query: "{{duration .StartTime .CompletionTime}}"}},
+				Patches: []redskyv1beta1.PatchTemplate{{TargetRef: &corev1.ObjectReference{Kind: "ConfigMap", Name: "test"}, Patch: "{}"}},
+			},
+		}
+	}
+
+	cases := []struct {
+		desc    string
+		exp     func(*redskyv1beta1.Experiment)
+		allowed bool
+	}{
+		{
+			desc:    "valid",
+			exp:     func(*redskyv1beta1.Experiment) {},
+			allowed: true,
+		},
+		{
+			desc: "min greater than max",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Parameters[0].Min = redskyv1beta1.FromInt64(10)
+			},
+		},
+		{
+			desc: "unknown metric type",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Metrics[0].Type = "foobar"
+			},
+		},
+		{
+			desc: "bad metric template",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Metrics[0].Query = "{{ .Foo"
+			},
+		},
+		{
+			desc: "metric template using trial job",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Metrics[0].Query = "{{ len .JobPods.Items }}"
+			},
+			allowed: true,
+		},
+		{
+			desc: "failing metric template",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Metrics[0].Query = `{{ fail "nope" }}`
+			},
+		},
+		{
+			desc: "unknown constraint parameter",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Constraints = []redskyv1beta1.Constraint{
+					{Order: &redskyv1beta1.OrderConstraint{LowerParameter: "one", UpperParameter: "three"}},
+				}
+			},
+		},
+		{
+			desc: "unknown patch rollback policy",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.PatchRollback = "sometimes"
+			},
+		},
+		{
+			desc: "patch selector",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Patches[0].TargetRef.Name = ""
+				exp.Spec.Patches[0].Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+				exp.Spec.Patches[0].Patch = `{"data":{"app":"{{ .Target.metadata.labels.app }}"}}`
+			},
+			allowed: true,
+		},
+		{
+			desc: "retry policy",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.TrialTemplate.Spec.RetryPolicy = &redskyv1beta1.RetryPolicy{}
+			},
+			allowed: true,
+		},
+		{
+			desc: "retry policy with local optimizer",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Optimization = []redskyv1beta1.Optimization{{Name: optimizer.OptimizationAlgorithm, Value: optimizer.AlgorithmRandom}}
+				exp.Spec.TrialTemplate.Spec.RetryPolicy = &redskyv1beta1.RetryPolicy{}
+			},
+			allowed: true,
+		},
+		{
+			desc: "patch selector with name",
+			exp: func(exp *redskyv1beta1.Experiment) {
+				exp.Spec.Patches[0].Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			exp := valid()
+			c.exp(exp)
+
+			v := &ExperimentValidator{}
+			require.NoError(t, v.InjectDecoder(newDecoder(t)))
+			resp := v.Handle(context.TODO(), newRequest(t, admissionv1beta1.Create, exp, nil))
+			assert.Equal(t, c.allowed, resp.Allowed, "%v", resp.Result)
+		})
+	}
+}
+
+func TestExperimentValidator_Update(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	old := exp.DeepCopy()
+	exp.Status.Phase = "Running"
+
+	v := &ExperimentValidator{}
+	require.NoError(t, v.InjectDecoder(newDecoder(t)))
+
+	// An invalid experiment can still have it's status updated
+	resp := v.Handle(context.TODO(), newRequest(t, admissionv1beta1.Update, exp, old))
+	assert.True(t, resp.Allowed)
+
+	// But not it's specification
+	exp.SetReplicas(2)
+	resp = v.Handle(context.TODO(), newRequest(t, admissionv1beta1.Update, exp, old))
+	assert.False(t, resp.Allowed)
+}
+
+func TestTrialValidator(t *testing.T) {
+	exp := &redskyv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: redskyv1beta1.ExperimentSpec{
+			Parameters: []redskyv1beta1.Parameter{
+				{Name: "one", Min: redskyv1beta1.FromInt64(1), Max: redskyv1beta1.FromInt64(5)},
+				{Name: "two", Min: redskyv1beta1.FromInt64(3), Max: redskyv1beta1.FromInt64(3)},
+				{Name: "three", Values: []string{"red"}},
+			},
+		},
+	}
+
+	negative := int32(-1)
+
+	cases := []struct {
+		desc    string
+		trial   redskyv1beta1.TrialSpec
+		allowed bool
+	}{
+		{
+			desc: "valid",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{{Name: "one", Value: redskyv1beta1.FromInt64(2)}},
+			},
+			allowed: true,
+		},
+		{
+			desc: "out of bounds",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{{Name: "one", Value: redskyv1beta1.FromInt64(20)}},
+			},
+		},
+		{
+			desc: "unassigned",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{{Name: "two", Value: redskyv1beta1.FromInt64(3)}},
+			},
+		},
+		{
+			desc: "fixed values assigned",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{
+					{Name: "one", Value: redskyv1beta1.FromInt64(2)},
+					{Name: "two", Value: redskyv1beta1.FromInt64(3)},
+					{Name: "three", Value: redskyv1beta1.FromString("red")},
+				},
+			},
+			allowed: true,
+		},
+		{
+			desc: "fixed value out of bounds",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{
+					{Name: "one", Value: redskyv1beta1.FromInt64(2)},
+					{Name: "two", Value: redskyv1beta1.FromInt64(4)},
+				},
+			},
+		},
+		{
+			desc: "readiness gate name and selector",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{{Name: "one", Value: redskyv1beta1.FromInt64(2)}},
+				ReadinessGates: []redskyv1beta1.TrialReadinessGate{
+					{Kind: "Deployment", Name: "test", Selector: &metav1.LabelSelector{}},
+				},
+			},
+		},
+		{
+			desc: "negative retry limit",
+			trial: redskyv1beta1.TrialSpec{
+				Assignments: []redskyv1beta1.Assignment{{Name: "one", Value: redskyv1beta1.FromInt64(2)}},
+				RetryPolicy: &redskyv1beta1.RetryPolicy{Limit: &negative},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = redskyv1beta1.AddToScheme(scheme)
+
+			tt := &redskyv1beta1.Trial{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-001", Namespace: "default", Labels: map[string]string{redskyv1beta1.LabelExperiment: "test"}},
+				Spec:       c.trial,
+			}
+
+			v := &TrialValidator{Client: fake.NewFakeClientWithScheme(scheme, exp)}
+			require.NoError(t, v.InjectDecoder(newDecoder(t)))
+			resp := v.Handle(context.TODO(), newRequest(t, admissionv1beta1.Create, tt, nil))
+			assert.Equal(t, c.allowed, resp.Allowed, "%v", resp.Result)
+		})
+	}
+}
+
+func newDecoder(t *testing.T) *admission.Decoder {
+	scheme := runtime.NewScheme()
+	_ = redskyv1beta1.AddToScheme(scheme)
+	d, err := admission.NewDecoder(scheme)
+	require.NoError(t, err)
+	return d
+}
+
+func newRequest(t *testing.T, op admissionv1beta1.Operation, obj, old runtime.Object) admission.Request {
+	req := admission.Request{}
+	req.Operation = op
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	req.Object.Raw = data
+	if old != nil {
+		data, err := json.Marshal(old)
+		require.NoError(t, err)
+		req.OldObject.Raw = data
+	}
+	return req
+}
@@ -27,12 +27,18 @@ import (
 	"github.com/redskyops/redskyops-controller/controllers"
 	"github.com/redskyops/redskyops-controller/internal/config"
 	"github.com/redskyops/redskyops-controller/internal/controller"
+	"github.com/redskyops/redskyops-controller/internal/metric"
 	"github.com/redskyops/redskyops-controller/internal/version"
+	"github.com/redskyops/redskyops-controller/internal/webhook"
+	collector "github.com/redskyops/redskyops-controller/pkg/metric"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 )
 
 var (
@@ -57,6 +63,8 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.Var(&controller.ExperimentLabels, "metrics-experiment-label",
+		"The value used for the experiment label of metrics: \"name\", \"namespaced-name\" or \"none\" to disable metrics labeled by experiment.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(func(o *zap.Options) {
@@ -66,7 +74,8 @@ func main() {
 	v := version.GetInfo()
 	setupLog.Info("Red Sky Ops Controller", "version", v.String(), "gitCommit", v.GitCommit)
 
-	mgr, err := ctrl.NewManager(controller.WithConversion(ctrl.GetConfigOrDie(), scheme), ctrl.Options{
+	cfg := ctrl.GetConfigOrDie()
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:             scheme,
 		MetricsBindAddress: metricsAddr,
 		LeaderElection:     enableLeaderElection,
@@ -76,59 +85,111 @@ func main() {
 		os.Exit(1)
 	}
 
+	migration := &controller.StorageMigration{
+		Log: ctrl.Log.WithName("migration"),
+	}
+	if migration.Client, err = client.New(cfg, client.Options{Scheme: scheme}); err != nil {
+		setupLog.Error(err, "unable to create client", "client", "migration")
+		os.Exit(1)
+	}
+
+	// Logs are not available through the controller runtime client, the collectors that read them need their own client
+	pods, err := corev1client.NewForConfig(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to create client", "client", "pods")
+		os.Exit(1)
+	}
+	collector.Register(redskyv1beta1.MetricLogs, metric.NewLogsCollector(pods))
+	collector.Register(redskyv1beta1.MetricResults, metric.NewResultsCollector(pods))
+
+	// Controllers are not started until the migration completes so they never observe old representations
+	gated := migration.Gate(mgr)
 	if err = (&controllers.ExperimentReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Experiment"),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Experiment"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("experiment-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Experiment")
 		os.Exit(1)
 	}
 	if err = (&controllers.ServerReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Server"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Server"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("server-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Server")
 		os.Exit(1)
 	}
+	if err = (&controllers.OptimizerReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Optimizer"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("optimizer-controller"),
+	}).SetupWithManager(gated); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Optimizer")
+		os.Exit(1)
+	}
 	if err = (&controllers.SetupReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Setup"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Setup"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("setup-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Setup")
 		os.Exit(1)
 	}
 	if err = (&controllers.PatchReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Patch"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Patch"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("patch-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Patch")
 		os.Exit(1)
 	}
 	if err = (&controllers.ReadyReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Ready"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Ready"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("ready-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Ready")
 		os.Exit(1)
 	}
 	if err = (&controllers.TrialJobReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Trial"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Trial"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("trial-job-controller"),
+	}).SetupWithManager(gated); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Trial")
 		os.Exit(1)
 	}
 	if err = (&controllers.MetricReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Metric"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("metric-controller"),
+	}).SetupWithManager(gated); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Metric")
+		os.Exit(1)
+	}
+	// The API server may need the conversion webhook to read old objects, so migrate once the manager is running
+	if err = mgr.Add(migration); err != nil {
+		setupLog.Error(err, "unable to create migration")
+		os.Exit(1)
+	}
+	mgr.GetWebhookServer().Register("/convert", &conversion.Webhook{})
+	if err = (&webhook.ExperimentValidator{}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Experiment")
+		os.Exit(1)
+	}
+	if err = (&webhook.TrialValidator{
 		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Metric"),
-		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Metric")
+		setupLog.Error(err, "unable to create webhook", "webhook", "Trial")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metric defines the interface used to collect the values of experiment metrics along with a registry of
+// collectors indexed by metric type. Collectors for the built-in metric types are registered by the controller,
+// additional types can be supported by registering a collector before the controller is started.
+package metric
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/redskyops/redskyops-controller/internal/meta"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Collector captures the values of a single type of metric
+type Collector interface {
+	// Target returns the object (e.g. a list of services) the metric should be collected from, the returned object
+	// may be nil if the collector does not require a target
+	Target(ctx context.Context, r client.Reader, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error)
+	// Capture returns the value and error (standard deviation) of a metric whose queries have already been rendered
+	Capture(m *redskyv1beta1.Metric, t *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error)
+}
+
+var (
+	collectorsLock sync.RWMutex
+	collectors     = make(map[redskyv1beta1.MetricType]Collector)
+)
+
+// Register makes a collector available for the specified metric type; registering a collector for a type that
+// already has a collector will replace the existing collector
+func Register(metricType redskyv1beta1.MetricType, c Collector) {
+	collectorsLock.Lock()
+	defer collectorsLock.Unlock()
+	collectors[metricType] = c
+}
+
+// ForMetric returns the collector registered for the type of the supplied metric
+func ForMetric(m *redskyv1beta1.Metric) (Collector, error) {
+	metricType := m.Type
+	if metricType == "" {
+		metricType = redskyv1beta1.MetricLocal
+	}
+
+	collectorsLock.RLock()
+	defer collectorsLock.RUnlock()
+	if c, ok := collectors[metricType]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown metric type: %s", m.Type)
+}
+
+// Target returns the object the supplied metric should be collected from
+func Target(ctx context.Context, r client.Reader, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error) {
+	c, err := ForMetric(m)
+	if err != nil {
+		return nil, err
+	}
+	return c.Target(ctx, r, namespace, m)
+}
+
+// NoTarget can be embedded in collectors which do not require a target object
+type NoTarget struct{}
+
+// Target always returns nil
+func (NoTarget) Target(context.Context, client.Reader, string, *redskyv1beta1.Metric) (runtime.Object, error) {
+	return nil, nil
+}
+
+// PodTarget can be embedded in collectors which use the list of pods matching the metric selector
+type PodTarget struct{}
+
+// Target returns the list of pods matching the metric selector
+func (PodTarget) Target(ctx context.Context, r client.Reader, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error) {
+	target := &corev1.PodList{}
+	if sel, err := meta.MatchingSelector(m.Selector); err != nil {
+		return nil, err
+	} else if err := r.List(ctx, target, client.InNamespace(namespace), sel); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// ServiceTarget can be embedded in collectors which use the list of services matching the metric selector
+type ServiceTarget struct{}
+
+// Target returns the list of services matching the metric selector
+func (ServiceTarget) Target(ctx context.Context, r client.Reader, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error) {
+	target := &corev1.ServiceList{}
+	if sel, err := meta.MatchingSelector(m.Selector); err != nil {
+		return nil, err
+	} else if err := r.List(ctx, target, client.InNamespace(namespace), sel); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// EndpointTarget can be embedded in collectors which make requests to the services or pods matching the metric selector
+type EndpointTarget struct{}
+
+// Target returns the list of services or pods matching the metric selector, depending on the metric target type
+func (EndpointTarget) Target(ctx context.Context, r client.Reader, namespace string, m *redskyv1beta1.Metric) (runtime.Object, error) {
+	if m.TargetType == redskyv1beta1.MetricTargetPods {
+		return PodTarget{}.Target(ctx, r, namespace, m)
+	}
+	return ServiceTarget{}.Target(ctx, r, namespace, m)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// countCollector returns the number of items in the target list
+type countCollector struct {
+	PodTarget
+}
+
+func (c *countCollector) Capture(_ *redskyv1beta1.Metric, _ *redskyv1beta1.Trial, target runtime.Object) (float64, float64, error) {
+	return float64(len(target.(*corev1.PodList).Items)), 0, nil
+}
+
+// constantCollector returns a fixed value without a target
+type constantCollector struct {
+	NoTarget
+	value float64
+}
+
+func (c *constantCollector) Capture(*redskyv1beta1.Metric, *redskyv1beta1.Trial, runtime.Object) (float64, float64, error) {
+	return c.value, 0, nil
+}
+
+func TestRegister(t *testing.T) {
+	Register("count", &countCollector{})
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	r := fake.NewFakeClientWithScheme(scheme,
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default", Labels: map[string]string{"app": "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default", Labels: map[string]string{"app": "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default", Labels: map[string]string{"app": "other"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "other", Labels: map[string]string{"app": "test"}}},
+	)
+
+	m := &redskyv1beta1.Metric{
+		Name:     "test",
+		Type:     "count",
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+	}
+
+	target, err := Target(context.TODO(), r, "default", m)
+	require.NoError(t, err)
+	c, err := ForMetric(m)
+	require.NoError(t, err)
+	value, stddev, err := c.Capture(m, &redskyv1beta1.Trial{}, target)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), value)
+	assert.Equal(t, float64(0), stddev)
+}
+
+func TestForMetric(t *testing.T) {
+	Register(redskyv1beta1.MetricLocal, &constantCollector{value: 1})
+	Register("replaced", &constantCollector{value: 2})
+	Register("replaced", &constantCollector{value: 3})
+
+	cases := []struct {
+		desc       string
+		metricType redskyv1beta1.MetricType
+		expected   float64
+		err        string
+	}{
+		{
+			desc:     "default",
+			expected: 1,
+		},
+		{
+			desc:       "replaced",
+			metricType: "replaced",
+			expected:   3,
+		},
+		{
+			desc:       "unknown",
+			metricType: "unknown",
+			err:        "unknown metric type: unknown",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			m := &redskyv1beta1.Metric{Type: c.metricType}
+			actual, err := ForMetric(m)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			if assert.NoError(t, err) {
+				value, _, err := actual.Capture(m, &redskyv1beta1.Trial{}, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, c.expected, value)
+			}
+		})
+	}
+}
@@ -52,6 +52,8 @@ type Metric struct {
 	Name string `json:"name"`
 	// The flag indicating this metric should be minimized.
 	Minimize bool `json:"minimize,omitempty"`
+	// The flag indicating this metric should be optimized (if omitted, defaults to true).
+	Optimize *bool `json:"optimize,omitempty"`
 }
 
 type ConstraintType string
@@ -96,8 +98,9 @@ type Constraint struct {
 type ParameterType string
 
 const (
-	ParameterTypeInteger ParameterType = "int"
-	ParameterTypeDouble  ParameterType = "double"
+	ParameterTypeInteger     ParameterType = "int"
+	ParameterTypeDouble      ParameterType = "double"
+	ParameterTypeCategorical ParameterType = "categorical"
 )
 
 type Bounds struct {
@@ -114,7 +117,9 @@ type Parameter struct {
 	// The type of the parameter.
 	Type ParameterType `json:"type"`
 	// The domain of the parameter.
-	Bounds Bounds `json:"bounds"`
+	Bounds *Bounds `json:"bounds,omitempty"`
+	// The discrete values for a categorical parameter.
+	Values []string `json:"values,omitempty"`
 }
 
 type ExperimentMeta struct {
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// NumberOrString is a value that can be either a JSON number or a string (e.g. a categorical assignment).
+type NumberOrString struct {
+	IsString bool
+	NumVal   json.Number
+	StrVal   string
+}
+
+// FromNumber returns the supplied value as a NumberOrString
+func FromNumber(val json.Number) NumberOrString {
+	return NumberOrString{NumVal: val}
+}
+
+// FromInt64 returns the supplied value as a NumberOrString
+func FromInt64(val int64) NumberOrString {
+	return NumberOrString{NumVal: json.Number(strconv.FormatInt(val, 10))}
+}
+
+// FromFloat64 returns the supplied value as a NumberOrString
+func FromFloat64(val float64) NumberOrString {
+	return NumberOrString{NumVal: json.Number(strconv.FormatFloat(val, 'f', -1, 64))}
+}
+
+// FromString returns the supplied value as a NumberOrString
+func FromString(val string) NumberOrString {
+	return NumberOrString{StrVal: val, IsString: true}
+}
+
+// String returns the string representation of the value
+func (s *NumberOrString) String() string {
+	if s.IsString {
+		return s.StrVal
+	}
+	return s.NumVal.String()
+}
+
+// Int64 returns the value as an int64
+func (s *NumberOrString) Int64() (int64, error) {
+	if s.IsString {
+		return strconv.ParseInt(s.StrVal, 10, 64)
+	}
+	return s.NumVal.Int64()
+}
+
+// Float64 returns the value as a float64
+func (s *NumberOrString) Float64() (float64, error) {
+	if s.IsString {
+		return strconv.ParseFloat(s.StrVal, 64)
+	}
+	return s.NumVal.Float64()
+}
+
+// MarshalJSON writes the value as either a JSON number or string
+func (s NumberOrString) MarshalJSON() ([]byte, error) {
+	if s.IsString {
+		return json.Marshal(s.StrVal)
+	}
+	return json.Marshal(s.NumVal)
+}
+
+// UnmarshalJSON reads the value from either a JSON number or string
+func (s *NumberOrString) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		s.IsString = true
+		s.NumVal = ""
+		return json.Unmarshal(b, &s.StrVal)
+	}
+	s.IsString = false
+	s.StrVal = ""
+	return json.Unmarshal(b, &s.NumVal)
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -47,7 +46,7 @@ type Assignment struct {
 	// The name of the parameter in the experiment the assignment corresponds to.
 	ParameterName string `json:"parameterName"`
 	// The assigned value of the parameter.
-	Value json.Number `json:"value"`
+	Value NumberOrString `json:"value"`
 }
 
 type TrialAssignments struct {
@@ -71,6 +70,10 @@ type TrialValues struct {
 	Values []Value `json:"values,omitempty"`
 	// Indicator that the trial failed, Values is ignored when true.
 	Failed bool `json:"failed,omitempty"`
+	// FailureReason is the machine readable reason code for the failure, if Failed is true.
+	FailureReason string `json:"failureReason,omitempty"`
+	// FailureMessage is a human readable explanation of the failure, if Failed is true.
+	FailureMessage string `json:"failureMessage,omitempty"`
 }
 
 type TrialStatus string
@@ -100,6 +103,9 @@ type TrialItem struct {
 	// Experiment is a reference back to the experiment this trial item is associated with. This field is never
 	// populated by the API, but may be useful for consumers to maintain a connection between resources.
 	Experiment *Experiment `json:"-"`
+	// Baseline is a reference to the baseline trial of the experiment this trial item is associated with. This field
+	// is never populated by the API, but may be useful for consumers to compare trial results.
+	Baseline *TrialItem `json:"-"`
 }
 
 type TrialListQuery struct {
@@ -140,6 +146,9 @@ type TrialList struct {
 	Experiment *Experiment `json:"-"`
 }
 
+// LabelBaseline is the label used to identify the trial which uses the baseline parameter values
+const LabelBaseline = "baseline"
+
 type TrialLabels struct {
 	// New labels for this trial.
 	Labels map[string]string `json:"labels"`
@@ -200,7 +200,7 @@ func generateExperiment(o *ServerOptions) *experimentsv1alpha1.Experiment {
 		e.Parameters = append(e.Parameters, experimentsv1alpha1.Parameter{
 			Name:   getUnique(used, getRandomParameter),
 			Type:   experimentsv1alpha1.ParameterTypeInteger,
-			Bounds: *generateBounds(),
+			Bounds: generateBounds(),
 		})
 	}
 
@@ -274,6 +274,9 @@ func checkServerExperiment(name string, original, created *experimentsv1alpha1.E
 	}
 	for _, p := range created.Parameters {
 		if op, ok := params[p.Name]; ok {
+			if p.Bounds == nil {
+				return fmt.Errorf("server returned parameter without bounds: %s", p.Name)
+			}
 			if p.Bounds.Min != op.Bounds.Min || p.Bounds.Max != op.Bounds.Max {
 				return fmt.Errorf("server returned parameter with incorrect bounds: %s [%s,%s] (expected [%s,%s])", p.Name, p.Bounds.Min, p.Bounds.Min, op.Bounds.Min, op.Bounds.Max)
 			}
@@ -316,6 +319,10 @@ func checkTrialAssignments(exp *experimentsv1alpha1.Experiment, t *experimentsv1
 	}
 	for _, a := range t.Assignments {
 		if p, ok := params[a.ParameterName]; ok {
+			if p.Bounds == nil {
+				continue
+			}
+
 			// Check bounds using floating point arithmetic
 			v, err := a.Value.Float64()
 			if err != nil {
@@ -330,7 +337,7 @@ func checkTrialAssignments(exp *experimentsv1alpha1.Experiment, t *experimentsv1
 				return err
 			}
 			if v < min || v > max {
-				return fmt.Errorf("server return out of bounds assignment: %s = %s (expected [%s,%s])", a.ParameterName, a.Value, p.Bounds.Min, p.Bounds.Max)
+				return fmt.Errorf("server return out of bounds assignment: %s = %s (expected [%s,%s])", a.ParameterName, a.Value.String(), p.Bounds.Min, p.Bounds.Max)
 			}
 		} else {
 			return fmt.Errorf("server returned unexpected assignment: %s", a.ParameterName)
@@ -19,6 +19,7 @@ package experiments
 import (
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -201,6 +202,11 @@ func (m *experimentsMeta) Columns(obj interface{}, outputFormat string, showLabe
 			for i := range tl.Experiment.Metrics {
 				columns = append(columns, "metric_"+tl.Experiment.Metrics[i].Name)
 			}
+			if hasBaseline(tl) {
+				for i := range tl.Experiment.Metrics {
+					columns = append(columns, "improvement_"+tl.Experiment.Metrics[i].Name)
+				}
+			}
 		}
 
 		// CSV labels need to be split out into individual columns
@@ -221,10 +227,19 @@ func (m *experimentsMeta) Columns(obj interface{}, outputFormat string, showLabe
 
 	// Columns are less complex in other cases
 	columns := []string{"name"}
-	switch obj.(type) {
+	switch o := obj.(type) {
 
-	case *experimentsv1alpha1.TrialList, *experimentsv1alpha1.TrialItem:
+	case *experimentsv1alpha1.TrialList:
 		columns = append(columns, "Status") // Title case the value
+		if hasBaseline(o) {
+			columns = append(columns, "improvement")
+		}
+
+	case *experimentsv1alpha1.TrialItem:
+		columns = append(columns, "Status") // Title case the value
+		if o.Baseline != nil {
+			columns = append(columns, "improvement")
+		}
 
 	case *experimentsv1alpha1.ExperimentList, *experimentsv1alpha1.ExperimentItem:
 		if outputFormat == "wide" {
@@ -275,6 +290,20 @@ func (m *experimentsMeta) ExtractValue(obj interface{}, column string) (string,
 			return string(o.Status), nil
 		case "Status":
 			return strings.Title(string(o.Status)), nil
+		case "improvement":
+			var improvements []string
+			if o.Experiment != nil {
+				for i := range o.Experiment.Metrics {
+					m := &o.Experiment.Metrics[i]
+					if m.Optimize != nil && !*m.Optimize {
+						continue
+					}
+					if imp := improvement(o, m); imp != "" {
+						improvements = append(improvements, fmt.Sprintf("%s=%s", m.Name, imp))
+					}
+				}
+			}
+			return strings.Join(improvements, ","), nil
 		case "labels":
 			var labels []string
 			for k, v := range o.Labels {
@@ -300,6 +329,13 @@ func (m *experimentsMeta) ExtractValue(obj interface{}, column string) (string,
 					return "", nil // Do not fail for missing metrics unless the trial complete
 				}
 			}
+			if mn := strings.TrimPrefix(column, "improvement_"); mn != column && o.Experiment != nil {
+				for i := range o.Experiment.Metrics {
+					if mn == o.Experiment.Metrics[i].Name {
+						return improvement(o, &o.Experiment.Metrics[i]), nil
+					}
+				}
+			}
 			if ln := strings.TrimPrefix(column, "label_"); ln != column {
 				for k, v := range o.Labels {
 					if ln == k {
@@ -313,6 +349,44 @@ func (m *experimentsMeta) ExtractValue(obj interface{}, column string) (string,
 	return "", fmt.Errorf("unable to get value for column %s", column)
 }
 
+// hasBaseline checks to see if the trials in the list can be compared to a baseline
+func hasBaseline(tl *experimentsv1alpha1.TrialList) bool {
+	for i := range tl.Trials {
+		if tl.Trials[i].Baseline != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// improvement returns the relative improvement of a trial's metric value over the baseline value as a percentage
+func improvement(t *experimentsv1alpha1.TrialItem, m *experimentsv1alpha1.Metric) string {
+	if t.Baseline == nil {
+		return ""
+	}
+
+	var value, baseline *float64
+	for i := range t.Values {
+		if t.Values[i].MetricName == m.Name {
+			value = &t.Values[i].Value
+		}
+	}
+	for i := range t.Baseline.Values {
+		if t.Baseline.Values[i].MetricName == m.Name {
+			baseline = &t.Baseline.Values[i].Value
+		}
+	}
+	if value == nil || baseline == nil || *baseline == 0 {
+		return ""
+	}
+
+	imp := (*value - *baseline) / math.Abs(*baseline) * 100
+	if m.Minimize {
+		imp = -imp
+	}
+	return fmt.Sprintf("%+.1f%%", imp)
+}
+
 // Header returns the header name to use for a column
 func (m *experimentsMeta) Header(outputFormat string, column string) string {
 	if strings.ToLower(outputFormat) == "csv" {
@@ -19,6 +19,7 @@ package experiments
 import (
 	"testing"
 
+	experimentsv1alpha1 "github.com/redskyops/redskyops-controller/redskyapi/experiments/v1alpha1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -98,3 +99,38 @@ func TestParseNames(t *testing.T) {
 		})
 	}
 }
+
+func TestImprovement(t *testing.T) {
+	baseline := &experimentsv1alpha1.TrialItem{
+		TrialValues: experimentsv1alpha1.TrialValues{
+			Values: []experimentsv1alpha1.Value{
+				{MetricName: "cost", Value: 200},
+				{MetricName: "throughput", Value: 50},
+			},
+		},
+	}
+	trial := &experimentsv1alpha1.TrialItem{
+		TrialValues: experimentsv1alpha1.TrialValues{
+			Values: []experimentsv1alpha1.Value{
+				{MetricName: "cost", Value: 150},
+				{MetricName: "throughput", Value: 45},
+			},
+		},
+		Baseline: baseline,
+	}
+
+	cases := []struct {
+		desc     string
+		metric   experimentsv1alpha1.Metric
+		expected string
+	}{
+		{desc: "minimize", metric: experimentsv1alpha1.Metric{Name: "cost", Minimize: true}, expected: "+25.0%"},
+		{desc: "maximize", metric: experimentsv1alpha1.Metric{Name: "throughput"}, expected: "-10.0%"},
+		{desc: "missing", metric: experimentsv1alpha1.Metric{Name: "latency"}, expected: ""},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.expected, improvement(trial, &c.metric))
+		})
+	}
+}
@@ -177,10 +177,12 @@ func (o *GetOptions) getTrials(ctx context.Context, numbers map[experimentsv1alp
 			return err
 		}
 
+		baseline := findBaseline(&tl)
 		for i := range tl.Trials {
 			if hasTrialNumber(&tl.Trials[i], nums) {
 				t := tl.Trials[i]
 				t.Experiment = &exp
+				t.Baseline = baseline
 				l.Trials = append(l.Trials, t)
 			}
 		}
@@ -213,10 +215,12 @@ func (o *GetOptions) getTrialList(ctx context.Context, name experimentsv1alpha1.
 			return err
 		}
 
-		// Store a back reference to the experiment on the list and every item in it
+		// Store a back reference to the experiment (and baseline) on the list and every item in it
 		l.Experiment = &exp
+		baseline := findBaseline(&l)
 		for i := range l.Trials {
 			l.Trials[i].Experiment = &exp
+			l.Trials[i].Baseline = baseline
 		}
 	}
 
@@ -227,6 +231,17 @@ func (o *GetOptions) getTrialList(ctx context.Context, name experimentsv1alpha1.
 	return o.Printer.PrintObj(&l, o.Out)
 }
 
+// findBaseline returns a copy of the completed baseline trial from the list, or nil if there is no baseline
+func findBaseline(l *experimentsv1alpha1.TrialList) *experimentsv1alpha1.TrialItem {
+	for i := range l.Trials {
+		if l.Trials[i].Labels[experimentsv1alpha1.LabelBaseline] == "true" && l.Trials[i].Status == experimentsv1alpha1.TrialCompleted {
+			baseline := l.Trials[i]
+			return &baseline
+		}
+	}
+	return nil
+}
+
 func (o *GetOptions) filterAndSortExperiments(l *experimentsv1alpha1.ExperimentList) error {
 	// Experiments do not have labels so anything but the empty selector will just nil out the list
 	if sel, err := labels.Parse(o.Selector); err != nil {
@@ -276,10 +291,15 @@ func (o *GetOptions) filterAndSortTrials(l *experimentsv1alpha1.TrialList) error
 
 // sortableTrialData slightly modifies the schema of the trial item to make it easier to specify sort orders
 func sortableTrialData(item *experimentsv1alpha1.TrialItem) map[string]interface{} {
-	assignments := make(map[string]int64, len(item.Assignments))
+	assignments := make(map[string]interface{}, len(item.Assignments))
 	for i := range item.Assignments {
-		if a, err := item.Assignments[i].Value.Int64(); err == nil {
-			assignments[item.Assignments[i].ParameterName] = a
+		a := &item.Assignments[i].Value
+		if a.IsString {
+			assignments[item.Assignments[i].ParameterName] = a.StrVal
+		} else if v, err := a.Int64(); err == nil {
+			assignments[item.Assignments[i].ParameterName] = v
+		} else if v, err := a.Float64(); err == nil {
+			assignments[item.Assignments[i].ParameterName] = v
 		}
 	}
 
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 
 	experimentsv1alpha1 "github.com/redskyops/redskyops-controller/redskyapi/experiments/v1alpha1"
 	"github.com/redskyops/redskyops-controller/redskyctl/internal/commander"
@@ -94,16 +95,16 @@ func (o *SuggestOptions) SuggestAssignments(exp *experimentsv1alpha1.Experiment)
 	return ta, nil
 }
 
-func (o *SuggestOptions) assign(p *experimentsv1alpha1.Parameter) (json.Number, error) {
+func (o *SuggestOptions) assign(p *experimentsv1alpha1.Parameter) (experimentsv1alpha1.NumberOrString, error) {
 	// Look for explicit assignments
 	if a, ok := o.Assignments[p.Name]; ok {
-		return checkValue(p, json.Number(a))
+		return checkValue(p, a)
 	}
 
 	// Compute a default value (may be needed for interactive prompt)
 	def, err := o.defaultValue(p)
 	if err != nil {
-		return "0", err
+		return experimentsv1alpha1.NumberOrString{}, err
 	}
 
 	// Collect the value interactively
@@ -116,17 +117,17 @@ func (o *SuggestOptions) assign(p *experimentsv1alpha1.Parameter) (json.Number,
 		return *def, nil
 	}
 
-	return "0", fmt.Errorf("no assignment for parameter: %s", p.Name)
+	return experimentsv1alpha1.NumberOrString{}, fmt.Errorf("no assignment for parameter: %s", p.Name)
 }
 
-func (o *SuggestOptions) defaultValue(p *experimentsv1alpha1.Parameter) (*json.Number, error) {
+func (o *SuggestOptions) defaultValue(p *experimentsv1alpha1.Parameter) (*experimentsv1alpha1.NumberOrString, error) {
 	switch o.DefaultBehavior {
 	case "none":
 		return nil, nil
 	case "min":
-		return &p.Bounds.Min, nil
+		return boundValue(p, true)
 	case "max":
-		return &p.Bounds.Max, nil
+		return boundValue(p, false)
 	case "rand":
 		return randomValue(p)
 	}
@@ -134,11 +135,18 @@ func (o *SuggestOptions) defaultValue(p *experimentsv1alpha1.Parameter) (*json.N
 	return nil, nil
 }
 
-func (o *SuggestOptions) assignInteractive(p *experimentsv1alpha1.Parameter, def *json.Number) (json.Number, error) {
+func (o *SuggestOptions) assignInteractive(p *experimentsv1alpha1.Parameter, def *experimentsv1alpha1.NumberOrString) (experimentsv1alpha1.NumberOrString, error) {
+	domain := ""
+	if p.Type == experimentsv1alpha1.ParameterTypeCategorical {
+		domain = fmt.Sprintf("[%s]", strings.Join(p.Values, ","))
+	} else if p.Bounds != nil {
+		domain = fmt.Sprintf("[%v,%v]", p.Bounds.Min, p.Bounds.Max)
+	}
+
 	if def != nil {
-		_, _ = fmt.Fprintf(o.ErrOut, "Assignment for %v parameter '%s' [%v,%v] (%v): ", p.Type, p.Name, p.Bounds.Min, p.Bounds.Max, *def)
+		_, _ = fmt.Fprintf(o.ErrOut, "Assignment for %v parameter '%s' %s (%v): ", p.Type, p.Name, domain, def.String())
 	} else {
-		_, _ = fmt.Fprintf(o.ErrOut, "Assignment for %v parameter '%s' [%v,%v]: ", p.Type, p.Name, p.Bounds.Min, p.Bounds.Max)
+		_, _ = fmt.Fprintf(o.ErrOut, "Assignment for %v parameter '%s' %s: ", p.Type, p.Name, domain)
 	}
 
 	s := bufio.NewScanner(o.In)
@@ -153,70 +161,110 @@ func (o *SuggestOptions) assignInteractive(p *experimentsv1alpha1.Parameter, def
 		if text == "" && def != nil {
 			return *def, nil
 		}
-		number, err := checkValue(p, json.Number(text))
+		v, err := checkValue(p, text)
 		if err != nil {
 			continue
 		}
-		return number, nil
+		return v, nil
 	}
 
 	if err := s.Err(); err != nil {
-		return "0", err
+		return experimentsv1alpha1.NumberOrString{}, err
 	}
-	return "0", fmt.Errorf("no assignment for parameter: %s", p.Name)
+	return experimentsv1alpha1.NumberOrString{}, fmt.Errorf("no assignment for parameter: %s", p.Name)
 }
 
-func checkValue(p *experimentsv1alpha1.Parameter, n json.Number) (json.Number, error) {
+func checkValue(p *experimentsv1alpha1.Parameter, s string) (experimentsv1alpha1.NumberOrString, error) {
 	switch p.Type {
 	case experimentsv1alpha1.ParameterTypeInteger:
-		min, max, err := intBounds(&p.Bounds)
+		min, max, err := intBounds(p.Bounds)
 		if err != nil {
-			return "0", err
+			return experimentsv1alpha1.NumberOrString{}, err
 		}
-		v, err := n.Int64()
+		v, err := strconv.ParseInt(s, 10, 64)
 		if err != nil {
-			return "0", err
+			return experimentsv1alpha1.NumberOrString{}, err
 		}
 		if v < min || v > max {
-			return "0", fmt.Errorf("value is not within experiment bounds [%d-%d]: %d", min, max, v)
+			return experimentsv1alpha1.NumberOrString{}, fmt.Errorf("value is not within experiment bounds [%d-%d]: %d", min, max, v)
 		}
+		return experimentsv1alpha1.FromInt64(v), nil
 	case experimentsv1alpha1.ParameterTypeDouble:
-		min, max, err := floatBounds(&p.Bounds)
+		min, max, err := floatBounds(p.Bounds)
 		if err != nil {
-			return "0.0", err
+			return experimentsv1alpha1.NumberOrString{}, err
 		}
-		v, err := n.Float64()
+		v, err := strconv.ParseFloat(s, 64)
 		if err != nil {
-			return "0.0", err
+			return experimentsv1alpha1.NumberOrString{}, err
 		}
 		if v < min || v > max {
-			return "0.0", fmt.Errorf("value is not within experiment bounds [%f-%f]: %f", min, max, v)
+			return experimentsv1alpha1.NumberOrString{}, fmt.Errorf("value is not within experiment bounds [%f-%f]: %f", min, max, v)
 		}
+		return experimentsv1alpha1.FromFloat64(v), nil
+	case experimentsv1alpha1.ParameterTypeCategorical:
+		for _, v := range p.Values {
+			if v == s {
+				return experimentsv1alpha1.FromString(s), nil
+			}
+		}
+		return experimentsv1alpha1.NumberOrString{}, fmt.Errorf("value is not one of the experiment values [%s]: %s", strings.Join(p.Values, ","), s)
 	}
-	return n, nil
+	return experimentsv1alpha1.FromNumber(json.Number(s)), nil
 }
 
-func randomValue(p *experimentsv1alpha1.Parameter) (*json.Number, error) {
+func boundValue(p *experimentsv1alpha1.Parameter, min bool) (*experimentsv1alpha1.NumberOrString, error) {
+	var v experimentsv1alpha1.NumberOrString
+	switch {
+	case p.Type == experimentsv1alpha1.ParameterTypeCategorical:
+		if len(p.Values) == 0 {
+			return nil, fmt.Errorf("no values for %v parameter: %s", p.Type, p.Name)
+		}
+		if min {
+			v = experimentsv1alpha1.FromString(p.Values[0])
+		} else {
+			v = experimentsv1alpha1.FromString(p.Values[len(p.Values)-1])
+		}
+	case p.Bounds == nil:
+		return nil, fmt.Errorf("no bounds for %v parameter: %s", p.Type, p.Name)
+	case min:
+		v = experimentsv1alpha1.FromNumber(p.Bounds.Min)
+	default:
+		v = experimentsv1alpha1.FromNumber(p.Bounds.Max)
+	}
+	return &v, nil
+}
+
+func randomValue(p *experimentsv1alpha1.Parameter) (*experimentsv1alpha1.NumberOrString, error) {
 	switch p.Type {
 	case experimentsv1alpha1.ParameterTypeInteger:
-		min, max, err := intBounds(&p.Bounds)
+		min, max, err := intBounds(p.Bounds)
 		if err != nil {
 			return nil, err
 		}
-		r := json.Number(strconv.FormatInt(rand.Int63n(max-min)+min, 10))
+		r := experimentsv1alpha1.FromInt64(rand.Int63n(max-min+1) + min)
 		return &r, nil
 	case experimentsv1alpha1.ParameterTypeDouble:
-		min, max, err := floatBounds(&p.Bounds)
+		min, max, err := floatBounds(p.Bounds)
 		if err != nil {
 			return nil, err
 		}
-		r := json.Number(strconv.FormatFloat(rand.Float64()*max+min, 'f', -1, 64))
+		r := experimentsv1alpha1.FromFloat64(rand.Float64()*(max-min) + min)
+		return &r, nil
+	case experimentsv1alpha1.ParameterTypeCategorical:
+		if len(p.Values) == 0 {
+			break
+		}
+		r := experimentsv1alpha1.FromString(p.Values[rand.Intn(len(p.Values))])
 		return &r, nil
 	}
 	return nil, fmt.Errorf("unable to produce random %v", p.Type)
 }
 
 func intBounds(b *experimentsv1alpha1.Bounds) (int64, int64, error) {
+	if b == nil {
+		return 0, 0, fmt.Errorf("missing bounds")
+	}
 	min, err := b.Min.Int64()
 	if err != nil {
 		return 0, 0, err
@@ -229,6 +277,9 @@ func intBounds(b *experimentsv1alpha1.Bounds) (int64, int64, error) {
 }
 
 func floatBounds(b *experimentsv1alpha1.Bounds) (float64, float64, error) {
+	if b == nil {
+		return 0, 0, fmt.Errorf("missing bounds")
+	}
 	min, err := b.Min.Float64()
 	if err != nil {
 		return 0, 0, err
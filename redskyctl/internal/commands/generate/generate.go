@@ -25,13 +25,13 @@ import (
 	redskyv1alpha1 "github.com/redskyops/redskyops-controller/api/v1alpha1"
 	redskyv1beta1 "github.com/redskyops/redskyops-controller/api/v1beta1"
 	"github.com/redskyops/redskyops-controller/internal/config"
-	"github.com/redskyops/redskyops-controller/internal/controller"
 	"github.com/redskyops/redskyops-controller/redskyctl/internal/commands/authorize_cluster"
 	"github.com/redskyops/redskyops-controller/redskyctl/internal/commands/grant_permissions"
 	"github.com/redskyops/redskyops-controller/redskyctl/internal/commands/initialize"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 )
 
 // Options includes the configuration for the subcommands
@@ -82,7 +82,7 @@ func readExperiments(filename string, defaultReader io.Reader, list *redskyv1bet
 	_ = redskyv1beta1.AddToScheme(scheme)
 	_ = redskyv1alpha1.AddToScheme(scheme)
 	_ = registerListConversions(scheme)
-	cs := controller.NewConversionSerializer(scheme)
+	cs := serializer.NewCodecFactory(scheme)
 	mediaType := runtime.ContentTypeYAML
 	switch filepath.Ext(filename) {
 	case "json":
@@ -92,7 +92,7 @@ func readExperiments(filename string, defaultReader io.Reader, list *redskyv1bet
 	if !ok {
 		return fmt.Errorf("could not find serializer for %s", mediaType)
 	}
-	decoder := cs.DecoderToVersion(info.Serializer, runtime.InternalGroupVersioner)
+	decoder := cs.DecoderToVersion(info.Serializer, redskyv1beta1.GroupVersion)
 
 	// NOTE: This attempts to read an "ExperimentList", a stream of experiment YAML documents IS NOT an experiment list
 	// TODO If the mediaType is YAML we should use a `yaml.NewDocumentDecoder(...)` to get individual documents
@@ -39,6 +39,13 @@ func TestGenerate(t *testing.T) {
 
 	defer os.Remove(experimentFile.Name())
 
+	sharedExperimentFile, err := ioutil.TempFile("", "trial")
+	require.NoError(t, err)
+	_, err = sharedExperimentFile.Write(sharedExperiment)
+	require.NoError(t, err)
+
+	defer os.Remove(sharedExperimentFile.Name())
+
 	rsConfig, err := ioutil.TempFile("", "rsConfig")
 	require.NoError(t, err)
 	_, err = rsConfig.Write(configData)
@@ -115,6 +122,44 @@ func TestGenerate(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			desc: "gen rbac shared targets",
+			args: []string{
+				"rbac",
+				"--filename", sharedExperimentFile.Name(),
+			},
+			expectedError: false,
+			expectedPatterns: []string{
+				"kind: ClusterRole\n",
+				"- deployments",
+				"- priorityclasses",
+				"- statefulsets",
+				"- list",
+				"kind: ClusterRoleBinding",
+			},
+			unexpectedPatterns: []string{
+				"name: redsky-patching-shared-example-role-cluster",
+			},
+		},
+		{
+			desc: "gen rbac shared targets with roles",
+			args: []string{
+				"rbac",
+				"--filename", sharedExperimentFile.Name(),
+				"--cluster-role=false",
+			},
+			expectedError: false,
+			expectedPatterns: []string{
+				"kind: Role\n",
+				"namespace: default",
+				"namespace: ingress",
+				"- deployments",
+				"kind: ClusterRole\n",
+				"name: redsky-patching-shared-example-role-cluster",
+				"- priorityclasses",
+				"kind: ClusterRoleBinding",
+			},
+		},
 		// TODO: Revisit gen secret after we get errors surfacing to redskyctl/main.go
 		// calling commander.ExitOnError interrupts the test on failures
 		/*
@@ -194,6 +239,37 @@ spec:
     min: 100
     max: 4000`)
 
+var sharedExperiment = []byte(`apiVersion: redskyops.dev/v1beta1
+kind: Experiment
+metadata:
+  name: shared-example
+  namespace: default
+spec:
+  parameters:
+  - name: priority
+    min: 1
+    max: 1000
+  patches:
+  - targetRef:
+      apiVersion: apps/v1
+      kind: Deployment
+      name: ingress-nginx-controller
+      namespace: ingress
+    patch: '{"spec":{"replicas":2}}'
+  - targetRef:
+      apiVersion: scheduling.k8s.io/v1
+      kind: PriorityClass
+      name: app-priority
+    type: merge
+    patch: '{"value":{{ .Values.priority }}}'
+  - targetRef:
+      apiVersion: apps/v1
+      kind: StatefulSet
+    selector:
+      matchLabels:
+        tier: backend
+    patch: '{"metadata":{"annotations":{"priority":"{{ .Values.priority }}"}}}'`)
+
 var configData = []byte(`
 authorizations:
 - authorization:
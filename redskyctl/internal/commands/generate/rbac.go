@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes/scheme"
 )
@@ -90,11 +91,37 @@ func (o *RBACOptions) Complete() {
 	// Create a REST mapper to convert from GroupVersionKind (used on patch targets) to GroupVersionResource (used in policy rules)
 	rm := meta.NewDefaultRESTMapper(scheme.Scheme.PreferredVersionAllGroups())
 	for gvk := range scheme.Scheme.AllKnownTypes() {
-		rm.Add(gvk, meta.RESTScopeRoot)
+		if clusterScopedKinds[gvk.GroupKind()] {
+			rm.Add(gvk, meta.RESTScopeRoot)
+		} else {
+			rm.Add(gvk, meta.RESTScopeNamespace)
+		}
 	}
 	o.mapper = rm
 }
 
+// clusterScopedKinds are the well known types which are not namespaced, without a connection to a cluster there is
+// no way to discover the scope of a type
+var clusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:        true,
+	{Group: "", Kind: "Node"}:             true,
+	{Group: "", Kind: "PersistentVolume"}: true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:               true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                           true,
+	{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"}:               true,
+	{Group: "node.k8s.io", Kind: "RuntimeClass"}:                                    true,
+	{Group: "policy", Kind: "PodSecurityPolicy"}:                                    true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:                       true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:                true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:                             true,
+	{Group: "storage.k8s.io", Kind: "CSIDriver"}:                                    true,
+	{Group: "storage.k8s.io", Kind: "CSINode"}:                                      true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                                 true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttachment"}:                             true,
+}
+
 func (o *RBACOptions) generate() error {
 	// Read the experiments
 	experimentList := &redskyv1beta1.ExperimentList{}
@@ -109,20 +136,32 @@ func (o *RBACOptions) generate() error {
 	}
 
 	// Discover the policy rules from the experiments and collapse them
-	var experimentRules []*rbacv1.PolicyRule
+	var experimentRules, experimentClusterRules []*rbacv1.PolicyRule
 	for i := range experimentList.Items {
-		experimentRules = o.appendRules(experimentRules, &experimentList.Items[i])
+		experimentRules, experimentClusterRules = o.appendRules(experimentRules, experimentClusterRules, &experimentList.Items[i])
 	}
 	rules := make([]rbacv1.PolicyRule, 0, len(experimentRules))
 	for _, r := range experimentRules {
 		rules = mergeRule(rules, r)
 	}
-	if len(rules) == 0 {
+	clusterRules := make([]rbacv1.PolicyRule, 0, len(experimentClusterRules))
+	for _, r := range experimentClusterRules {
+		clusterRules = mergeRule(clusterRules, r)
+	}
+	if len(rules) == 0 && len(clusterRules) == 0 {
 		return nil
 	}
 
+	// Objects patched in other namespaces require the same roles as the experiment namespaces
+	if namespaces != nil {
+		for _, ns := range targetNamespaces(experimentList) {
+			namespaces = appendMissing(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+	}
+
 	// Add up all the objects and print them out
-	rbac := buildRBAC(roleRef, subject, rules, namespaces)
+	rbac := buildRBAC(roleRef, subject, rules, clusterRules, namespaces)
 	return o.Printer.PrintObj(rbac, o.Out)
 }
 
@@ -177,9 +216,17 @@ func (o *RBACOptions) bindingTargets(experimentList *redskyv1beta1.ExperimentLis
 	return roleRef, subject, namespaces, nil
 }
 
-func buildRBAC(roleRef *rbacv1.RoleRef, subject *rbacv1.Subject, rules []rbacv1.PolicyRule, namespaces []string) *corev1.List {
+func buildRBAC(roleRef *rbacv1.RoleRef, subject *rbacv1.Subject, rules, clusterRules []rbacv1.PolicyRule, namespaces []string) *corev1.List {
 	result := &corev1.List{}
 
+	// A cluster role which is not bound to the cluster can hold the cluster scoped rules
+	if roleRef.Kind == "ClusterRole" && len(namespaces) == 0 {
+		for i := range clusterRules {
+			rules = mergeRule(rules, &clusterRules[i])
+		}
+		clusterRules = nil
+	}
+
 	// Include either a cluster role or a role for each namespace
 	switch roleRef.Kind {
 	case "ClusterRole":
@@ -232,18 +279,61 @@ func buildRBAC(roleRef *rbacv1.RoleRef, subject *rbacv1.Subject, rules []rbacv1.
 		})
 	}
 
+	// Cluster scoped objects can only be patched using a cluster role with a cluster role binding
+	if len(clusterRules) > 0 {
+		clusterRoleRef := rbacv1.RoleRef{APIGroup: roleRef.APIGroup, Kind: "ClusterRole", Name: roleRef.Name + "-cluster"}
+		result.Items = append(result.Items, runtime.RawExtension{
+			Object: &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterRoleRef.Name,
+				},
+				Rules: clusterRules,
+			},
+		}, runtime.RawExtension{
+			Object: &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterRoleRef.Name + "binding",
+				},
+				Subjects: []rbacv1.Subject{*subject},
+				RoleRef:  clusterRoleRef,
+			},
+		})
+	}
+
 	return result
 }
 
-// appendRules finds the patch and readiness targets from an experiment
-func (o *RBACOptions) appendRules(rules []*rbacv1.PolicyRule, exp *redskyv1beta1.Experiment) []*rbacv1.PolicyRule {
-	// Patches require "get" and "patch" permissions
+// targetNamespaces returns the namespaces explicitly referenced by the patch targets of the experiments
+func targetNamespaces(experimentList *redskyv1beta1.ExperimentList) []string {
+	var namespaces []string
+	for i := range experimentList.Items {
+		for _, p := range experimentList.Items[i].Spec.Patches {
+			if p.TargetRef != nil && p.TargetRef.Namespace != "" {
+				namespaces = appendMissing(namespaces, p.TargetRef.Namespace)
+			}
+		}
+	}
+	return namespaces
+}
+
+// appendRules finds the patch and readiness targets from an experiment, rules for cluster scoped targets are returned separately
+func (o *RBACOptions) appendRules(rules, clusterRules []*rbacv1.PolicyRule, exp *redskyv1beta1.Experiment) ([]*rbacv1.PolicyRule, []*rbacv1.PolicyRule) {
+	// Patches require "get" and "patch" permissions, patches using a selector also require "list"
 	for i := range exp.Spec.Patches {
 		// TODO This needs to use patch_controller.go `renderTemplate` to get the correct reference (e.g. SMP may have the ref in the payload)
 		// NOTE: Technically we can not get the target reference without an actual trial; in most cases a dummy trial should work
 		ref := exp.Spec.Patches[i].TargetRef
-		if ref != nil {
-			rules = append(rules, o.newPolicyRule(ref, "get", "patch"))
+		if ref == nil {
+			continue
+		}
+		verbs := []string{"get", "patch"}
+		if exp.Spec.Patches[i].Selector != nil {
+			verbs = append(verbs, "list")
+		}
+		if o.isClusterScoped(ref) {
+			clusterRules = append(clusterRules, o.newPolicyRule(ref, verbs...))
+		} else {
+			rules = append(rules, o.newPolicyRule(ref, verbs...))
 		}
 	}
 
@@ -268,7 +358,14 @@ func (o *RBACOptions) appendRules(rules []*rbacv1.PolicyRule, exp *redskyv1beta1
 		}
 	}
 
-	return rules
+	return rules, clusterRules
+}
+
+// isClusterScoped checks to see if the referenced object is not namespaced
+func (o *RBACOptions) isClusterScoped(ref *corev1.ObjectReference) bool {
+	gvk := ref.GroupVersionKind()
+	m, err := o.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	return err == nil && m.Scope.Name() == meta.RESTScopeNameRoot
 }
 
 // newPolicyRule creates a new policy rule for the specified object reference and list of verbs
@@ -75,7 +75,7 @@ func Test(t *testing.T) {
 
 			res, err := k.Run(k.Base)
 			assert.NoError(t, err)
-			assert.Equal(t, res.Size(), 6)
+			assert.Equal(t, res.Size(), 11)
 
 			r, err := res.Select(types.Selector{Name: "redsky-controller-manager"})
 			assert.NoError(t, err)
@@ -85,6 +85,16 @@ func Test(t *testing.T) {
 			if tc.expected.Image != "" {
 				assert.Contains(t, r[0].String(), tc.expected.Image)
 			}
+
+			r, err = res.Select(types.Selector{Name: "redsky-serving-cert"})
+			assert.NoError(t, err)
+			assert.Len(t, r, 1)
+			assert.Contains(t, r[0].String(), "redsky-webhook-service."+tc.expected.Namespace+".svc")
+
+			r, err = res.Select(types.Selector{Name: "experiments.redskyops.dev"})
+			assert.NoError(t, err)
+			assert.Len(t, r, 1)
+			assert.Equal(t, tc.expected.Namespace+"/redsky-serving-cert", r[0].GetAnnotations()["cert-manager.io/inject-ca-from"])
 		})
 	}
 }
@@ -59,9 +59,74 @@ func defaultOptions() *Kustomize {
 }
 
 // WithNamespace sets the namespace attribute for the kustomization.
+// The serving certificate DNS names, the CA injection annotations and the conversion webhook of the custom resource
+// definitions also reference the namespace so they are patched to match the webhook service in the new namespace.
 func WithNamespace(n string) Option {
 	return func(k *Kustomize) error {
 		k.kustomize.Namespace = n
+
+		namespacePatch := `
+apiVersion: cert-manager.io/v1alpha2
+kind: Certificate
+metadata:
+  name: redsky-serving-cert
+  namespace: {{ .Default }}
+spec:
+  dnsNames:
+  - redsky-webhook-service.{{ .Namespace }}.svc
+  - redsky-webhook-service.{{ .Namespace }}.svc.cluster.local
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: redsky-mutating-webhook-configuration
+  annotations:
+    cert-manager.io/inject-ca-from: {{ .Namespace }}/redsky-serving-cert
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: redsky-validating-webhook-configuration
+  annotations:
+    cert-manager.io/inject-ca-from: {{ .Namespace }}/redsky-serving-cert
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: experiments.redskyops.dev
+  annotations:
+    cert-manager.io/inject-ca-from: {{ .Namespace }}/redsky-serving-cert
+spec:
+  conversion:
+    webhookClientConfig:
+      service:
+        namespace: {{ .Namespace }}
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: trials.redskyops.dev
+  annotations:
+    cert-manager.io/inject-ca-from: {{ .Namespace }}/redsky-serving-cert
+spec:
+  conversion:
+    webhookClientConfig:
+      service:
+        namespace: {{ .Namespace }}`
+
+		t := template.Must(template.New("namespacePatch").Parse(namespacePatch))
+
+		var b bytes.Buffer
+		if err := t.Execute(&b, struct{ Default, Namespace string }{Default: defaultNamespace, Namespace: n}); err != nil {
+			return err
+		}
+
+		if err := k.fs.WriteFile(filepath.Join(k.Base, "namespace_patch.yaml"), b.Bytes()); err != nil {
+			return err
+		}
+
+		k.kustomize.PatchesStrategicMerge = append(k.kustomize.PatchesStrategicMerge, "namespace_patch.yaml")
+
 		return nil
 	}
 }
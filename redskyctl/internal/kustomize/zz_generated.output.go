@@ -18,4 +18,4 @@ limitations under the License.
 package kustomize
 
 // The below is a gzipped encoded yaml
-var kustomizeBase = Asset{data: []byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xfd]s㶲(\f\xdf\xe7W\xb0\xf6\xbd\xf3<k\xefU\xabN\xe5n\x96=\x93='3\x19\x1f{&\xb9x뽀Ȗ\x84c\x8a`\x00P\xb6s\xea\xfc\xf7\xa7H\x8a\x92l\x8b$\xba\x01\x92\xa2\xd5}\x95x\x84\x0f\x02ݍ\xfen\x91\xcb?@\x1b\xa9\xb2_\xa2\xed?~z\x90Y\xf2K\xf4\xbb\u0600\xc9E\f?m\xc0\x8aDX\xf1\xcbOQ\x94\x8a\x05\xa4\xa6\xfc\xaf(\x12y\xfe\xf3C\xb1\x00\x9d\x81\x05\xf3\xb3T\xffO&6\xf0K\xa4!1\x0f\xcf*7կb\x95Y\xadҫ<\x15\x19\xfc\xd2\xfco\n\xfaj#2\xb1\x02\xfdS\x14\x1d\x8f\xbb2\xcf\xc6\xc2槫\xab\xab\x9f\x8e7&r\tO\x16\xb2\xf2\xff\xcc\xcf\x0f\xff\xa3Zp\xfb\x8f\x05X\xd1l\xf9\xba0Vm\xee\xc0\xa8B\xc7p\x03K\x99I+U\xf6\xe2\vD\x96)+\xca?\xef>\xe3hG+Ȫ/Z\x142M@W+\xec\x0f\xe6\xff\xfd\xf9?\u007f\xfeϟ\xa2(\xd6P\r\xff.7`\xac\xd8\xe4\xbfDY\x91\xa6\x84é\xff\x02O9h\xb9\x81̚\x9f\xf7\xff\xfas\x02۟L\x0eq\xb5\xe5$\xa9\xbeC\xa4\xb7Zf\x16\xf4\xb5J\x8bM\xbd\xff\xab\xe8\u007f\xde\u007f\xfb\xfdV\xd8\xf5/\xd1\xcf\xc6\n[\x98\x9f\xf3\xb50P\xed\"\x01\x13k\x99\xdb\xea\v>\xee\x17\x8a\xea\x1fV?\xa97q\u007f\xf8\x83}\xce\xe1\x97\xc8X-\xb3\xd5OQ\xb4Ҫȏ\xf6]\xed\xac\x1e\xb6\xfb\xd4\xfa\xf0\x0f\xb3W\u007fL\xa5\xb1\xbf\xbd\xfa\x87/\xd2\xd4\xff\x98\xa7\x85\x16\xe9\x8bO\xaf\xfend\xb6*R\xa1\x8f\xff\xe5\xa7(2\xb1*\xb7\xf4\x1f\xffQ\xfew\xb1л\v6\xbfD\xff\xe7\xff\xfe\x14E\x87+\xfa\x87H\xf3\xb5\xf8\xc7\xe1o\xbb#\xaa\xbf\xf1\xe8\x9f\xcb9װ\x11\xf5\aD\x91\xca!\xfbp\xfb\xf9\x8f\xff\xba\u007f\xf1\xe7(ʵ\xcaA[\xd9|k\rGxy\xf4\xd77G\xd7@u@.?<\xc6\xd3\xd7?V\x8b\xff\r\xb1=\xfa\x87\x06;\x0epz\xb3;\x1c7V\v\x99\xd97\xff\x14E\xd2\xc2\xe6ğ\xbb櫡:֓\xff\xd2\xfa\x85\aP:\x01\xdd6\xbao\xe5\x12R\xf5\b\xfaVh\xb1\x01\xdb>\x93\xd3^J(\xf2<\xdct\x1a\xfe*\xa4\x86\xa4m\x9a\xabW\xbbo\xfd\xd9\xcb]u\x9e\xf5\x1b\x049\x80)6>'\xbdP\xc5k\x04>\xb5\x81\x9e\x03\x96\xe6G\xf91\xffv\x9bl\xa1T\n\"k\xfd]ޜIǾ;P\xfbh\"\x87\x03\xa8\xa1\v\xdd_o\xbf\xe7,jx\x04\xb9ZۀS\xf6\xa1]\r5?\xec\xf9I\xbd\xb7\xce\x1f\xf5\xa2\xdd\xf1τ\xd6\xe2\x99L-\x15\x06\xb6\xfe\xeb\x01\x17h\x04\xd2\xf9\xcf\xed\xdb߀\xd52\x0e\xc9PAk\xa5\xffW\x01\xfa\x99\xccV72\x93\x1b\xf9w\x0f_\xee\xa2./\xae\x9e\x97\x82\by\xb0ҭ\xd4 \xb2\xe7o\xcbv\x04q \x91\xe6G\xa5\x04\xb5j\xe1\xa5\u007fy\x9d}%S\xd0\xcf\xce@\n\xb1U^\x8f\xe2F\xd8x\xfd\xf1)\xd7`\xccA\xc4=\rA\x99\xe3\x03\xb4\x9e\xdb\x01\x10\xbc\xb1\\Wt\x1c\x06iҭH\x8b\xfeOq:\x19\xf4\xda.\x8c\xb0\x06W.\xfe\x00\xdd\xf3\\\xed\x0fq\x1c6\xbeþ/GZ\xd0i8\xd6g\xdc\xf0\xcb\xf1\x9c\x1d>\xa5\xf7'\xd5\x0fh$\xdcuq\xad\x8f\xeeU\xcdt\xc2=KY\xa3\xb8߷\xf2\x93n\xb2v\xe3!\x9d4\xe2\xc27z8\x86ӕ\xbbp\t\xa7\x89\xfa9\x83\x03O@a\xa9\x9f@\xd4N\xfb\xbdT\xefF\x01\xad\xfb\xeb\xa5q\fu\xf7\x9e\x98\x03\r\x9c\xfc\xc7=\x11|\x87M\x9e\n{\x82\xa4{\x88\xe0\xa4*\ued2dS\xaa\xb9ۢ%,e&R\xf9w\xa7bӋ\x8bN\x98؇\x87ԳW\xb9-E\xd0\xcaHv.ֆ\x8a\xbe\xc7e\xebՒ\xe1\xd8z\x97\xc2K>Ӎxj\xc5C\xa57\xc2V\xe2\xf2\xbf\xfe\xd9yp]\x02\xf5F\x9e@\x81\x90\vx`\x05\xe9^ɷg\xe3\xf5\xa9[ _]5#\x99\x1e4\x88Df`̯¶\xf3\xa2\x1e>\xe3\xa6\x1d\xc4*\xab\x1f\x84\xef\x1d\x92\x95\xe3\xb6#'\xf1\xf8\xea\xe5\x9a=\f\xd0A\\\xec\xe0\x91B\xaf\xc0\xdeA\xabj\xearFm\xe6\xe4S[\xe99\x9c\xa5\x844\xb9\xedP\u009dgzk\xb4&L\xd2g2s\x9e\xa4z˽gj<\a\xa1N\xbb\x90\xbeG4\x9d:R\xf1\x8fp\fNC\x9e\xcaX\x9c\xc0\xf2#>\xff_\xff\xd92\xe3i\x0e\xdfn\x10a\x05\xe65\xb0\x02㺿y(0vnz\x8b\xc8s\xad\x9e\xe4FX\xb8+2+\xbb\x18\xbf\x03\x9e\tc\xe4*\xab\\\xc3\x1ez\x90\xab\xfd\xd2ŵ\xe3l\xeb\xeb\xd45\x1ap\x91~_\xae\xdc%\x05\xd7\xe0b8\xecu\xfe\xb4+./\xb7\xd3c0\xec\xe7\x11\a\x17\u007f\x87\xf8\xe4z\x85n\"\x94\xf3%:\x89Qγ\xf5\x89R\xce\x13\xf5\xa3)b\xa2^\x91\xcay6\x84X\xe5<g\x8fh\x852qt\xa2j\x15#$\xd2\x1bH\xc5\xf3=\x94\x8aC\a\xa2u\xcb2/W\xed\xa6\xd7J\xfc\xfaV=t\xdd~\x9a`<N\xd8\xf2M\xb1\xe6\x0e6Bf2[\xb91\xbc~\xb6\x13E\xed\x0f\xce\x01\x9a\xa3[<\xdbn\xdf3\x82\xd3V\x87دV\"f\xec\xd5\xe7\xf6K;{\xc6ܙ\x13r\xaf\x91;\xa3\"\xcc\xdcϴ\b\x93\xba\x85P\x10&u`f\x84\x99Q\x8c\x8d0\u007f/\x93C\xcf\xe9\xe8\xccs\x93\x14J\xb2\xee\xfc\xc1\x9e\xfe:\u007f\xb5\xa7\xa9\xe1e\x8a\xbdY\xebz\r\xf1\xc3YrU\xb7'\xa4\x06w\x0e\xfc\xc2\xe0\xd5˒\x1c\xfd\xdbh\xbc\xeb\xf7m\xa3^\xdb\x1a\x869\xb0T\x18[!\xc9\xf7NM\xe5\xe5\x16\x12a\xe1\xaaTm\x82=`\xa0\xa5J&=\x88\xbe\xa8\x93\xfdV\x11\x0f\x1e&\n\xa5\x01\xe7\x98\v\xdcVjp\x8aNi\x00\xc5\xc2kp\x8fV\xf1X\xc45z\xa5\x01ĉ\x92\xf7\xe4N\xf9\r\xb8E\xb74\xd0\x1f\xe5r\xf8\xa5S\xb4K\x03\x8e\x0f\xe5˟\xbb}\xa5c\x14L\x03\xf8h\x98\x97\x9br\xbe/\xc4';\xff\x94\xe5\xe5\x06X^\xbexyy\nA\xb7\xd3\u007f\x1bR\xceu\xa6I\xe7ӟ\x8b̺\x142-4|_k0k\x95\xf6\xa2\xe00r\xda\xd9H\xce.\x8c\xce\xf9\x16\x82\x1a\xdeY\x9a\xde\x03K\xd3}\xc0\xd2t\xf7/Y\x9av\xf9yHi:\x90h\xd0ς\xdc(\x1e\xc7v\x9c\xc8\x03\xc3j\x1c\x99\f\xea\xd60\x8c\x055\xb1;3A\xb0\x11\x12B\xba\x10\x95+\xd3pa\x17Ό\xc2]\xa5t\xfa\x0eg\xb6@a\b\xee\xee\"w\x92\xed\xfc\x91\x01[\xe47\xb0\x14Ej\xaf\xd3\xc2X\xd0w*\xf5\x8b\xa08\x9e\xf3\xaeH\xc7\xd2\x12~ժ\xc8\xcfX\x9a\xcfT\xd6T\x88\xf8q\xf7\xe5\x8c7\xda(ӿ\x1f*-\x9c\xf36\xcfx\x8b[Ћ\xb3ݞ\x9bi\xa1\xfa\x841d\a[\xe4\xf7\xa0\xb72\x86\x0fq\xac\x8a\xcc\xfeީ\xa1\xb9\xf2\xa1\xef\u008c\xe2\x8e[C\xba\xb9^\x8b\xf6<b\xc4\xc6_\xcd\x18\xda\xfcQN\xfc\x87\x93\xdc\xe0\x88\x9a8En\xa9t\f\xf7\xd561\xe2H_]\x86\x03\xb8\xda.т\x8eS|]\r\x9dY\xe3/\xc1)\x87\xfc\xf4\x10\x17\xc3@\r\xd5\xd6?i\xd5Z\x8e\xe3%\xe0U\xf3}\x96\x90\x83I\xdeg\x9d\bu\xc1\r\x90Tb\x9c\x8a\x1b\xb9\x15\xb8x\xbb+g\xe5\x159\xc0}\xfb\x8e\xdbF+\x9e\xfd\x0f\xe4\x81\x15\xb9\xe0\xe6 \xec(V\xd9R\xae\xbe\x8a|(\xca\xc0a+\x1aSQ81\xc0\x15ʍX\x85\xb3\xa4\x065˚\a\x99_k8\x19\xcc~jJ\x97'\xa6\x9c\xf3\x06R\b9\xe7V\xa5\xc5\x06\xbe\x96B\xcf4O\xf2\xa6\\\xda\xd59\x89\xc6\xd1zv\xadr\xb1jI\xcd\r\xb0\xc8`\x8f\xbe\x06\x91|\xcbRG\xc33VV1\xc5b\xb0s\xdf\xcd\xfd\xf1)w4h#]\xae\xee\xcf\xcb\x1e\xbf\xce\xf6)\n\x92\xc1\x10R\x17\xfa\xa3\xe2\t\xa3\x98N\x1e\xcd\xc7T\x18+\xe3\u007f\xa7*~\xb8\xb7J\xf7R\x12\x86\xbd,\x8dK\xa0t\x84ſ\\h+]\xd9\t\xc6\xe7\x16\xa1\xfcn\x11\x92E\xe0\x18D\xfd2|\xbe\t|z\xeev\xd8f\x03\xa1l\xac\xe2\xefBÍ4\x0f!1,\x16\xf1Zf\xab\xaf*\t\x8ff\x894\x0fݖ\b\x8f\x89\u007f\xdc}\x0e\x1fh4\f\xb9\r\x12e4\x14\xe1\xb8\xe3ws\xbdN?\xfcq\xf79(\x1d|\x92]\x96\xf6\x1a0t0\x1c\x1f2\x10k\xe8\xb1Ƚ\x9e\xdb\x11\a\xccZh\x18`fw\x1c8|\\\xffO\x9b͆\u0083\x18\xf2\xf52\xa8\xfbr\xa32iUwu\xd2\x06\xce\xc1'\xd8]\xba\x91\xbc\x85\xa1i\xc1\x85vћ\xae\xa7v\xb4\xa0a-\x11\x18;\xc4p!\tQT\x98\xee*Ǆ=\xb8\xd3zC\x1d\xc1\xe8Wf\x1dդ\x1b8\x03qyhzx\xe7H\xfb\xee\x04qg\xb3'\xe6ƒ\xda\xe9\xef*\x88\x0f\xab\x959?n\xb3\x0eY\xdc8\x9eu\r\xd8\x13?ޖ\xbb\xb3)B<\xea\xc7+ >|\xb8\xf0\xc3\xdc\xc5L\x15\r\x1bz8H\xf6\x85\xca\xebh\xa4\xd0/\x80;\xcb12(\xb3\xd1r\x1b\\\x92\x18\xec\xfd\xcdT\x02\xb7\xc5\"\x95f}\u007f\t\x0f\xe6\xd06\xb1\x0f\xd6j\xb9(:sP\x0ep\x8e\xc1\xb9\b#E\x85\xe9\xa1\xc80Q\x8f٣\xd0ɇ\xdb^\xe3\x13\xbf\xfdG\x80\u007f\xfb\xab\x9c\xbf\x11\xe2!pi\x8b\a EE S\x19=W\xa3\xc4`\xec\xf7\x87\x14?\x10\x81\x18\x17+w\x1d\xc2.?\x8d\x84ܱʬ\x90\x19hW\x1b\xe1\x01\x88\xf8\x9dȭ4\x98$ \x8f\xb5\x9a\xd3<SRj\xb67\x1c%a\x05\xf9\xe9\xc5s\xe7\x89a\x93\xdb\xe7\x1b\x196%\x0f\x12\xd9\xdeS\xeb\xed6]\r\x8f\xf2o\xf8\"7ҡ'\x14!\x12\xd9ᬖ-\xe5\x1f\x0fp\x066\xb4\xb4x\xd7\xce\xe6:\x8b\xfc\xcf?\u007f\x9f\x91\xef\xe0\xf1Q\xf6gܞ\xcbvݩ!\x85\xa7:\x00\xe4r\x15\xe6\xda\\1[\xed\x8e-\xeem??k\xe5x\x99\xaa\xf8!\xacC'\x11V\x98\x81\x9c绹\u007f\xfc\b\xee\x99p>\xb1U\f\xb7\xa5\xf2i,d6td\xcf\xfb\x8f\x1d˓A\x10c\xfaȚ\xfaÂa\x99\xb4w\x90\xab\xa0t)u\x95\xae\x1e>\xdb[C\xae\x8c\x1ch\xea\xad\x1c\xa0\u0091\xfb\xb5\x1e\xbe-\xd8\xd5\xd6i\xc7a\xa3` Kru\xba\xebt\xdbV\x9dyǌ\xa2U\xdc\xefu\u007f`\xfd\x84ݯ\x81;\xdf\xfdZ\x19\xa7\xbc\x03\xcc\xd5\x0frA6\xfc3\x84`\xa5\x01O\\\x9a\xc0n\xb8x-\xf2\x0f\x85]\xdfH\x13\xabmG\x17۷\xdbu\x95w\x9b%\xee\xeb\x82 \xe1\x17\x18H̨+gY\xe5l\xba\xc4\xcd\xfeW\xf8:w\x15v|\xceJv<D\x99\xbe\xb30\xa0\xe4J[\xe1V\xd3\xe7\x1c\x8c\x06\xacH\xb6\xfd\xdc5\xe7\xb12k\xddV\xb7>\x19\v\x97\u007f\xf5\xdd\xc6UI\x1d\xbd\xbf9\xfe\x98POBМ\xcb,\xac\x1c7+i\xab$(\x1d\xde\xc4\x16TP\xb8\xda\xed1\x14\xf2\xe4{ݿ6X^\xa7B\xf6\xfa'P\xf2E9\xe1;Ց\xf7\xdf\x16\xec6\xd6ʪl~\xf6\x98d\xc2\xc8\xdar\xf1`篴}Tz\x00\xe3\xfd\xec\xc2\xc2\xdf]\xc4t\xaeU\xf9\x9b\xfe\xa5g\x1b5\xe5XO+\x1a:n\nY\x92\x83\xb6HD)\xadJ\x1aB\xdd]\r\xa8\x00\xf2\x03\x10\xe3Qj\xc0\x057\x1d\x80\x16\xe6t\x00J\xc0\xd3\x01p\xa1O\xafW%\x1d\x15>\xa6\xa6\x06\xf7\x80\xf4\xe31α/\a D\xb6\x1d\x0ft\xaf\a\\\xc3H\x95\x99p\xc1\xec\xaf\x17s\xaf\xceq<\nu\x86\x88\x18\xdb\x03\xbc_\x1e\x86\x0f\x84\r\xb3n\xe4\x11\x1c{\x00/V\x1a\xd1\x03f\x83\xed\x80ʥ\"b8\xed\x01\xc8\xec'\xe2W\b\x03\xf4\xa0\xdc\x03\xf8\x12\x9aG\xa0\xee\x01\xbci\x8d\x14\xbc\x1bp}Z@o\xb0\r\xf8\x91::\xdc\xf7\x00\x1e\x94N\x17\xa2\xce] \",V\x9b\xe4Yh`\xc5\xc7\x1dX\xf1y\v\xac\xf8\xbc]l\x04\xc5Ǽ(\xe1\xfd]=\x00B\xec&f\xbf\x15\x89\x84l\x94\x14\x9a(\x82\xa7\\֝\xd3\x1d\xbb=\xbd\x84#>\xf1\xaf\u007f\x12\xf6\x8b\xe7\x10x\xde0Zr\x11\x8a\xaaѸ8X\xfe\x0f\xa2\xb0TmO\re\x80\xfe\xabP\x8b\xe7\xfe\xba\xb3\x18*ZiU8YY\xcfĩ\xa0a%\x8d\x1d \xae\xd2B&\xb2\xd0\xd9O\x83\xd4:j<+\x13F\x80\xd6w\xe0\xe8\x80\t\x85\xfdz\x11\xd4\xf12TȗK5l\xf4\xac\x0f\xf0\xec\xda.\x015\xef\fk\xc5)\x15:\x88\x87\x03\xac\xda~>\x87\x82n\x15\xc1\xf5\xfe*t\xd97\x13\x8b\x14>\u007f\x9b\x01CZ\t\v\x8f\"\xfc\x83\x99ke!.\x05\xe1\x1b\xb5\x112|(*\x13\xe5\xe9\x9f;\x12\xa51\xe9\xc7L,R7\x91\x1cy\x80Vi\xb1\x82A\xea-\xef\xe6\xbe\x1d\x82ϛgc!|\xf2|-\xe7LZ\xc2vG\xe5\xfd\x1aI\x83\xfa\xfd\xbf\xac\xce*\x18\xbft\xb2j\xce6p\xe6<\xca\rq\xa9A\xf7\x15\xb8\xd4\xe0\x0e\x86\xaa\n8`\xcdpw\xaeS\xbf%*hD\xfa\xec\x021/B\xdc\x19\xec\r>\x9e\xda\xe4ᓢ\x9c\xbfrk\xf25h\x98Md\xf1^\x90Ke\xfc\x1c<\f\xf8\xd5\xfc\x03^\xbck\xdc\u03801\xcc\x0e\xc17\x8ehtVm\x8e\xac\xd0\xf6\xbb\xdc\xc0\xb7\xe5\xd2t\t\x87\x0e'ka\x93\xa7\x9d}\xde\x1c[σ\x15\x89\xb0\xa2\xfbf\x1c\x0f\xdb\xe4\xd0S\xd5ʝLEl\xe5\x16n@$\xa9\xcc\xc0\xd9\xf7\x84\xf37a\x84\xaf\x85\x88\x1f\xd4r\xe9X4\f'\fb\xf6\x11\xabM\x9e\x82c\xa9\xa0ᶱ\x11Y!\xd2{H\xab\x92\x12\xfd;\xc1<\xe1\xb9\xd0\"M!\x95\xc6Aw\x1d\xee\x13\x8d\xf3\xc7!\x9b\x0f\n\x1bWM\xea\xea\xecnG\xc1\x03\x15\x8eBq,#\xc3OH^\xd3rW\xc2\xe9H=\x17\xda:\xb5{>\x06B\xb8\x0fѥ\x8e\x0f\xb8\xc0:\x9b1A'W\xfbKAI\xb5hǴ\xeb\xf7V\xd4\xf1E,\xc0-cݧ\x18\x1a\xe1\x06q\x89\xd8\xee?\xee\u007f\xcf\x1b@\xf2\x1a\xa7\xf7\x9d\xb0\xe1\xc8齧m:\xa2\xbf\xff\r\xd0\xe2N(\xc6\x18\xb1\\\xcaLZ\x04\xef$\xf6\x00W\t|@\xafE_\xaf\x1e\tK\xd0\x1a\x92\x9b\xa2\xa4\x90\xfbx\rI\x91\xcal\xf5y\x95\xa9\xfd\x9f?>A\\\xb8\xf7\xb9=\x06R\x8ce\x14 v\xbb\xfe2|\x14U\xb8\x1dDdI\xe04\x90\x8f\xf2\x00!>\xa9\x06b(\xebK\xf0\x0eM?\x00E\xee\x18|Sx\x19\xe54\x04\xb8\xf9\x1a\x02~\x1b5\xac\xf4-\xf8\x04\xf8\x1f\x80\x12\x86\xfbz\x06\xa4\x9ct\x1a\xbc\x12\x84^O\xe3w\xba\x15\xf7\xa9\x12h\x98\xf1\xbc\x00f<n\xc0\x8c\xa7\x1f\x98\xf1\x04\xdf\xc8#\xc8\xd5\x1a\x911\xf4\x12|3W\"\xef\xec\x15ߤ\xb5\x83\xecJ\x1a^\x1f\x1fa\xa8\xd7\xc5\xd1\xf1\xa69\xac\xa1t\x10\xbfǧT\xcb\x1a+\xe8w\xd0T~\xe8\xc5JY\x15\xe9\x00\x96\b\x1c\x80%\x02\x17`\x89\xa0}\x1aVE^\x033\x1e\a`\xc6\xe3\x02\xccxڧ\x99T\x15\xf1\xd9\x02\xfdR\xaf\xdeJ\x9dDy\x9c\x9c.\x8e\x1e\x98\xab\x84\xbd\x17\x87\x9dyz/\x0e\x87Y\xde\xfe\x94zC*\x16\x80\b\x03\x19v3Q`E&\n\xf7\xb2\x84\x93+\xa2P\xb2E\x14\xfa\xb9\v)c\f\xb0\xb9P\xb2F\x14Rވ\xc2\u007fg8\xb9#\n&{DA\xe4\x8f(\x9c\f\x12\x85\x93C\xa2\x80'\x8e\x8e~i\a\x9f\xb8\x98v\b\x88\xabA\xc5@\xcfi\xb2&\xda}j\xfd3\xd0\xf9\x86\xc0G\xabr\x95\xaa\xd5\xf3o~\x8fM\x80/\xf2\xe5AW\xc7\xdf\xc2n\x8b\x89\xdc\x16/\x85V\xf6]\xccY\x03\t\"\xf6\xb3ߢ\x03\xd8|\xe8\x00l>t\x016\x1f\xb6O\x13\xc0o\xe1/\xae\x87\x17Ճ\x8a\x91^G\xed=\x85\xbfh\xeeI\xdd\x01\xce\xd2\x17\xd7\x02\x88\xe2\x9e_\xe1'\xfb\xf9\x89ߓ\bo\x1ev\xee\xccJ\xb6u\x1fvƶ\xee!6\x13\xb1\xad\x1b\tl\xeb\xa6\x01ۺ\xf1\xc0\xb6\xee~`[\xf74Ӱ\xad\xfb\xc4\x1cl\xeb>\x02\xb6u\xb3\xad\x9bm\xdd\r\xb0\xad\xfb4\xb0\xad{\xd4M\xb1\xad\xdb\x05\xd8\xd6\xdd>\rۺ\xfb\xa7a[7ۺ\xd9\xd6=\xd0 QX\xb5QEf\xef}\xbaf\xe1\xaa\xc1ְ\xefՉ\xa0\r4)Q\x05\"\xa1Wh\x92%ҹ\aeP\x91+V\x9b\x8d\xc8\xd0\xc44\x9f\x0f\x84l;\xd2\xc7y\xa6*\x93\xdb\xd4z\xf2\xd3Jr\x9dn\xe5OZ\x11\xddF\xfe\x1aN\xac\xb2\xa5\\}\x15\xf9o\xf0LlS\x1cj+Q\bE+\x90\xacEG\xc5\xc0\x1b\xa1u\x97<\xbd\x19l\xb7\xc9\xd7௺\xf8\xa9-\x01\x04`\x9f\xbe\xf75\x84\xc1s\xff\x1e\xf85\x04\xc22\xef~\xf8\x01w\x13\x02\xcb|:\xe4\xd7\x10\x00\xd7B\xb4\x80\xaf!\f\xce\x05i\a_C \xb4\xf3l\r\x1ft/\xbem\xe2\x03n&\x04\x05x4\x8e\xaf!\x00\x01\xd4}.X\xb08\x06\x16,N\xc0\xec\x05\v\xaf\t\xbc\xf2\xec\xbb\x1bC\x9c\x06\x8f\xddz\xa8\xa0\x14-g\x125t\xaf\x12\x91ٖ?\xcb\xf2c\x13\x01X\x84?{\xf0g\r\x9ed\x99kXʧI\xd4zT\x87\xa7\xb7\xc0\xf83=\xfeL\xc0$\x9d[U\xbf]\x8cpW\xd5j\xb7E\x9a\xd6\r\x9bF[7\x95K\x88\x9f\xe3\x14\xfd\xa5>T\x91+c\xef\xadФ\x90\x1f_r\x84'\xd76\x04\xe1\u05ce\xe8\x06\xeecxO\xc1t\xde\xd2\xde\xda\xda\xfc\xd7\xfe\xe6\xa9m\x10\xe2J\xd7ʐ\u05cfB]Fy\x0e\xff\r\"A9\xad\xde\xc2YE\xfa\xf8\xebg\xd1\x00Q5g\xb4\xa3P\xf1+$\xcd\xe5\xe5\x14\xd5\xd1\x04\xe0Ig\x11\xbd\x82\xeb\x99۶\a\xcf\v\xce\x15\xed\x85l@d\xcf\xdf<L=UtB\x18D\xbd\xf2\x0e\xb0\xad\xc1\xc4k\xf0\xe3\bg\x11r]^\xecTϥ\x8d\xf3{\x15?\xf0\x83\xc9\xe4\xf5\n\xe6\x8c\xd7^\xc3s\r\xf7V嬌\x90\x80\x95\x91#`ed\xb7\tVFZ\x80\x95\x91\x1e`e\xe45\xb02\x12\x9d\x9f\xb4\xc4\xca\b+#50y\x9d\x9c\x88\x95\x11\xb2\xb3\x8a04\x95[\xc8\xc0\x98[\xad\x16\xa3\xfar\xa8\x1a\x8c/\xe5zj.sO|\xf2Bѥ\x90i\xa1\xe1\xfbZ\x83Y\xab\x94t\x88\xbeY\xf7~L\xc2C\xcf\xf2E<\x9f\xe7\xc2\x1bm\x02\xe8U\x9e\x98\x1fF\x9f:\x9b\xc0\xbb\x00:\xd4\xd9D\x99z\xe9M\xbe:S\xb0|X\xba\xae\xe4\xa3'y_\xa2\x8f\x00\xe7%\xbc\x05\x11\xdcB\bm~\xfaФI\xb8DY\xd1\v\xe7e&\xad\x14\xe9\r\xa4\xe2\xf9\x1eb\x95њ\xd9M\xfb\x0e砥Jf\xbb}S\xc41\x183cA\xc8K\x83\x9e\xb5(t\xe9\fw~\x1c\xcf\xca\r\xa8\xc2Δ[\x90?\x9d\"\xeb\x921\xac\xbcױ\xaa\x03x\a\xf6\xd7\tp\xb7dB\xf6\xaf\xfc\xe6K\x84%\a\xfc|;IT{\xb9\xf4\x9c\x8fn\xb2\xfa\x02\xb9VVŊ\x18\fﵸOr\xd1\vz\xc1\xf3\xde\xf1\x03\xe85\x88D\xb2M\xd2\x1d\xd8&\xc96\xc9\x19\n\xe2l\x93l\x80m\x92\xaf\x80m\x92l\x93d\x9b\xe4|4t\xb6IF\x93o\x9fm\x92\xf3\x15\x85.\x9d\xe1Ώ\xe3]\xa8M\xb2)HD\xe8\x1cF'\xcfTn$\xde8\x19\x05\xad\x02\xedI&ޥe\xc0\\\xee\t\x90\x87\x1a\x88\v-\xed\xf3\xb5\xca,<\xa19\xac\x0fΊ4U\x8f\xb7Zne\n+\xf8hb\x91\nZC\n\xdf\x1a\x1a\xb1\xc8\xc5B\xa6\x92z\xf3\xbe\x0f\xabH.ְ\x15E\x89\xa6\xe5\x87E\xef\xe0\xeb=s\xebv\xa4CB\x1e?\x8aɵ\x8a\xbf\xaa\"#\x89d\x9ev~\x91|\xcb\xd2\xe7;\xa5\xec'\x99\x82y6\x16HU\x85\xfdN@\x17\xd9\a\xf3\xabV\x05\ty\x8f$\x9b\u007f\xfdst-\xa2\xda\xfb\xef*+\xcfp\xa2\x93\xfba\x80T\x0esڃ3\xf0Ef\xc5ӷ\xaa\xe6\xd34-\x90`\v\xe4ZS\xde\xfcN+|5\xa3`\x8bW\x13L\xb5xA\xc4\xd7\x00\x8b{=\x12\x8f2Kԣ\x99\x10cW\x1b#\xae5$\x90Y)\xd2\xfb\x9c\x9e\x95\xed}\x89o\xb7\xe2S!؟\x9a\x1aF8\xe1.&\xd19\xacж\xc8\xd9w\xed\x06\x17-\xe4\xb2\xefz\xae\x06[\xf6]7\xc0\xbe\xebW\xc0\xbek\xf6]\xb3\xefz>\x9e\x1c\xf6]G\x93o\x9f}\xd7\xf3\x15\x85.\x9d\xe1Ώ\xe3]\xa8\xef\xda\xd8D\xa2\xfdotkp\xb5ܷ\fߋ\x88\xbe\xa4\x05\xbd\x91Y\xe5g\xfc\nƈ\x15P\xba\x81\x91\xa9\xea\xc4\xf2\xe3\x16\xa5\xb7\x96\xb8\x16尷*-6p\x03[I\b\x87\x98$s*\xa9\xf6Jo\x10\xe7\xc9n'J\xdc\xf1ɝ9\x9c\x18a\xf0\\\xba\xfaԈ\\9<\xe7\x81\xc7Uo\xe7\xc9и^]\xab\\\xac\xc8\x11\x1ds\xa5\xa5\x83\x87\xdagqz7\x18S,&\xbb\xf7\xdd\xda\x1f\x9fr\xa2\xafl2.\xb6\xa7\x97w\xcc\xc4\x1e\x95~\x90\xd9\xeaF\xa2/\x87x-\x94\vA\x1f'\xe9 \xf1G\x98d\xe6\xbaj\x97\xe6\xfe14\x0e^~\xbe\x01\xbdE\x9b\xcdI\xef\f]\x8e$!\xa1\xa2\xb8\xa1I\x1f\xe6\xf3|R_\x0e/\xeeE\xb6\xee{\xacJfB\xb4\xdb7 t\xbc\xc6\xde\xc8\xf9\xe35\xe1\x18\x93\xcc`\x15?\xc2'A&\x16)܃.E\xf4/2{@\x9c#E\x0e\x81|\r\x1b\xd0\"\xbdn\x12\xea\x11\v\xa2\xef\x99J\xe2B\xaf\xc6\x12\xe2\xbdi\x13\xff\xca\x13\x83\r\xe6\xf3\x81\x90mg\xa1\x82M\xa6\u007fx\xf8\x89C\xacLi\u007f[\x83\xbf\xbf~\xdfі{q\x1f\xc3ل0p/\xee\x03\x04\x88BXJH\x933\xc0s\x91\xcb?@\x1b\xb2\xa5\xa7\x81@XV\x1d\v\xdd\b\x12t7!\xb0l\xff=S\xe2Z\x93\xd6\xf8\xe9Lpn_\xb3\xc9'x\xb6\x86@h\x97ȭ4\x8a\x1c%\x1et/\xcdm\x9d\xc1fBP@\xf39S\x12@\xdd\xea\x9c\x05\x8bc`\xc1\xe2\x04\xcc^\xb0\xf0\xceĦZ\xe1\xe7bI\x87lK\xd1r\xa6\xaa\x05Z\xabDd\xb6\xe5ϲ\xfc\xd8D\x00\x16\xe1\xcf\x1e\xfcY\x83'Y\xe6\x1a\x96\xf2i\x1a\xb7^\xf5\xf21\xfe\xcc\x18\u007f&`\x92r#V\xe3\x15g\xaeV\xbb-\xd2t\xe4x\xaaT.!~\x8e\xf1i\xb1>T\x91+cﭠ\x05\x95r_]\xee\xab{\f\xdcWw\xb7\t\xee\xab\xdb\x02\x81p=⾺]Sp_\xdd7{\xe0Ɵ\xaf&⾺\xdcW7:\x97\a\x93\xc9\xeb%\xcc\x19\xaf=\v\x91\xc1\xbd\xa5\x15qce\x84\x95\x91c`ed\xb7\tVFZ\x80\x95\x91\x1e`e\xe45\xb02\x12\x9d\x9f\xb4\xc4\xca\b+#50y\x9d\x9c\x88\x95\x911k\x12\xa6r\v\xdcP\xcf\x1d\xb8(!\x17%\xa4]?\x17%䢄\xe1w\xc2E\t\xb9(!m\xf0\x99\xd4\xc8⢄H\u0884\xd1\xe4\xdb碄\xf3\x15\x85.\x9d\xe1Ώ\xe3]hQB\x8a\xacKư\xf2^\xe7Q\xe2k\x9f\x00wK&d_t\xf0e\xbe5\a\xfc|;IT{\xb9\xf4\x9c\x8fn\xb2\xfa\x02\xb9VVŊ\x18\f?Y\x89\xaf\x17\xf4\x82\xe7\xbd\xe3\a\xd0k\x10\x89d\x9b\xa4;\xb0M\x92m\x923\x14\xc4\xd9&\xd9\x00\xdb$_\x01\xdb$\xd9&\xc96\xc9\xf9h\xe8l\x93\x8c&\xdf>\xdb$\xe7+\n]:Ý\x1fǻP\x9bdS\x90hԺĩ\xdcH\xbcq\xb2\x04\x91$\xb2\xae`p\xeb\xc9\x1e<\xc9Ļ\xb4\f\x98\xcb=\x01\xf2P\x03q\xa1\xa5}\xbeV\x99\x85'4\x87\xf5\xc1Y\x91\xa6\xea\xf1V˭La\x05\x1fM,RrK\b\xbf\x1a\x1a\xb1\xc8\xc5B\xa6\x92z\xf3\xbe\x0f\xabH.ְ\x15E\x89\xa6\xe5\x87E\xef\xe0\xeb=s\xebv\xa4CB\x1e?\x8aɵ\x8a\xab\x9e7\xf4\xa5\xc9v\xfe\xba\x85ʝR\xf6\x93L\xc1<\x1b\v\xa4\xaa\xc2~'Puh\xffU\xab\x82\x84\xbcG\x92Ϳ\xfe9\xba\x16Q\xed\xfdw\x95\x95g8\xd1\xc9\xfd0@*\x879\xed\xc1\x19\xf8\"\xb3\xe2\xe9\x1b\xa5WE\r\xbeOE\n[ ך\xf2\xe6wZ\xe1\xab\x19\x05[\xbc\x9a`\xaa\xc5\v\"\xbe\x06X\xdc\xeb\x91x\x94Y\xa2\x1ë́\x18\xbb\xda\x18q\xad!\x81\xccJ\x91\xde\xe7\xf4\xacl\xefK|\xbb\x15\x9f\n\xc1\xfe\xd4\xd40\xc2\tw1\x89\xcea\x85\xb6Eξk7\xb8h!\x97}\xd7s5ز\xef\xba\x01\xf6]\xbf\x02\xf6]\xb3\xef\x9a}\xd7\xf3\xf1\xe4\xb0\xef:\x9a|\xfb컞\xaf(t\xe9\fw~\x1c\xefB}\xd7\xc6&\x12\xed\u007f\xa3[\x83\xab\xe5\xbee\xf8^D\xf4%\xad\xd0+\xb0\xd7>\x1d\xa2\xc8$eAodV\xf98\xbf\x821b\x05\x94Nd!\x97\x1f\xb7 \xbe\xb5ĵ(\x17\xbdUi\xb1\x81\x1b\xd8JB(\xc6$Y[I\xb5\xd7\xc9:\xf4O\x944䓷s81\xc2\xe0\xb9t\x14\xaa\x11\xb9r\xb6\xce\x03\x8f7\xe5V'C\xe3zu\xadr\xb1\"G\x93̕\x96\x0e\xdeq\x9f\xc5\xe9\x9dhL\xb1\x98\xec\xdewk\u007f|ʉ~\xbaɸ؞^\xde1\x13{T\xfaAf\xab\x1b\x89\xbe\x1c\xe2\xb5P.\x04}\x9c\xa4\x83\xc4\x1fa\xa9\xbb~H\xa50\x18~<Z\xcf\xfcrw幽\xe3\xc6\xf9\x12\x1d\xe3C\xdc\xe4\x88\b\xf5\xf9\xf6\xda\xfd\xa3(\x0fC\xb9\xc8\xef`K\xca\x1f~\xa1\xdb\xcf7\xc3/\x82{\xd5\t(\xb0\xefTv_\xb5\xd5;Gj\x1f\xb1\xe2\xc6H\xc4 3y\xb0\a\x9c\xe3\x91\v\xbdzǼ\x95\x18\x1c1\x9f\x0f\x84l;\v\xb5m2\x9d\xc5ï\x1dbeJ\xbb\xde\x1a\xfc\xe3\v\xf6\x1dx\xb9w\xf81\x9cM\xc8\x05\xf7\x0e?@\x80\xa8\x89\xa5\x8449\x03<\x17\xb9\xfc\x03\xb4![\x87\x1a\b\x84eձ\xd0\r'Aw\x13\x02\xcb\xf6\xdf3%\xae5i\x98\x9f\xce\x04\xe7b\x1f\x97\xd3K\b\x84v\x89\xdcJ\xa3\xc8Q\xedA\xf7\xd2\xdc\xd6\x19l&\x04\x054\x9f3%\x01ԭ\xd9Y\xb08\x06\x16,N\xc0\xec\x05\v\xef\xccq\xaa\xe5~.\xd6wȶ\x14-g\xaaڥ\xb5JDf[\xfe,ˏM\x04`\x11\xfe\xec\xc1\x9f5x\x92e\xaea)\x9f\xa6q\x05V/\x1f\xe3ό\xf1g\x02&Y\x19\xdfG\x8b\x85ڛ\xfaG\x8e\xc1J\xe5\x12\xe2\xe7\x18\x9f\xc6\xebC\x15\xb92\xf6\xde\nZ\x10,\xf7\x01\xe6>\xc0\xc7\xc0}\x80w\x9b\xe0>\xc0-\x10\b\xd7#\xee\x03\xdc5\x05\xf7\x01~\xb3\anT\xfaj\"\xee\x03\xcc}\x80\xa3sy0\x99\xbc^\u009c\xf1ڳp\x1a\xdc[Z\xd19VFX\x199\x06VFv\x9b`e\xa4\x05X\x19\xe9\x01VF^\x03+#\xd1\xf9IK\xac\x8c\xb02R\x03\x93\xd7ɉX\x19\x19\xb3\x86b*\xb7\xc0\r\x00݁\x8b(r\x11E\xda\xf5s\x11E.\xa2\x18~'\\D\x91\x8b(\xd2\x06\x9fIM/.\xa2\x88\x04.\xa2\x18M\xbe}.\xa28_Q\xe8\xd2\x19\xee\xfc8ޅ\x16Q\x1c\xb1DBM\x15\xf3(\v\xb6O\x80\xbb%\x13\xb2/:\xf82ߦr\xca$Q\xedU\x99\x93\x19\x1f\xddd\xf5\x05r\xad\xac\x8a\x151\x18~\xb2\xb2`/\xe8\x05\xcf{\xc7\x0f\xa0\xd7 \x12\xc96Iw`\x9b$\xdb$g(\x88\xb3M\xb2\x01\xb6I\xbe\x02\xb6I\xb2M\x92m\x92\xf3\xd1\xd0\xd9&\x19M\xbe}\xb6I\xceW\x14\xbat\x86;?\x8ew\xa16ɦ \x11\xfa\x8b}\xc83\x95\x1b\x897N\x96 \x92D\xd6\x15\fn=ك'\x99x\x97\x96\x01s\xb9'@\x1ej .\xb4\xb4\xcf\xd7*\xb3\xf0\x84\xe6\xb0>8+\xd2T=\xdej\xb9\x95)\xac\u08c9EJn#\xe1WC#\x16\xb9X\xc8TRo\xde\xf7a\x15\xc9\xc5\x1a\xb6\xa2(Ѵ\xfc\xb0\xe8\x1d|\xbdgnݎtH\xc8\xe3G1\xb9Vq\xd5'\x87\xbe4\xd9\xce_\xb7]\xb9S\xca~\x92)\x98gc\x81TU\xd8\xef\x04\xaa\x8e\xf2\xbfjU\x90\x90\xf7H\xb2\xf9\xd7?G\xd7\"\xaa\xbd\xff\xae\xb2\xf2\f':\xb9\x1f\x06H\xe50\xa7=8\x03_dV<}\xabj>M\xf2T\xa4\xb0\x05r\xad)o~\xa7\x15\xbe\x9aQ\xb0ū\t\xa6Z\xbc \xe2k\x80Ž\x1e\x89G\x99%\xea\xd1L\x88\xb1\xab\x8d\x11\xd7\x1a\x12Ȭ\x14\xe9}N\xcf\xca\xf6\xbeķ[\xf1\xa9\x10\xecOM\r#\x9cp\x17\x93\xe8\x1cVh[\xe4\xec\xbbv\x83\x8b\x16r\xd9w=W\x83-\xfb\xae\x1b`\xdf\xf5+`\xdf5\xfb\xae\xd9w=\x1fO\x0e\xfb\xae\xa3ɷϾ\xeb\xf9\x8aB\x97\xcep\xe7\xc7\xf1.\xd4wml\"\xd1\xfe7\xba5\xb8Z\xee[\x86\xefED_҂\xdeȬ\xf23~\x05c\xc4\n(\xdd\xc0\xc8Tub\xf9q\x8b\xd2[K\\\x8br\xd8[\x95\x16\x1b\xb8\x81\xad$\x84CL\x929\x95T{\x9d\xac\xb3\xfeD\x89;>\xb93\x87\x13#\f\x9eKW\x9f\x1a\x91+\x87\xe7<\xf0xSnu24\xaeW\xd7*\x17+rD\xc7\\i\xe9\xe0\xa1\xf6Y\x9c\xde\r\xc6\x14\x8b\xc9\xee}\xb7\xf6ǧ\x9c\xe8+\x9b\x8c\x8b\xed\xe9\xe5\x1d3\xb1G\xa5\x1fd\xb6\xba\x91\xe8\xcb!^\v\xe5B\xd0\xc7I:H\xfc\x11f*\x01\x9c\x9b\x8eph\xe5\"\xf7\x90Bl1\xbdB}\xe3\x17I\xb7K8v\xb5\x05\xbd\x06\x81@\x87\xb9|Y\xae\x016\x95\x87\x1d+\xcd\x13v\x98k\xa9\xb4Ĉ\xf14U\x94\xa2\x806{\xbbN\x851\x83\x93\xcb>\v\xfaWa1\x88\x81\x96Ȩ\xb2X\xac\xb2\x1a\x81\xbf\x13BWFe\xba/vz~\xdcWC\x1530\x02u\xe9\"\xb3r\x03\xe3 \xb0\x89א\x14)6\x00\x84\xb2\x125̛\x86\xfaKJd$=\xb4\x8fj+\xa3\xc6pN\xb4SR\xc4&U\x9f Fi\x8e\u007f4>1\x99t%\x9b\x18\x87\xe9\xa1\xe0\xd0b/=\x16\xa4\xc5[z,H\x8b\xb1\xa4\x9bBi*\x98)\xf2<\x85\rdV\xa4\x15\xef@\xe2\x0e\xc9\x1e\xe4\x13\xf6L\xf7$\xd04M\xf3lb\x9b\x8eq*>62\xaay\xc8\xcbDA\x0e\xe1\xf1\xe1\x1bD\xb3\bɰA\v\xcd!\x1bCh\b\xea\x13\x13MG\xb9\x10q\xd0\x1eh\x10*\xf6\xd9\a\x13\xfd\xe2\x9d\xc7e\xf4\x84A\x06\xf4V\xc6\xf0!\x8eq\x99Q$y\xfex\xa9\xe1Շ\xb5\xd0p\xabU\f\xb5Vdr\x81q\xdaRdPS,\x12\xb5\x11\x18w4\xe1Î\xfc\xb1\xbfj\x11\xc3--H\x88\xf6<S\x1ef\xabR\xd0\x02ɸF3x\xc0r\t1:ԅL\xd8\x0f0\x9e\xe3\xbc<\x10\x81\xb2\xcbz.x\xb8ib\x14\xca42#I\xc4!\x1e\xd2H\xd6'\xabr\x95\xaa\xd5\xf3}\xaeA$\xd7*3V\v\x89r\x05\x8fF\x81\xa9X@\x8a\xf7!\xf8-Z\xc2Fظ\xf2\xfc\x811\xd4T3\x8fx\u007f\xffX\u007f\x0239@\x800;*\x83\t\xba\x89\x8az=\xd2%\xbc\xbbJ\x06\xc9P\xf0\x8d\xce\xf7\xcbp\xb8*Q\x898\xb2A\x02\xd2pϼ\x06\x9fS\xab\xa8\xffK\xc9{\xb8\x04\vv\xe8F<\xdd?\xc0\xa3\xc7\xf3\x8e\x0e0\xf50\t\xed\xde\xc2\xdfF\x14\xbd\x1eא\xfdȌ\xb0\xd2,\xa5X\xe0͠\xa3\xba\xd2v\u05c9\x1ast\xaa\xa8qoN\xe6\xfc\x84\xa7:t\xee\x1cE%\xf1h>\xa6\xc2X\x19\xff;U\xf1ýUz\xd4t䥡\xb8\x85#_&\x97\vm%5*o\xda\f\x12\x9f\xc8:\xbf\xb8\xba\x1a\x8b?\xdf\xcc\xc6v\xdblx\xacGL\xfc]h\xb8\x91\xe6aL\n\x8aE\xbc\x96\xd9\xea\xabJ\xc6'\xa3D\x9a\aj\xdd\x06\xef\x85\u007f\xdc}\x1e}݉\xd8Ճ\xa4UN\xf0\f%\x9d\x86\xd1\xd0\xe9\xbdAG\xd2\xc0\x1fw\x9fG\xe5\x13\x9f$^\x86\xf3\xe1\x13ӽ\x1b\x06b\rH\xfb\xfc뵉8\\\xd9\xec'X\x99\x8eÇ\xc3\xc2\x0fm>v,<\x8e!_/GuXoT&\xad\xa2\x15\xd3\xf00\xcb\x04Q\xa8iF\x05j\x91\x84Y\xb2\xfe\x86WPxc\xe4\xcd+\xaa\xa5\uf014h\xeckz\xf5)\xb02\x9d\xb9\x87^\x12n\x12\xde\xdap\x8f\xd1\xf8\xa3\xcc\x12\xfc\xf1\xccP\x9d\x9e\x9a_0Ѣ\x80\x15\xf9\x1e\x88U\xb6\x94\xab\xaf\x02]\xd6\xd6\a\xa3\x12X\x8a\"\xb5TE~Z\xab\x14Y\xb8\xbahw\xe7\x86x\xd75\xf8\xf7،\xbc\xef\xbd\x06\x9fJ^\x01\x0er:WeNɖ\x8d\xa6uSN\x12\xf4\xab\xf2ڵ9\xb6\x84@\u007f\x02\x8c\x1c\x95\xf9k\xb9\x1d]\x92\x9eL^\xccT\x02\xb7\xc5\"\x95f}\xcf\x02\x1c\xa5\xd3˴>\xa0\x0f\xd6j\xb9(P\t\xa7\ax\x0f\x81\x0e\x1eF\xea\x8a\xd2\xc7bc\x89z\xcc\x1e\x85N>ܢ\x9d%,ˎ60\x84,\xbb\x94\x90&D>\x1aj\x0f%\x88\\\xfe\x01ڐ+\xde4\x10$\bnw,\xf4b0Aw\x13\xa2h\xf0\xfe{<\xc5m\xafʿ\xac\xb7Dap\xa2\xe9\x93\xf7\xe9L\x887V\x99\x152\xf3\xeb\xc6PC \xfaM\xe4V\x1a\x9f\x80\xe0\x80{in\xeb\f6\x13\x82\x954\x9f3\x1d'\xf1U\xdc\xe7\xa7~\x93\x17\x86Mn\x9f\te\xab\xbc\xbc\xad\x90\xc8£\xad\x18\xd5\x11'\xff\x86/r#\xc7\xee\xabF\xbe\x9b%:\x8bu\x86>\x9e\xb4\xe0`I\xcch\xa1W`\xff\xfc\xf3\xf7\v\x8aUx|\x94\xb4:\xd1s\xfc\\:\xb7H\xe1\xe9\x8fʌ\xc2\x06ơ\x96U\xf4Vo\xef\xc1:\xc5\x1ey\x02\xb01\xb1\x0f\x96\xa9\x8a\x1f\xc6\rhI\x84\x15f\xa2\xe0\xd1\xdd\xda?~\x8c\x1e)A\xbe\xa1UU\xbd\xc1Hc!\xb3cg\"p.\x0fvt\x9eL\x82\xd8\xf3\x8b\xec\xaf\x0fj4*\x92\xf6\x0er5*\x9f\x93\xba\xaaV\xe0q'ddȕ\x91\x13-\xbd\x95TG\xc1$qh\x87\xb3\x1a\r\x15\xd3\xc2X\xd0\xe3F\xd9C\x96\xe4\nW\xdb\xe3\x00\x9e\xbc\xfc\x82\xa2\xdd\xe9x\xb8\xbf <#\xc5[Lɸ\xbbV\x86\xd4~\xc3\au'A ;\xbe\xd8\xe3\U00054388\x01Ҍ\x1cF\x15\xafE\xfe\xa1\xb0\xeb\x1bib\xb5\x05\x9f7\x8d\xaa\xff6[\xb8\xafk\x00\x8d\xbf\x81\x89\xc4\xf0\xba?\xa6Udס\xdf\xea\u007f\x8d-E\xec\xb0\xfbsV>ϨJ\x80\x81\x96\x9f\xa5A>W\xda\nZ}\x9c9\x1a\x89\xd9\x10G\x80)\xed\xa6\xb5\xdb\xe6\xb6\xc2\xd2\xd9<\xe9\xf2/,v\\\x95\xdc\x03=\xe6\xf8p\xc6\x12!(\x88D\xbe\x84l\\=뢴\x9d\xba^\uf332E\t\x82\xf2\xd5\xee\x1b\xc7\"\x8e|o\xeb\xad\x1d\x8aש\x90\xe8x\r/y\xbb\\\x90m\x98\x0epu8\xabѰc\xad\xac\xca.\xcf\x1f\x90\xcc(\xb3\xb5\xdc\xech\xf8\xa0\xb4}Tz\x82\xe0\x83\x8bKK\xe7\f\xeb\x1eȵ*\xc7\xe0\xb7z\xb1Y)u\xac\xee\xfc\xf2Rȹ\xf4!7\x11\x85(\xcb\x1cd\x8aP_S\x83W\x02\xfb\x01\x02\xc5\xc7\xd7\xe0\x97\x1cr\x800i\"\a\b\x910r\x00\xbfԑ\u05fb\nr\xf4\xfe9\x015\xd0\x13\xe2\x8f\xe7 \xc7\xe6\x1f @\xa6\xd2\xf1D\xf4Z\xe85\xf8ش\x8e\xf7\xe1}\xd9>\xc9\xf4\xaf7Co\xb2~<\x8b\xd7\x1dy\xe4\xa8\x1e\x80߈6\xf0O\f\x1df_Q\xc0d\xd1\x03\x04}ʢp\t\xa4\a\b\xbc\xc3P\\?\n\x94^z\x80`\xec;b\xa9\x82\xb4\xab@8\x16.I\xf5\x00\xa1\x19I\xc0\xc4\xd5\x03\x04\xe7%A\x92Y\x0f\x10|\u007fa\x12\\\x0fp֬\xce;\xfd\xf5\x00\x019]8!\xfe\xbd\t\xe0\x016S\xbb\xc4YȬ\x81\r\x11n\xc0\"\x03\x12\xd8\x10\xd1=\x11\x1b\"Nl\xe6\f\f\x11/\x1b\n\u007fW\x0fࡖ\x06\xaa\xa6T$\x12\xb2\xb3(9\x12E\xf0\x94K\xafn\xac/\xc1\xa77\xebK\b\xc5Q\xfdy\xe9\xd9\x14w\xf1\xe2z\u07b44Y}\x15\x8f\xc6\x1b\xb5\u007fm,\a\xe8_\x85Z<\xdbQ\xbd\xf0+\xad\n\x92\x17n\xa6Nx\r+i\xec\x04ym\x162\x81\xe9}\x1fh\xd9\tz14\x91\x0e\xb3\x89shp\x82\x18 1\x16wЋQ\x03#\xa6J\x19و\xd5\x04\xcd\xd4\xe0\xb9\x1c7\xfa\xba\x17ػH\xa9\xb1\x83\xe89\xe1\x82\x00S&\\̪\x81PŰУ\xc6n;db\x91\xc2\xe7o\x17\xf0\x80\xac\x84\x85G1\xbe\x80\x97ke!.\x15\xe1\x1b\xb5\x11r\xfc\xd4Cfrh\x98\x92\xc9\x19\x93~\xcc\xc4\"\xa5\xa9\xf4\x9e\x17f\x95\x16+\x98\xa4?\xf0n\xed\xdb)\xe4\x00\xf3l,\x8c_\xbc\xb4\xd6\x13f\xd5rt\xc7E\xf1\x16\x93\x86\x15\xe0GVw3\xda{H\xf2\xfa]l\xa0\xf9<\xdb\x1fp+\xaf0fpn充\xc9l\xdd\xd3u՚\xb0\xa77\xfd\x15\xa8e\x115j\x86\xf7\xc5%\xbe\xb1z@\xee\xc7:I\x1a\xf5ai\x93\x8f_Ć|j[\x93\xafA\xc3\xc5d\xb2\xee\x15\xa9T\xc6ϣ\xa7\x95\xbeZ\u007fBD\xa5\xc6\xe1O\x98SK\b\xa6'\x92\x05e\xa7W\x15\xd3D\f \xed\r+\xe3\xe0\xbe\xe4\xea\x10\xfd\xedfcE}\x03\xe2\xc7֦\xbb\xc0\x98\x0fK\v\xfa\x93̤Y\xbb|\x05N\xfcw\x17\xf3\xdd\xce\xf1*\xb2\xb0\xc9Sa\xbb\xf1\xc0\xe9 \x1c~\xf4\xfa\x94\x84L\v\xdd\xc1P\xdc\x0e\xc7\xe5P\xd0\xf7\x13n\xe9\xadH\x8b\xae\xf7\xa6Wwv}\xb4\x84-/Ӛ;\xd8\b\x999\xf89ݱ\t\xb4\xee\xcf]p\xe6\xb4.\u009a\xf3d\xd5\xf1\x06\x9aͅhz\xd9\xe6U\xbd\xa5\x8e_ ȩ\x9dqvN\xd2\xfa\x8f\xa7\xbf\xf0*ڀ\xd526\xaf\xfe\x9a\v-6`_3זٍ\x15\xb6x\x85\xa3\xed\xb8+b+\xb7\xf0]˓5\x01\xbbɯ\vq\xf3\xb50'\xf0\xa1\x15\x03\xdaN\xe4x\u007f\xaf\x8f\xa5\\\xa2\xffD\xde\xfc\xb1\xaa\x10\x95\xfc\x12Y\xbdC\x8f\x9dl\xf5K\xb4\x14i5c\x8d\\\xbfD\xdb\u007f,\xc0\x8a\u007f\xd4?\x8aװ\x11\xcd\xf6T\x0eه\xdb\xcf\u007f\xfc\xd7\xfd\x8b?\xb7\x9dt[\x96e\xcbq<\xc8,q\xfa\xe1\x06\xacH\x84\x15\xa7~\xfc\x161r\x88]\xd1\"V\x99\xb1Z\x9c.A\xdd\xca*\xfbXd\x17\xc7\xe9\xe5\rJ'\xed\x8ej\x17未Gз\r-u1\x17'>U\xe4y\xb8\xe9\xfaX\xdeիݷ\xfe\xec\xe5\xae:Ϻ\x83\xf3\x99\xf6\xa6s.'\xbdP\xc5k\x04>\xb5\x81\x9e\x03\x96\xe6G\xf91\xffv\x9b\xac\xcf^r`\xa2]s9X\xd0ݕW7k\bB3z\x04\xb9Z;\xf8Q\x9c\xa7t\x15O{U\x94\xab\xdd\xde\xfc\xe5W\x17]\xa5\x9fZ*\fl\xfdז\a\xd5y\xa7\x0e\x8f\xfe\xa9\xed\xef^\xf7\x80\f\xb5\x12\b\xffW\xd1Qf\xbb\x17\x1562\x93\x1b\xf9w\x0f_\xee\xa2./\xae\xde\xe5\xed\xe8\x1f\xact+5\x88\xec\xf9[\xab\r\xf4ʅD\xae\x1c$\xf3\xbf\xbcξ\x92)\xe8gg \xadZ\x96\xf8\xb0ꍰ\xf1\xfa\xe3S\xae\xebb\xe9\xe31G'w!\x827\x96늎\xc3 Mڧ46\xe0\xecxE\x99\xc1\\\x8d6\xae\\\xbc\xcf5w\xb5?\xc4q\xd8\xf8\x0e\xfb\xbe\x88\x05t\x17$\xc7w\x04t<g\x17cI\xdfOl\x879\xbag\x1b]\x17\xd7\xfa\xe8^\xd5L'ܳ\x945\xbe\x87\xfbV~\xd2M\xd6n<\xa4\x93F\\\xf8F\x0f\xc7p\xbar\x17.\xe14Q?gp\xe0\t(,\xf5\x13\x88\xdai\xbf\x97\xea\xdd(\xa0u\u007f\xbd4\x8e\xa1\xee\xde\x13\xa3\xd9c\x8e\x88\xe0\xfb\xce\x12\x8b&\x82\x93\xaa\xb8ӶN\xa9\xe6n\x8bFUy\xa4L\xa4\xf2\xefNŦ\x17\x17\x9d0q\x18[X\x1dD\xb1\x91\u007f\x8b\xd3-\x04\xa7\xb16t\xda3\x87a\xebm\xf6J\"[\xefRx\xc9g\xba\x11O\xadx\xe8\x90L\xdc/Po\xdac\xb9\xc3,\xe0\x81\x15\xa4{%ߞ\x8dקn\x81|uՌdz\xd0 \x12\x99\x811\xbf\n\xdb\u038bz\xf8\x8c\x9bv\x10\xab\xac~\x10\xfa\x1d\xfdNl\xab_<\xbez\xb9f\x0f\x03t\x10\x17;xd\xd5\xc1\xa3#<\xc7\xe5\x8c܊\xf69\x1d\x8eSq=\xa7\x99\xde\x1a\xad\t\x93\xf4\x99̜'\xe9\r\xa6qD\x9d:)=\xd4i\x17\xd2\xf7\x88\xa6SG*\xfe\x11\x8e\xc1i\xc8S\x19\x8b\xa0N\xa7v\x83\b+0\xaf\x81\x15\x18\xd7\xfd\xcdC\x81\xb1Z\x8atvʋ\xc8s\xad\x9e\xe4FX\xb8+2+\xbb\xb8\xbf\x03\xb2\tc\xe4*\xdb@g\xe7\xdc`\x91\x1e\xe3\aP`\n\xf6\xb8\x86\x95\xcc)ڢ\xaa\x80\x04Z\x967\xdc\x19\xe2\xecv\x85\xaeŏ\x1d/ѱP\xb1\xe3l}\xf2\x94\xf3D\xfdh\x8a\x98\xc8!H\xd99\xca\xc7Y\xb6r\x9e\xb3G\xbeB\xd99:Q\xb5\ued5a\xde@*\x9e{Kq\x85\vb\xfb\xdfj\xd1\xce\xe2\x1bp\xc3\xfdn\x96\xffrS\xbd\xb6\xfe\xf6'\x00\xb7\xa9h\x1f\x91t\x03\"Ie\x06\xceu\xcep\xb5\xcc\xdcc\xee\xa2h!\xe2\a\xb5\\~\x91\x1b\xe9\xe0{\x1f*\x92\xb4ԋ7y\n\xb6\xcfK7\xf466\"+D\xda\xee+8=\xb7[:K.\xb4HSH\xa5q\xc8\x1b\x1e\xee\x13\xfb\x1c\xabG\x1bFe2`\x9c\xad\a@euRR+\x90\x99\x9b(\x1ff\x03\xee\xeeYυ\\]\xb6\a d͒v\xe6\xee\xd0=\x006\xa3\x00\x93\u007f\xe9\xe8\xee=\x80\xe3c\xf0z\x80\xeb\xf7::\x83\x0f\x80w\v\xbf\xde\x1a\xe2\x06Q\x1f\x8f\xf8\xb1\xed}\xcf\x1b@\xf2\x1a\xa7\xf7\x9d\xb0\xe1\xc8齧m:\xa2\xbf\xff\r\xd0j\x9ab^\x87\xfdF\x97\xcbR\fD\xf0NZ\xde[\xa6\x12\xf8\x80^\x8b\xbe^=\x12\x96\xa05$7EI!\xf7\xf1\x1a\x92\"\x95\xd9\xea\xf3*S\xfb?\u007f|\x82\xb88\xed\xb5\xeb\x03r\xa9\x02\xdf\x14\xd5\xfa\xcb|*\xfa\x86\xa8+L\x93\x04NC\x80R\xf4\xe1\n\xd1\a)CO|^O\x01E\xee\x18|Sx\x19\xe54\x04iB\x10\x85\xfd6\x8a\x94s\x1a\xc2\x14\xb1\xf7/a\x8f\x96\x93N\x03Zz\xea\x9a\xc6\xeft+\xeeS5\x13b\xc6\xf3\x02\x98\xf1\xb8\x013\x9e~`\xc6\x13|#\xae\xf9/\xa7!D\x89'\x8a\x9ep\f>\xa8uu$\xbb\x92\x86;\xa4\xe8\x9c\x06\xaf\x8b\xa3\xe3MsXC\xe9 ~\x8fO\xa9\x965V\xd0\uf829\xfcЋ\x95\xb2*\xd2\x01,\x118\x00K\x04.\xc0\x12A\xfb4\xac\x8a\xbc\x06f<\x0e\xc0\x8c\xc7\x05\x98\xf1\xb4O3\xa9*\xe2\xb3\x05\xfa\xa5^\xbd\x95:\x89\xf28\xe1Ӊ\x03s\x95\xb0\xf7\xe2\xb03O\xef\xc5\xe10\xcb۟RoH\xc5\x02\x10a \xc3n&\n\xac\xc8D\xe1^\x96\xb0\x8d\xd6\x03\xb5\xf9\r\xfc܅\x941\x06\xd8\\(Y#\n)oD\xe1\xbf3\x9c\xdc\x11\x05m\xf6\x1e\xa2\x8dp0\x19$\n'\x87D\x01O\x1c\x1d\xfd\xd2\x0e>q1\xed\x10\x10W\x83\x8a\x81\x9e\xd3샚\xa7\xd6?\x03\x9do\b|\xb4*W\xa9Z=\xff\xe6\xf7\xd8\x04\xf8\"_\x1etu\xfc-춘\xc8m\xf1Rhe\xdfŜ5\x90 b?\xfb-:\x80͇\x0e\xc0\xe6C\x17`\xf3a\xfb4\x01\xfc\x16\xfe\xe2zxQ=\xa8\x18\xe9u\xd4\xdeS\xf8\x8b\xe6\x9e\xd4\x1d\xe0,}q-\x80(\xee\xf9\x15~\xb2\x9f\x9f\xf8=\x89\xf0\xe6a\xe7άd[\xf7agl\xeb\x1eb3\x11ۺ\x91\xc0\xb6n\x1a\xb0\xad\x1b\x0fl\xeb\xee\a\xb6uO3\rۺO\xcc\xc1\xb6\xee#`[7ۺ\xd9\xd6\xdd\x00ۺO\x03ۺG\xdd\x14ۺ]\x80m\xdd\xedӰ\xad\xbb\u007f\x1a\xb6u\xb3\xad\x9bm\xdd\x03\r\x12\x85U\x1bUd\xf6\x1e\xf4V\xc6\xf0!\x8e\xcb\xff\xfb\xae\x1e\x00!\x10bJ\xc95p\xe8L\xed\xbe\x0e\x9a\x94\xa8\x02\x91\xd0+4\xc9\x12\xe9܃2\xa8\xc8\x15\xab\xcdF\xf4\x151}\v\xf3\xf9@ȶ#}\x9cg\xaa\xb2S#\xcaS\xe0\xc9O\x9d\x8a\x1a\x0f\xb7\xf2'\xad\x88n#\u007f\r'V\xd9R\xae\xbe\x8a\xfc7x\xee,U<\xfcV\xa2\x10\x8aV Y\x8b\x8e\x8a\x817\xa2\xf2Z\x16\r\xb1\x19܋\xf4\x16\xfcU\x17?\xb5%\x80\x00\\վ>\x03<w-\xe9\xdd\a\x81\xb0̱$\xf8(\xbb\t\x81e\xfb\xef\x99\x12ך\xca\xe1\x9f\xce\x04\xe7\xf6\"\xe6\xef\xe7\xc2\xdc\x12\xb9\x95\xc6\u05ca\x15h/\xcdm\x9d\xc1fBP@\xf39S\x12\x80\x81X\x83e\xc1\xe2\x18X\xb08\x01\xb3\x17,\xbc&\xf0ʳ\xef\xebx\u007f\n<v롂R\xb4\x9cI\xd4нJDf[\xfe,ˏM\x04`\x11\xfe\xec\xc1\x9f5x\x92e\xaea)[\xfb\x80\xba,M>\xc1\xfa\xe5c\xfc\x991\xfeL\xc0$\xe5F\xacзF\xbe\xabj\xb5\xdb\"MoU*c\xb4tD^7\x95K\x88\x9f\xe3\x14\xfd\xa5>T\x91+c\xef\xadФ\x90\x1f_r\x84'\xd76\x04\xe1\u05ce\xe8\x06\xeecxO\xc1t\xde\xd2\xde\xda\xda\xfcW G\x8f\x85\xb8ҵ2\xe4\xf5\xa3P\x97Q\x9e\xc3\u007f\x83HPN\xab\xb7pV\x91>\xfe\xfaY4@T\xcd\x19\xed(T\xfc\nIsy9E_/\xc7~8\xa3\xe8\x95\xdc\xd3\xfa\x1a\xe4\x82sE{!\x1b\x10\xd9\xf37\x0fSO\x15\x9d\x10\x06Q\xaf\xbc\x03lk0\xf1\x1a\xfc8\xc2Y\x84\\\x97\x17;\xd5si\xe3\xfc^\xc5\x0f\xfc`2y\xbd\x829\xe3\xb5\xd7\xf0\\ýU9+#$`e\xe4\bX\x19\xd9m\x82\x95\x91\x16`e\xa4\aX\x19y\r\xac\x8cD\xe7'-\xb12\xc2\xcaH\rL^''be\x84\xec\xac\"\fM\xe5\x1620\xe6V\xabŨ\xbe\x1c\xaa\x06\xe3K\xb9\x9e\x9a\xcb\xdc\x13\x9f\xbcPt)dZh\xf8\xbe\xd6`\xd6*%\x1d\xa2oֽ\x1f\x93\xf0г|\x11\xcf\xe7\xb9\xf0F\x9b\x00z\x95'\xe6\x87ѧ\xce&\xf0.\x80\x0eu6Q\xa6^z\x93\xaf\xce\x14,\x1f\x96\xae+\xf9\xe8Iޗ\xe8#\xc0y\toA\x04\xb7\x10B\x9b\x9f>4i\x12.QV\xf4\xc2y\x99I+Ez\x03\xa9x\xbe\x87Xe\xb4fvӾ\xc39h\xa9\x92\xd9n\xdf\x14q\f\xc6\xccX\x10\xf2Ҡg-\n]:Ý\x1fǳr\x03\xaa\xb03\xe5\x16\xe4O\xa7Ⱥd\f+\xefu\xac\xea\x00ށ\xfdu\x02\xdc-\x99\x90\xfd+\xbf\xf9\x12a\xc9\x01?\xdfN\x12\xd5^.=磛\xac\xbe@\xae\x95U\xb1\"\x06\xc3{-\xee\x93\\\xf4\x82^\xf0\xbcw\xfc\x00z\r\"\x91l\x93t\a\xb6I\xb2Mr\x86\x828\xdb$\x1b`\x9b\xe4+`\x9b$\xdb$\xd9&9\x1f\r\x9dm\x92\xd1\xe4\xdbg\x9b\xe4|E\xa1Kg\xb8\xf3\xe3x\x17j\x93l\n\x12\x11:\x87\xd1\xc93\x95\x1b\x897NFA\xab@{\x92\x89wi\x190\x97{\x02\xe4\xa1\x06\xe2BK\xfb|\xad2\vOh\x0e냳\"M\xd5㭖[\x99\xc2\n>\x9aX\xa4\x82\u0590·\x86F,r\xb1\x90\xa9\xa4\u07bc\xef\xc3*\x92\x8b5lEQ\xa2i\xf9a\xd1;\xf8z\xcfܺ\x1d鐐Ǐbr\xad⯪\xc8H\"\x99\xa7\x9d_$߲\xf4\xf9N)\xfbI\xa6`\x9e\x8d\x05RUa\xbf\x13\xd0E\xf6\xc1\xfc\xaaUAB\xde#\xc9\xe6_\xff\x1c]\x8b\xa8\xf6\xfe\xbb\xca\xca3\x9c\xe8\xe4~\x18 \x95Ü\xf6\xe0\f|\x91Y\xf1\xf4\xad\xaa\xf94M\v$\xd8\x02\xb9֔7\xbf\xd3\n_\xcd(\xd8\xe2\xd5\x04S-^\x10\xf15\xc0\xe2^\x8fģ\xcc\x12\xf5h&\xc4\xd8\xd5ƈk\r\tdV\x8a\xf4>\xa7ge{_\xe2ۭ\xf8T\b\xf6\xa7\xa6\x86\x11N\xb8\x8bIt\x0e+\xb4-r\xf6]\xbb\xc1E\v\xb9컞\xab\xc1\x96}\xd7\r\xb0\xef\xfa\x15\xb0\xef\x9a}\xd7컞\x8f'\x87}\xd7\xd1\xe4\xdbg\xdf\xf5|E\xa1Kg\xb8\xf3\xe3x\x17\xea\xbb66\x91h\xff\x1b\xdd\x1a\\-\xf7-\xc3\xf7\"\xa2/iAodV\xf9\x19\xbf\x821b\x05\x94n`d\xaa:\xb1\xfc\xb8E\xe9\xad%\xaeE9\xec\xadJ\x8b\r\xdc\xc0V\x12\xc2!&ɜJ\xaa\xbd\xd2\x1b\xc4y\xb2ۉ\x12w|rg\x0e'F\x18<\x97\xae>5\"W\x0e\xcfy\xe0q\xd5\xdby24\xaeW\xd7*\x17+rD\xc7\\i\xe9\xe0\xa1\xf6Y\x9c\xde\r\xc6\x14\x8b\xc9\xee}\xb7\xf6ǧ\x9c\xe8+\x9b\x8c\x8b\xed\xe9\xe5\x1d3\xb1G\xa5\x1fd\xb6\xba\x91\xe8\xcb!^\v\xe5B\xd0\xc7I:H\xfc\x11&\x99\xb9\xaeڥ\xb9\u007f\f\x8d\x83\x97\x9fo@o\xd1fs\xd2;C\x97#IH\xa8(nh҇\xf9<\x9fԗË{\x91\xad\xfb\x1e\xab\x92\x99\x10\xed\xf6\r\b\x1d\xaf\xb17r\xfexM8\xc6$3Xŏ\xf0I\x90\x89E\n\xf7\xa0K\x11\xfd\x8b\xcc\x1e\x10\xe7H\x91C _\xc3\x06\xb4H\xaf\x9b\x84zĂ\xe8{\xa6\x92\xb8Ы\xb1\x84xo\xdaĿ\xf2\xc4`\x83\xf9| d\xdbY\xa8`\x93\xe9\x1f\x1e~\xe2\x10+S\xda\xdf\xd6\xe0\xef\xaf\xdfw\xb4\xe5^\xdc\xc7p6!\f܋\xfb\x00\x01\xa2\x10\x96\x12\xd2\xe4\f\xf0\\\xe4\xf2\x0fІl\xe9i \x10\x96U\xc7B7\x82\x04\xddM\b,\xdb\u007fϔ\xb8֤5~:\x13\x9c\xdb\xd7l\xf2\t\x9e\xad!\x10\xda%r+\x8d\"G\x89\a\xddKs[g\xb0\x99\x10\x14\xd0|Δ\x04P\xb7:g\xc1\xe2\x18X\xb08\x01\xb3\x17,\xbc3\xb1\xa9V\xf8\xb9X\xd2!\xdbR\xb4\x9c\xa9j\x81\xd6*\x11\x99m\xf9\xb3,?6\x11\x80E\xf8\xb3\a\u007f\xd6\xe0I\x96\xb9\x86\xa5|\x9aƭW\xbd|\x8c?3Ɵ\t\x98\xa4܈\xd5xř\xab\xd5n\x8b4\x1d9\x9e*\x95K\x88\x9fc|Z\xac\x0fU\xe4\xca\xd8{+hA\xa5\xdcW\x97\xfb\xea\x1e\x03\xf7\xd5\xddm\x82\xfb\xea\xb6@ \\\x8f\xb8\xafn\xd7\x14\xdcW\xf7\xcd\x1e\xb8\xf1竉\xb8\xaf.\xf7Ս\xce\xe5\xc1d\xf2z\ts\xc6k\xcfBdpoiE\xdcX\x19ae\xe4\x18X\x19\xd9m\x82\x95\x91\x16`e\xa4\aX\x19y\r\xac\x8cD\xe7'-\xb12\xc2\xcaH\rL^''bed̚\x84\xa9\xdc\x027\xd4s\a.J\xc8E\ti\xd7\xcfE\t\xb9(a\xf8\x9dpQB.JH\x1b|&5\xb2\xb8(!\x12\xb8(a4\xf9\xf6\xb9(\xe1|E\xa1Kg\xb8\xf3\xe3x\x17Z\x94\x90\"\xeb\x921\xac\xbc\xd7y\x94\xf8\xda'\xc0ݒ\t\xd9\x17\x1d|\x99o\xcd\x01?\xdfN\x12\xd5^.=磛\xac\xbe@\xae\x95U\xb1\"\x06\xc3OV\xe2\xeb\x05\xbd\xe0y\xef\xf8\x01\xf4\x1aD\"\xd9&\xe9\x0el\x93d\x9b\xe4\f\x05q\xb6I6\xc06\xc9W\xc06I\xb6I\xb2Mr>\x1a:\xdb$\xa3ɷ\xcf6\xc9\xf9\x8aB\x97\xcep\xe7\xc7\xf1.\xd4&\xd9\x14$\x1a\xb5.q*7\x12o\x9c,A$\x89\xac+\x18\xdcz\xb2\aO2\xf1.-\x03\xe6rO\x80<\xd4@\\hi\x9f\xafUf\xe1\t\xcda}pV\xa4\xa9z\xbc\xd5r+SX\xc1G\x13\x8b\x94\xdc\x12¯\x86F,r\xb1\x90\xa9\xa4\u07bc\xef\xc3*\x92\x8b5lEQ\xa2i\xf9a\xd1;\xf8z\xcfܺ\x1d鐐Ǐbr\xad\xe2\xaa\xe7\r}i\xb2\x9d\xbfn\xa1r\xa7\x94\xfd$S0\xcf\xc6\x02\xa9\xaa\xb0\xdf\tT\x1d\xda\u007fժ !\xef\x91d\xf3\xaf\u007f\x8e\xaeET{\xff]e\xe5\x19Ntr?\f\x90\xcaaN{p\x06\xbeȬx\xfaF\xe9UQ\x83\xefS\x91\xc2\x16ȵ\xa6\xbc\xf9\x9dV\xf8jF\xc1\x16\xaf&\x98j\U0004222f\x01\x16\xf7z$\x1ee\x96\xa8G3!Ʈ6F\\kH \xb3R\xa4\xf79=+\xdb\xfb\x12\xdfnŧB\xb0?55\x8cp\xc2]L\xa2sX\xa1m\x91\xb3\xef\xda\r.Z\xc8e\xdf\xf5\\\r\xb6\xec\xbbn\x80}ׯ\x80}\xd7\xec\xbbf\xdf\xf5|<9컎&\xdf>\xfb\xae\xe7+\n]:Ý\x1fǻPߵ\xb1\x89D\xfb\xdf\xe8\xd6\xe0j\xb9o\x19\xbe\x17\x11}I+\xf4\n\xec\xb5O\x87(2IY\xd0\x1b\x99U>ί`\x8cX\x01\xa5\x13Y\xc8\xe5\xc7-\x88o-q-\xcaEoUZl\xe0\x06\xb6\x92\x10\x8a1I\xd6VR\xedu\xb2\x0e\xfd\x13%\r\xf9\xe4\xed\x1cN\x8c0x.\x1d\x85jD\xae\x9c\xad\xf3\xc0\xe3M\xb9\xd5\xc9и^]\xab\\\xac\xc8\xd1$s\xa5\xa5\x83w\xdcgqz'\x1aS,&\xbb\xf7\xdd\xda\x1f\x9fr\xa2\x9fn2.\xb6\xa7\x97w\xcc\xc4\x1e\x95~\x90\xd9\xeaF\xa2/\x87x-\x94\vA\x1f'\xe9 \xf1GX\xea\xae\x1fR)\f\x86\x1f\x8f\xd63\xbf\xdc]yn\xef\xb8q\xbeD\xc7\xf8\x1079\"B}\xbe\xbdv\xff(\xca\xc3P.\xf2;ؒ\xf2\x87_\xe8\xf6\xf3\xcd\xf0\x8b\xe0^u\x02\n\xec;\x95\xddWm\xf5Α\xdaG\xac\xb81\x121\xc8L\x1e\xec\x01\xe7x\xe4B\xaf\xde1o%\x06G\xcc\xe7\x03!\xdb\xceBm\x9bLg\xf1\xf0k\x87X\x99Ү\xb7\x06\xff\xf8\x82}\a^\xee\x1d~\fg\x13r\xc1\xbd\xc3\x0f\x10 jb)!M\xce\x00\xcfE.\xff\x00m\xc8֡\x06\x02aYu,t\xc3I\xd0݄\xc0\xb2\xfd\xf7L\x89kM\x1a\xe6\xa73\xc1\xb9\xd8\xc7\xe5\xf4\x12\x02\xa1]\"\xb7\xd2(rT{н4\xb7u\x06\x9b\tA\x01\xcd\xe7LI\x00ukv\x16,\x8e\x81\x05\x8b\x130{\xc1\xc2;s\x9cj\xb9\x9f\x8b\xf5\x1d\xb2-E˙\xaavi\xad\x12\x91ٖ?\xcb\xf2c\x13\x01X\x84?{\xf0g\r\x9ed\x99kXʧi\\\x81\xd5\xcb\xc7\xf83c\xfc\x99\x80IV\xc6\xf7\xd1b\xa1\xf6\xa6\xfe\x91c\xb0R\xb9\x84\xf89Ƨ\xf1\xfaPE\xae\x8c\xbd\xb7\x82\x16\x04\xcb}\x80\xb9\x0f\xf01p\x1f\xe0\xdd&\xb8\x0fp\v\x04\xc2\xf5\x88\xfb\x00wM\xc1}\x80\xdf\xec\x81\x1b\x95\xbe\x9a\x88\xfb\x00s\x1f\xe0\xe8\\\x1eL&\xaf\x970g\xbc\xf6,\x9c\x06\xf7\x96Vt\x8e\x95\x11VF\x8e\x81\x95\x91\xdd&X\x19i\x01VFz\x80\x95\x91\xd7\xc0\xcaHt~\xd2\x12+#\xac\x8c\xd4\xc0\xe4ur\"VFƬ\xa1\x98\xca-p\x03@w\xe0\"\x8a\\D\x91v\xfd\\D\x91\x8b(\x86\xdf\t\x17Q\xe4\"\x8a\xb4\xc1gRӋ\x8b(\"\x81\x8b(F\x93o\x9f\x8b(\xceW\x14\xbat\x86;?\x8ew\xa1E\x14G,\x91PS\xc5<ʂ\xed\x13\xe0nɄ\xec\x8b\x0e\xbe̷\xa9\x9c2IT{U\xe6d\xc6G7Y}\x81\\+\xabbE\f\x86\x9f\xac,\xd8\vz\xc1\xf3\xde\xf1\x03\xe85\x88D\xb2M\xd2\x1d\xd8&\xc96\xc9\x19\n\xe2l\x93l\x80m\x92\xaf\x80m\x92l\x93d\x9b\xe4|4t\xb6IF\x93o\x9fm\x92\xf3\x15\x85.\x9d\xe1Ώ\xe3]\xa8M\xb2)H\x84\xfeb\x1f\xf2L\xe5F⍓%\x88$\x91u\x05\x83[O\xf6\xe0I&ޥe\xc0\\\xee\t\x90\x87\x1a\x88\v-\xed\xf3\xb5\xca,<\xa19\xac\x0fΊ4U\x8f\xb7Zne\n+\xf8hb\x91\x92\xdbH\xf8\xd5ЈE.\x162\x95ԛ\xf7}XEr\xb1\x86\xad(J4-?,z\a_\xef\x99[\xb7#\x1d\x12\xf2\xf8QL\xaeU\\\xf5ɡ/M\xb6\xf3\xd7mW\ue532\x9fd\n\xe6\xd9X U\x15\xf6;\x81\xaa\xa3\xfc\xafZ\x15$\xe4=\x92l\xfe\xf5\xcfѵ\x88j￫\xac<ÉN\xee\x87\x01R9\xcci\x0f\xce\xc0\x17\x99\x15Oߪ\x9aO\x93<\x15)l\x81\\kʛ\xdfi\x85\xaff\x14l\xf1j\x82\xa9\x16/\x88\xf8\x1a`q\xafG\xe2Qf\x89z4\x13b\xecjcĵ\x86\x042+Ez\x9fӳ\xb2\xbd/\xf1\xedV|*\x04\xfbSS\xc3\b'\xdc\xc5$:\x87\x15\xda\x169\xfb\xae\xdd\u0885\\\xf6]\xcf\xd5`˾\xeb\x06\xd8w\xfd\n\xd8w;k\xf6]\xcfǓþ\xebh\xf2\xed\xb3\xefz\xbe\xa2Х3\xdc\xf9q\xbc\v\xf5]\x1b\x9bH\xb4\xff\x8dn\r\xae\x96\xfb\x96\xe1{\x11ї\xb4\xa072\xab\xfc\x8c_\xc1\x18\xb1\x02J702U\x9dX~ܢ\xf4\xd6\x12ע\x1c\xf6V\xa5\xc5\x06n`+\t\xe1\x10\x93dN%\xd5^'\xeb\xac?Q\xe2\x8eO\xee\xcc\xe1\xc4\b\x83\xe7\xd2էF\xe4\xca\xe19\x0f<ޔ[\x9d\f\x8d\xebյ\xcaŊ\x1c\xd11WZ:x\xa8}\x16\xa7w\x831\xc5b\xb2{߭\xfd\xf1)'\xfa\xca&\xe3b{zy\xc7L\xecQ\xe9\a\x99\xadn$\xfar\x88\xd7B\xb9\x10\xf4q\x92\x0e\x12\u007f\x84\x99J\x00\xe7\xa6#\x1cZ\xb9\xc8=\xa4\x10[L\xafP\xdf\xf8E\xd2\xed\x12\x8e]mA\xafA \xd0a._\x96k\x80M\xe5a\xc7J\xf3\x84\x1d\xe6Z*-1b<M\x15\xa5(\xa0\xcdޮSa\xcc\xe0\xe4\xb2ς\xfeUX\fb\xa0%2\xaa,\x16\xab\xacF\xe0\xef\x84ЕQ\x99\ue2dd\x9e\x1f\xf7\xd5P\xc5\f\x8c@]\xbaȬ\xdc\xc08\bl\xe25$E\x8a\r\x00\xa1\xacD\r\xf3\xa6\xa1\xfe\x92\x12\x19I\x0f\xed\xa3\xdaʨ1\x9c\x13\xed\x94\x14\xb1I\xd5'\x88Q\x9a\xe3\x1f\x8dOL&]\xc9&\xc6az(8\xb4\xd8K\x8f\x05i\xf1\x96\x1e\v\xd2b,\xe9\xa6P\x9a\nf\x8a<Oa\x03\x99\x15i\xc5;\x90\xb8C\xb2\a\xf9\x84=\xd3=\t4M\xd3<\x9bئc\x9c\x8a\x8f\x8d\x8cj\x1e\xf22Q\x90Cx|\xf8\x06\xd1,B2l\xd0Bs\xc8\xc6\x10\x1a\x82\xfa\xc4D\xd3Q.D\x1c\xb4\a\x1a\x84\x8a}\xf6\xc1D\xbfx\xe7q\x19=a\x90\x01\xbd\x951|\x88c\\f\x14I\x9e?^jx\xf5a-4\xdcj\x15C\xad\x15\x99\\`\x9c\xb6\x14\x19\xd4\x14\x8bDm\x04\xc6\x1dM\xf8\xb0#\u007f\xec\xafZ\xc4pK\v\x12\xa2=ϔ\x87٪\x14\xb4@2\xae\xd1\f\x1e\xb0\\B\x8c\x0eu!\x13\xf6\x03\x8c\xe78/\x0fD\xa0첞\v\x1en\x9a\x18\x852\x8d\xccH\x12q\x88\x874\x92\xf5ɪ\\\xa5j\xf5|\x9fk\x10ɵʌ\xd5B\xa2\\\xc1\xa3Q`*\x16\x90\xe2}\b~\x8b\x96\xb0\x116\xae<\u007f`\f5\xd5\xcc#\xde\xdf?֟\xc0L\x0e\x10 ̎\xca`\x82n\xa2\xa2^\x8ft\tﮒA2\x14|\xa3\xf3\xfd2\x1c\xaeJT\"\x8el\x90\x804\xdc3\xaf\xc1\xe7\xd4*\xea\xffR\xf2\x1e.\xc1\x82\x1d\xba\x11O\xf7\x0f\xf0\xe8\xf1\xbc\xa3\x03L=LB\xbb\xb7\xf0\xb7\x11E\xaf\xc75d?2#\xac4K)\x16x3訮\xb4\xddu\xa2\xc6\x1c\x9d*jܛ\x939?\xe1\xa9\x0e\x9d;GQI<\x9a\x8f\xa90V\xc6\xffNU\xfcpo\x95\x1e5\x1dyi(n\xe1ȗ\xc9\xe5B[I\x8dʛ6\x83\xc4'\xb2\xce/\xae\xae\xc6\xe2\xcf7\xb3\xb1\xdd6\x1b\x1e\xeb\x11\x13\u007f\x17\x1an\xa4y\x18\x93\x82b\x11\xafe\xb6\xfa\xaa\x92\xf1\xc9(\x91\xe6\x81Z\xb7\xc1{\xe1\x1fw\x9fG_w\"v\xf5 i\x95\x13<CI\xa7a4tzoБ4\xf0\xc7\xdd\xe7Q\xf9\xc4'\x89\x97\xe1|\xf8\xc4t\uf181X\x03\xd2>\xffzm\"\x0eW6\xfb\tV\xa6\xe3\xf0\xe1\xb0\xf0C\x9b\x8f\x1d\v\x8fc\xc8\xd7\xcbQ\x1d\xd6\x1b\x95I\xabh\xc54<\xcc2A\x14j\x9aQ\x81Z$a\x96\xac\xbf\xe1\x15\x14\xde\x18y\xf3\x8aj\xe9; %\x1a\xfb\x9a^}\n\xacLg\ue857\x84\x9b\x84\xb76\xdcc4\xfe(\xb3\x04\u007f<3T\xa7\xa7\xe6\x17L\xb4(`E\xbe\ab\x95-\xe5\xea\xab@\x97\xb5\xf5\xc1\xa8\x04\x96\xa2H-U\x91\x9f\xd6*E\x16\xae.\xdaݹ!\xdeu\r\xfe=6#\xef{\xaf\xc1\xa7\x92W\x80\x83\x9c\xceU\x99S\xb2e\xa3iݔ\x93\x04\xfd\xaa\xbcvm\x8e-!П\x00#Ge\xfeZnG\x97\xa4'\x93\x173\x95\xc0m\xb1H\xa5Y߳\x00G\xe9\xf42\xad\x0f胵Z.\nT\xc2\xe9\x01\xdeC\xa0\x83\x87\x91\xba\xa2\xf4\xb1\xd8X\xa2\x1e\xb3G\xa1\x93\x0f\xb7hg\t˲\xa3\r\f!\xcb.%\xa4\t\x91\x8f\x86\xdaC\t\"\x97\u007f\x806\xe4\x8a7\r\x04\t\x82\xdb\x1d\v\xbd\x18L\xd0݄(\x1a\xbc\xff\x1eOq۫\xf2/\xeb-Q\x18\x9ch\xfa\xe4}:\x13\xe2\x8dUf\x85\xcc\xfc\xba1\xd4\x10\x88~\x13\xb9\x95\xc6' 8\xe0^\x9a\xdb:\x83̈́`%\xcd\xe7L\xc7I|\x15\xf7\xf9\xa9\xdf\xe4\x85a\x93\xdbgB\xd9*/o+$\xb2\xf0h+Fu\xc4ɿ\xe1\x8b\xdcȱ\xfb\xaa\x91\xeff\x89\xceb\x9d\xa1\x8f'-8X\x123Z\xe8\x15\xd8?\xff\xfc\xfd\x82b\x15\x1e\x1f%\xadN\xf4\x1c?\x97\xce-Rx\xfa\xa32\xa3\xb0\x81q\xa8e\x15\xbd\xd5\xdb{\xb0N\xb1G\x9e\x00lL\xec\x83e\xaa\xe2\x87q\x03Z\x12a\x85\x99(xt\xb7\xf6\x8f\x1f\xa3GJ\x90ohUUo0\xd2X\xc8\xecؙ\b\x9c˃\x1d\x9d'\x93 \xf6\xfc\"\xfb\xeb\x83\x1a\x8d\x8a\xa4\xbd\x83\\\x8d\xca礮\xaa\x15x\xdc\t\x19\x19re\xe4DKo%\xd5Q0I\x1c\xda\xe1\xacFCŴ0\x16\xf4\xb8Q\xf6\x90%\xb9\xc2\xd5\xf68\x80'/\xbf\xa0hw:\x1e\xee/\b\xcfH\xf1\x16S2\ueb95!\xb5\xdf\xf0A\xddI\x10Ȏ/\xf6x<\xa5#b\x804#\x87Q\xc5k\x91\u007f(\xec\xfaF\x9aXm\xc1\xe7M\xa3\xea\xbf\xcd\x16\xee\xeb\x1a@\xe3o`\"1\xbc\xee\x8fi\x15\xd9u\xe8\xb7\xfa_cK\x11;\xec\xfe\x9c\x95\xcf3\xaa\x12`\xa0\xe5gi\x90ϕ\xb6\x82V\x1fg\x8eFb6\xc4\x11`J\xbbi\xed\xb6\xb9\xad\xb0t6O\xba\xfc\v\x8b\x1dW%\xf7@\x8f9>\x9c\xb1D\b\n\"\x91/!\x1bWϺ(m\xa7\xae\xd7;\xa3lQ\x82\xa0|\xb5\xfbƱ\x88#\xdf\xdbzk\x87\xe2u*$:^\xc3K\xde.\x17d\x1b\xa6\x03\\\x1d\xcej4\xecX+\xab\xb2\xcb\xf3\a$3\xcal-7;\x1a>(m\x1f\x95\x9e \xf8\xe0\xe2\xd2\xd29ú\ar\xad\xca1\xf8\xad^lVJ\x1d\xab;\xbf\xbc\x14r.}\xc8MD!\xca2\a\x99\"\xd4\xd7\xd4\xe0\x95\xc0~\x80@\xf1\xf15\xf8%\x87\x1c L\x9a\xc8\x01B$\x8c\x1c\xc0/u\xe4\xf5\xae\x82\x1c\xbd\u007fN@\r\xf4\x84\xf8\xe39ȱ\xf9\a\b\x90\xa9t<\x11\xbd\x16z\r>6\xad\xe3}x_\xb6O2\xfd\xeb\xcdЛ\xac\x1f\xcf\xe2uG\x1e9\xaa\a\xe07\xa2\r\xfc\x13C\x87\xd9W\x140Y\xf4\x00A\x9f\xb2(\\\x02\xe9\x01\x02\xef0\x14\u05cf\x02\xa5\x97\x1e \x18\xfb\x8eX\xaa \xed*\x10\x8e\x85KR=@hF\x120q\xf5\x00\xc1yI\x90d\xd6\x03\x04\xdf_\x98\x04\xd7\x03\x9c5\xab\xf3N\u007f=@@N\x17N\x88\u007fo\x02x\x80\xcd\xd4.q\x162k`C\x84\x1b\xb0Ȁ\x046DtOĆ\x88\x13\x9b9\x03C\xc4ˆ\xc2\xdf\xd5\x03x\xa8\xa5\x81\xaa)\x15\x89\x84\xec,J\x8eD\x11<\xe5ҫ\x1b\xebK\xf0\xe9\xcd\xfa\x12BqT\u007f^z6\xc5]\xbc\xb8\x9e7-MV_ţ\xf1F\xed_\x1b\xcb\x01\xfaW\xa1\x16\xcfvT/\xfcJ\xab\x82䅛\xa9\x13^\xc3J\x1a;A^\x9b\x85L`z\xdf\aZv\x82^\fM\xa4\xc3l\xe2\x1c\x1a\x9c \x06H\x8c\xc5\x1d\xf4b\xd4\xc0\x88\xa9RF6b5A35x.Ǎ\xbe\xee\x05\xf6.Rj\xec zN\xb8 \xc0\x94\t\x17\xb3j T1,\xf4\xa8\xb1\xdb\x0e\x99X\xa4\xf0\xf9\xdb\x05< +a\xe1Q\x8c/\xe0\xe5ZY\x88KE\xf8Fm\x84\x1c?\xf5\x90\x99\x1c\x1a\xa6drƤ\x1f3\xb1Hi*\xbd\xe7\x85Y\xa5\xc5\n&\xe9\x0f\xbc[\xfbv\n9\xc0<\x1b\v\xe3\x17/\xad\xf5\x84Y\xb5\x1c\xddqQ\xbcŤa\x05\xf8\x91\xd5\u074c\xf6\x1e\x92\xbc~\x17\x1bh>\xcf\xf6\a\xdc\xca+\x8c\x19\x9c[yaa2[\xf7t]\xb5&\xec\xe9M\u007f\x05jYD\x8d\x9a\xe1}q\x89o\xac\x1e\x90\xfb\xb1N\x92F}X\xda\xe4\xe3\x17\xb1!\x9f\xda\xd6\xe4k\xd0p1\x99\xac{E*\x95\xf1\xf3\xe8i\xa5\xaf֟\x10Q\xa9q\xf8\x13\xe6\xd4\x12\x82\xe9\x89dA\xd9\xe9U\xc54\x11\x03H{\xc3\xca8\xb8/\xb9:D\u007f\xbb\xd9XQ߀\xf8\xb1\xb5\xe9.0\xe6\xc3҂\xfe$3i\xd6._\x81\x13\xff\xdd\xc5|\xb7s\xbc\x8a,l\xf2T\xd8n<p:\b\x87\x1f\x95\u0089\xcc\xc0\x98_Eg\x03\xc9^uҕ\x8f\xbb'\x199\xb3\x89\U00092ada\xd3\xe5k\xd0\xfb\x8c8*\xc6\b&\xe5JPK!\xd3B\xc3\xf7\xb5\x06\xb3Vi/*b\x10\xd1\x1d\r\xebҀ\xe9\r\xa4\xe2\xd91rl\x98\x8d<Ȭ\xf7\b\x9co\xc1E\xdat\x9e,\a-U2\xe9\xe1\x18H\xab\x12\xd4}\xabcħ\x8d\xb0\xf1\xfa\xe3S\xae\xebb\x98N\x02\x17\u008c\x84\x97\xe4Pf\"\x82\xd4P\xeeG8\x1c\xa2\xd7\"[\x91\x16\x18\xe1\x15m\x98#\x89KC?\U000ae99d\xab\xfd%\x84\x17\x06p_Ya\xff\x17\xb1\x00\xb7\x82\x97\xf4\xce3\xc8\xfb\u00884n?E\xc8\x06]G\xd7ς\xdc(\x1e\xc7v\x9c\xc8\x03\xc3j\x1c\x99\f\xea\xd60\x8c\x055\xb1;3A\xb0\x11\x12B\xba\x10\x95+\xd3pa\x17Ό\u0099^ܾÙ-P\x18\x82\xf3ɇ\x12\xe7\r\xd8\"\xbf\xa9\x1d^\xd7u\xb7\x82;\x95vHF\x0e;<\x9e\xf3\xaeH\xc7\xd2\x12~ժ\xc8\xcfX\x9a\xcfTv\xb7KZ\xfdq\xf7\xe5\x8c7ڤ\xd6Vv\xc5\xf3\xdf\xe6\x19oq\vzq\xb6\xdbsa\xc6W\xf5'\x8c!;\xd8\"\xbf\u007f\x91\xc1\xd5m\xa8t\xe5C߅y\x18\x83\x01\xad!\xdd\\\xaf\x85\xee\x8dTp\xbe\xc7\xfd\x8c\xa1\xcd\x1f\xe5\xc4\u007f8\xc9\r\x8e\xa8\x89S\xe4\x96J\xc7poݣ\xb8\xb1>-wG\x12RЩ\x84-\xb7\x99E\xf6\xfc\xcdыv\x85Wخ\xd0\xc1\x02\xd5\xd6?i\xe5\x18R\x85W\xcds\xa1\xc5\x06J\xf1\x01\xe3=\xa49s\xf0\x9eB\x92J\x8c\xb7\xc8#\xed\xf1hk<j\x80\xfb\xf6\x1d\xb7\x8dV<\xfb\x1f\xc8\x03+r\xc1\xcdA\xd8\x11\xb2\xc2%\x1ecq؊\xc6\xd4\xc1\xdc!\x8eW\xe8\x94\x064\x8dY\xd6<\xc8\xfcZ\x83\xe8O\xd4t\u007fb\xca9o \x85\x90s֮Ư\xa5\xd03͓\xbc)\x97v\xf7\xce\"q\xb4\x9e]\xab\\\xac\x84{\xa7O\xe4\"\x83=\xfa\xb8h\x1c\xac\xacb\x8a\xc5`羛\xfb\xe3S\xeeh\xd0F͏y^\xf6\xf8u\xb6O\x91\x9b2Գ\xb7\x90\xbaP\x1d\x1c3\x8a\xe9\xe4\xd1|L\x85\xb12\xfew\xaa\xe2\x87{\xabt/%a؋{\xd0\r\n\xffP\x8d\x83\xb1\x11\xba8\xf1\x1a\xc3\"p\f\x02S\x89\x1eqz\xeevX\xc7\xca\xf2\xce\xf4(\xfe.4\xb8\xb4\xb2\xc0`X,\xe2\xb5\xccV\xae\x01\xfb(4K\xa4yp\r\x99BO\xfc\xe3Ωf.jށ\xc8\xcd\xc5\xf9\x8e\x9et(\xc2q\xc7\xef\xe6z\x9d~\xf8\xe3\xeesP:\xf8$\xbb,\xed5`\xe8`8>\x84\v\x18G\xe1\x80Y\v\xed\x1c=;\b\x8f;|\\\xffO\x9b͆\u0083\x18\xf2u\u007f\xdb2T\xd0\x06\xa2P\xc19\xf8\x04ݓV\u0382\xb54\xb4\xe0B\xbb\xe8M\xa3\xe2ﱖ\b\x8c\x1db\xb8\x90\x04\xf7\x9a\x01\x83кc6\xbf;\xfd\xca,\xe9\xff\x9c3\x10\x97\x87\xa6\x87w\x8e\xb4\xefN\x10w6{bn\f\x999;\xacV\xe6\xfc\xb8\xcd:d\x11\x97\xb9J\xcbT\xc5:\x9b\"t&*\xfaÇ\v?t\xce$\x1d0\xf4Е\xeb!c\xd0\xdc\xd3M1/\x80;\xcb12(\xb3\xd1ұ!\xed9\xe8ϙJ\xe0\xb6X\xa4Ҭ\xef/\xe1\xc1\x1c\xda&\xf6\xc1Z-\x17Eg\x0e\xca\x01\xce18\x17a\xa4\xa80=\x14\x19\"\x1a6\xf1\xdb\u007f\x04\xf8\xb7\x1f\u07fb\x89\x16\x0fA\xed\xc3D\x8a\x8a \xf7T\x1a-\x06\x03\xdf\x05\t\x1d\x88q\xb1r\x97O?!\x1ar{\xf4\x06\"\xe27\xa9\xcf\x0fq-ZϞ\xd1H\t\xdde\aMIXA~z\xf1\xdcyb\xd8\xe4\xf6\xf9F\x86MɃD\x16NA|8ã\xfc\x1b\xbeȍt\xaa\xb3\x85\x8eDv8\xabe<\x03\x1bZZ\xbckg\xb3\x15z\x05\xf6\xcf?\u007f\x9f\x91\xef\xe0\xf1Q\xba5\xb28\x87\xed\xbaSC\n\x8e}\xfe߯\xc2\\\x9b+f\xabݱŽ\xed\xe7g\xad\x1c/S\x15?\x84u\xe8$\xc2\n3\x90\xf3|7\xf7\x8f\x1f\xc1=\x13\xce'\xb6\x8a\xe1\xb6T>\x8d\x85̆\x8e\xecy\xff\xb1cy2\bbL\x1fYS\u007fX0,\x93\xf6\x0er\x15\x94.\xa5\xae\xd2\xd5\xc3g{kȕ\x91\x03M\xbd\x95\xae\x86\x9eA\xfc\x90\x87o\vv\xb5u\xdaq\xd8(\x18Ȓ\\I\x87x\xfa\b\xcf;f\x14\xad\xe2~\xaf\xfb\x03\xeb'\xec~\r\xdc\xf9\xee\xd7\xca8\xe5\x1d`\xae~\x90\v\xb2\xe1\x9f!\x04+\rx\xe2\xd2\x04v\xc3\xc5k\x91\u007f(\xec\xfaF\x9aXm\x01\xc3\xf3\\\xe5\xddf\x89\xfb\xba H\xf8\x05\x06\x123\xea\xcaYV9\x9b.q\xb3\xff\x15\xfa\x15\xd8a\xc7\xe7\xacdǎ\xf5D\xe7g@ɕ\xb6\u00ad\xa6\xcf9\x18\rX\x91l\xfb\xb9k\xceceֺ\xadn}2\x16.\xff껍\xab\x92:z\u007fs\xfc1\xa1\x9e\x84\xa09\x97YX9nV\xd2V݃x\xc2\xe8V\aA\xe1j\xb7\xc7Pȓ\xefu\xff\xda`y\x9d\n\xd9\xeb\x9f@\xc9\x17\xe5\x84\xefTG\xde\u007f[\xb0\xdbX+\xab\xb2\xf9\xd9c\x92\t#k\xcbŃ\x9d\xbf\xd2\xf6Q\xe9\x01\x8c\xf7\xb3\v\v\u007fw\x11ӹV\xe5o\xfa\x97\x9emԔc=\xadh\xe8\xb8)dI\x0e\xda\"\x11\xad\xe7\x11\xa9M\x92O\xcf\x02bk$b<J\r\xd4vHӶ\xbe\xa26?\xf28*z{\x01|\xab#R\x93#Bd\xdb\xf1@lc\xa3\x91*3Q{'\xe1^\xb0\x97\xa3Pg\x88\x88\xb1=\xc0\xfb\xe5a\xf8@\xd80\xebF\x1e\xc1\xb1\a\xf0b\xa5\x11=`6\xd8\x0e|ڲQ\xc2i\x0f@f?\x11\xbfB\x18\xa0\a\xe5\x1e\xc0\x97\xd0<\x02u\x0f\xe0Mk\xa4\xe0݀\xeb\xd3\x02z\x83m\xc0\x8f\xd4\xd1\xe1\xbe\a\xf0\xa0t\xba\x10u\xee\x02\x11a1l\xbb\xdb\xf7+4\xb0\xe2\xe3\n\xac\xf8\xbc\x05V|\xde.6\x82\xe2c^\x94\xf0\xfe\xae\x1e\x00!v\x13\xb3ߊDB6J\nM\x14\xc1S.uU\xc5ұ\xdb\xd3K8\xe2\x13\xff\xfa'a\xbfx\x0e\x81\xe7\r\xa3%\x17\xa1\xa8\x1a\x8d\x8b\x83\xe5\xff \nK\xd5\xf6\xd4P\x06\xe8\xbf\n\xb5x\xee\xaf;\x8b\xa1\xa2\x95V\x85\x93\x95\xf5L\x9c\n\x1aV\xd2\xd8\x01\xe2*-d\"\v\x9d\xfd4H\xad\xa3Ƴ2a\x04h}\a\x8e\x0e\x98Pد\x17A\x1d/C\x85|\xb9T\xc3F\xcf\xfa\x00Ϯ\xed\x12P\xf3ΰV\x9cR\xa1\x83x8\xc0\xaa\xed\xe7s(\xe8V\x11\\\xef\xafB\x97}3\xb1H\xe1\xf3\xb7\x190\xa4\x95\xb0\xf0(\xc2?\x98\xb9V\x16\xe2R\x10\xbeQ\x1b!Ç\xa22Q\x9e\xfe\xb9#Q\x1a\x93~\xcc\xc4\"u\x13ɑ\aX\xf7\x93\x1f\xa4\xde\xf2\xbeW\xfd\x00|\xde<\x1b\v\xe1\x93\xe7k9g\xd2\x12\xb6;*\xef\xd7H\x1a\xd4\xef\xffeuV\xc1\xf8\xa5\x93Us\xb6\x813\xe7Qn\x88K\r\xba\xaf\xc0\xa5\x06w0TU\xc0\x01k\x86\xbbs\x9d\xfa-QA#\xd2g\x17\x88y\x11\xe2\xce`o\xf0\xf1\xd4&\x0f\x9f\x14\xe5\xfc\x95[\x93\xafA\xc3l\"\x8b\xf7\x82\\*\xe3\xe7\xe0a\xc0\xaf\xe6\x1f\xf0\xe2]\xe3v\x06\x8cav\b\xbeqD\xa3\xb3jsd\x85\xb6\xdf\xe5\x06\xbe-\x97\xa6K8t8Ykӝ?\xe6\xc3҂\xfe$dZtu1r\x13N\\\x84\x91\xd7+\xcbL\x9au\xd7\x01\x87[\xba\xaf\xf7{\xb8\x0eQ\xd6\xc2&\xb7\xe6\x0eJM\xdf\xc1\x10\xe9.Ł\xd6\xfd\xc1:\xee\xc9g!3ٜ\xba\xbd:\xce\x16\x84\xee\xae\xea-\rM\x99\x9d\x93\xb4\xfe\xe3\xe9/\xbc\x8a6`\xb5\x8cͫ\xbf\xee\xbbž\xfc\x87\x96ٍ\x15\xb6x\x85\xa3\xed\xb8+b+\xb7\xf0]˓I\xbe\xdd\xe4ׅ\xb8\xf9Z\x98\x13\xf8Њ\x01m'r\xbc\xbf\xd7\xc7R.\xd1\u007f\"o\xfeX\xa5\xf4%\xbfDV\xef\xd0c\xf78\xee\xfer8?\x11ǐ[H\x8eZ\xbbW\x8d\x9d\xa2\xff\xf8\x8f\xea\u007f\xf2\xb4\xd0\"\xdd\xfdo\xc9\xd5d]\x84+\xfa\xff\xfd\xff\u007f\xaag\x85d\x17M[\xff\xf1\xea\xeaꧣ\b\xdbH\xe4\x12\x9e,d\xd5/~~\xf8\x1f\xe6g\xa9\xfe\x9f\xed?\x16`\xc5?~\xaa\x97\xba.\x8cU\x9b\xa6\x15\xfe\r,\xab4y\x95\xfd\xb4\x01+\x12aE\xb5\xd1,SV\x1cU\x00\x8bUf\xb5JS\xd0W+\xc8~~(\x16\xb0(d\x9a\x80\xaeVh\xd6\xdf\xfe\xbf?\xff\xe7\xcf\xe5\xbd\xc6\x1a\xaa\xe1\xe5\x03c\xac\xd8\xe4\xbfDY\x91\xa6?EQ*\x16\xd0`\x86\xc8\xf3j*\x9d\x81\x85j\xaf\x15\v\x894$\xe6\xe1Y\xe5\xe5\x05\xd5\u007f\xb1\xd5}\xfd\xbc\xff\x87\x9f\x13\xd8\xfedr\xa8\xea\x16\x1e\x17 +\x91G_\x97\xefv\xbd\xf5\xab\xe8\u007f\xde\u007f\xfb\xbd\x92\"\xa2\x9f\xeb\x9b\xf8\xf9p\xcf\t\x98X\xcbJ\a\xfb%\xaapb\x87\xedտ\xd6K\xdf\x1f\xfe\xf0\n\xd9N\xcd-\x8c\x91\xabl\x03M)\x8f\x17+\\\x17ZCf\xa3\xd7?\xaa\x17\xfa\xf0\xea\xaf\x0e\xab\xd5oP\xfbBG\xff^\xaf\xf1\xc7\xe1\x0f\xaf\xa6\xaf}\xd1\xd1\xcb\x13\xae\x87\xbd@\xd5ꔪ\xffO\xa5\xb1\xbf\x1d\xfe\xf6E\x1a\xfb\x02\x8b\xed\x81ƌ\xccVE*\xf4\xee\x8f%2Ǫ\\\xbe\xc2tS,\x9a\xd0G\xf3K\xf4\u007f\xfe\xefOU\xeb\xfe\x1dF\xfdC\xa4\xf9Z\xfc\xe3\xf0\xb7ݵ\xd6\xdfs\xf4\xcf\xe5\x9ck؈\x86\xe0U\x0eه\xdb\xcf\u007f\xfc\xd7\xfd\x8b?\xb7\xf1\xae\xb6@\xf5\x16\x06\xf3\xb6![\xcb\x0f\x8fɪ\x87\xb1DQ\x83\xd1\a\xe8`\xb4y\xaeՓ\xdc\b\vwEf婷\xb7\xe3}<\xc2\xc1\xb7\xe3Ze\x97>\x99\xa5K\x04\xe8}\xac;\x9f|\x97\u061d>\xc1\xa7K\x0eh}\xff\xdb\xdf}\x87\xa7\xfa\xd4;\x0fO9hY\x9e\xfcI\xab@\xf7\x11\xf7\xe5S\xf4\x1crO.D\xcf\xe8\xf6.\x84=\x03\xdb\xd1\xc2a`\x87\xde\xdf3\xba\xe1*>'VH\xca'w\xa0F]\x96&\xbd\x81T<\xb7F\xb1y\xc8I\xc2\xc6\xebo9h\xd1R\xc0\x93L\xdb\xcezH\xbf\xfe\xf1\x96!\xbe\xfd\xf4ųm\x13\xb6{9Iu\b]v\x95\xde\x19\xeaJ\x1f\x1df;\x17\xed\xcd-\xf9\xc9I\x89qJbr\x9a\xa9\xaf\x95\xa8\xd3$}\xaa\x9e\xf3$\xbdF=\xa7\x99\x9c\b\x1d1_+\xd1;\xcfѣ\vv\xbfD%y\x9c\xfc\x87=^\x9f\xfc\xd7=Ά{\xab4\x88Df`\xcc\xf5\x1a\xe2\x87I\xb8\x89\x8bŦ\x9f\xe3쵩\xf2\xf4ZI\xb6\xc7f\xe3|\xef\xed\xea\xbd\x13\xf7\xaf!̇\xa7\xc2\xd8\xea\U000be7d4\x10_.\x95\b\vW\xa5(If\xbc\xa0\xa5JF\xf90\x03iU@ԇAoJz\xfa\xf8\x94뺴]\xa7!\xce\xc1y\xecn\xf7wr\x16#lڪz\xf0]\x12\xef\x10\x93\xf6Y8\x1bpv\xab\xe3b\x82\x1dݨn\xe6\xfc~\xc7\xf0\xd5\xfe\x10;\u007f\xe6h\xeaw\xd9}\x85}_\x8e\xec!\xa7\x01__\xdd\xf1\x9c\x1d>\xa5\xf7','\xb1\x9c\xe43\x87\x97\x9c4\xa4\xc0\xf3\xab8\xd9\u070e.\xef\xf4\xe2x\xefi\x9d\x8b\f\xb3\xac\xfd{\xdf\xd7\x1a\xccZ\xa5\xad(\x10\xe6\x9d\x1f]b\xea\"\xfc\xde\xd3\xf32\x80\xb1\xf4\xc4\xd2S\x90\xb5Yz\xda\xc3\x19KO\xc4'\xaa\x9dt\xbb)ȍ\\;\xd1хD{\x88\xd3\xe9t]\b\xd2i\xa2~\"t ?\x14Bt!m\x1f\xb1u\x91Y/\x81\xf5\x8b\xea\x9d\xfb\xeb%'\f!\xf5\x9bWIQ\x0e%\xee\xdb\"\xbf\xa9\x83ϯ\xeb\xce\x15w*\xc5y\xbe\x8e\xe7\xb8+\xd2\xd0RޯZ\x15\xf9\x84\xd2Y\xa6\xb2ƻ\xff\xe3\xee˄\x1bi\x94\x8d\xa3h\x87)\xb71\xe1\x16\xb6\xa0\x17\x93-߭BU[\v\xf96\xd9\"\xbf\u007fQ\x85\xe2tph\x1f}~\x17&\xa8\xb9y\r\xe9\xe6z-tk\xa0c\xef9\xefg\xf0U\xe3ʉ\xfe\xe8|\x97zP\xc2MP^*\x1dý\xed\xcf\\\xae7\xdc\x1f\xcf\xde\x1f\xde\xe7\xf8P:\x84\xf6\x89\xec\xf9[O\xc4\xfc\x95\xbb@|\xe5\x1c\x12Ym\xed\x93V=ij\xee\xaa\xca>\xd4\xce)\x03\x00\x17\xff\xef\x1e\xfd\x8fR\x1d\xdc3nzc&\x8fWw\b\xb2w\xfaa\xff\xf6z\xb6\xe5,\xb8\xb73\xf4\x03\tw\xe1J\x102v\xac\xc6\xec\x8e9nX\xe3\x8c1\x88\xa0W\xaf#\xef,\xed0\xacY\xc6<\xc8\xfcZ\x83h/\xfe\xd2\xcfB\xcb9n \x05\x9f9\xeat\x84\xaf\xe5\xa3:\xecӱ)\x97\xe8ϼpđz6\xadr\xb1\x12\xfd]\x1f\x1d'\r\xf6\x18\xb9e~\xb9\xbe\x91\xa6X\x04;\xb7\xdd\\\xa5\xc6\x1e`>\x17\xb6\xb9\xbf\xf7\xc9X+)B\xcfGV\xad\x13\xba\x82\xaa\x80\x8f\xe6c*\x8c\x95\xf1\xbfS\x15?\xdc[՞\n\xe3B\x8e\xfd\tbN\xf7\xef\xd4t\xd55k\xd8M\x9cr!-7\xc2r\xe9f\xe1p\n\xfd\xf6\x97\x9e\xae\x15\xbd\xf8-\xfe.4t\xb5}q\xb9\xf1X\xc4k\x99\xad\xfa\x92띮=\x91\xe6\xa1/=\xcfy\xa2\x1fw\x9d\xf5\xd4\xdd\x1c\xb4a\xd09\x88w6\x14\x82\xf6\xe3Us\r\x9d?\xf8q\xf7\xd9\v\xef>\xc9S\x96\xb0\x1a\\\xf0.\x1c\xbd\xba%\x9f;ݑY\vݛY\x1c\x84\xf6\x0f\x9bn\xffI\xb3\x19\xea=Ő\xaf\xdb[\xb499\xdd\x1c\x8a\x86\x8di\xd3\xee/\x001*)6\xb8\xd7E\vΛr\xca\xddwռ\x02fG:\xe9\\}u\xc1\x82\xd0LOe\xaf~z\x90YҾ\xcd\x11ţ\xd0\xf87S\xa4\x99\x8d\xa0\xd5k\x16q9a\xc7*Fa\xa5\xe2^\xe6|֡\x12nU\x88pՇ\\\x8d\xb3\x91s\xb5!\xe7\x0f\n\x17\xfe\xd0[M(`\xe8C\x90\xa8B\x97\xd2C.\x1c\xaf\x9fT\xdb;\x9e;\x11\xa9\x96=M]\xc7\xd4;2\x95\xc0m\xb1H\xa5Y\xdfϙч\xd6\xcd?X\xab\xe5\xa28\x193y\x80)\x83q\x1c\x94\xb4\nӨh\xee\xd0t\x8cߤz\xef\xcem\x92p\xfe0l\xaf1\x94W\f\xddGl0\x9f\x9b{G0g\xc7ۻ{\xd7)=\xb9p\xc8F跅\xc47T/-\xe4ܸ>Y\x83\xa1\xb2s\xc7+gLv\x15\xe8\xc6\x13\xd7z'\x82Mn\x9fo\xa4_(6$\xb2\xe8\fZp3xȿ\xe1\x8b\xdc\xc8\xceZ\xb1\xce\x11I\x1d\u07fc\x8c\xcf@\xe7O\x8bY:C\uab17?\xff\xfc\xfd\x8cl\x80\x8f\x8f\xb2\xbb\x19Ϙ\xdb\xe9Ǿ\x14zz\xc4\xcfO1\xa9չ\xb3\x95\xbe\xd9\xc2V\xc1\xd0J\xc82U\xf1\x83\x9fA5\x11V\x98@Λ\xdd\\?~x[\x14{\xbf|\x15\xc3m)\xfc\x1b\v\x99\xf5\xf5\xc0\xce\xd7\xe7\x9e'A.n<\xcfh\xbda\xf2\xadK{\a\xb9\xf2\xc2w\xa9\xabt\x1a\xff\xac\x15\r\xb922\xd0T[\x19 C\xb7\xff\xf8\x0f{&_A\x9d\x86\xe1\xe7Մ,ɕ\xec\x88k\x8b\xdci\ue33c\x91\xfd\xe7\xbf\xff\xf0v\x02i\xd7Tz\xeff\xadLg\x1c\x9f\xcb\xd5\x049P\xeb\xcfN\x1dX\x89\xc7II\xe3i\xa6\x8e\xd7\"\xffP\xd8\xf5\x8d4\xb1ڂ\v\x0f\xe8\x93s\x9a)\xef\xeb\x84=\xff\t\x03=kuF\xb6U\xbd\xa6\x0f\xb7\xd9\xfe\xf2\xafCP\xdd\xde\xe7\xacdC!\xca$\x8c\xaa\x18\xe6Jۓ\xb5r\x0f0\xae.ǂz\xfd\xa3J;\xadngp\xd6%\xffj;ū\x12\x1b[\xff\xedx\x93T\xd6\xe7\x15\x1b\x9f\xf9\xbd\xfbg\xf5Z\xef*9\x0f\x1f5\xd3\xf1P]\xed\xf6@\xbd\xcc|\xaf\x03\xd5\x06\x8e\xebT\xc8V\xbb\xa0ӻVN03\x9db\xbfg\xf2)\xae\x95U\xd9\xf9\xe9\x93\xc9\b\x919\xe5\"\xe4sS\xda>*\x1d\xc0\xb8vva]\xb3\x89\x8cʵ*\xff\xad}\x89\xb3\xf5B\xf7\xe4iG\xa1\xfdЎ\xa9u\xb8I\x1dwI\xf8)\xadC?\xaa-\x1dڏX\x03\xae5\x1d\xb59\x1dΡ|\x00l\x0f~\xc2\x11`\xfbﻷ\xa9Cv\xeaGx\xfc\x8f\a\xb85\xab\x1b,\xe3\x18\xd7\aϕc\xbf\xfc\xb5ә8\xc4\xf0\x1c`\xbe<\xc1=\x00\xc7o\x9d\x88\x10\x94s\x00\x12+\x8a\xf0\x81:\xde+b\xa9?B\x86\xf1\xbc\xde \x82\xbc#\xe6\xce\x11)\x18\xe8h\x83D\xc4'\x04\b\x1d\x80\x8c\xfb\xa8\xa0\xa1\x00\xeb\xe1\x02\x89\xbc\x17\xa4\x91\x9as\x98\xd1\xeb\xfd\xa1(\r/\x04\x9c\xcbÎ\x98ܭ\x95\xf4\x9c\x1fG\x16\x98Y`\xee\x1d\xc0\x02s\r\xe6E\xa9\xaf\xef\xea\x01\x1c\xc4<dTw\x91H\xc8\x06\tU\xadz1ɺSNO\xd5ݗ\xe0Ҍ\xeaԾ\xdc)ѝ\x06\a\v\xceu\xa2\"g\\\t\x16O\xeb\x90\xc8]ۑ\xa8\x06\xb4\xbf\n\xb5xn\xaf\xd3ソu3\xbd\xf31^jXIc\x03ĥX\xc8D\xe6\x1b\x1d\x1c$7\xb9\xb1Ȏ\x10!S\x9f]\x8f\xc1\x96\x8amz\xe1e\xa8\r\xe5\xd2类\xe5<\xcb\x03<\xf7\x95\x1bt\x9a\xe7\fk\x1c(\xe5\xeb\x04f\xc7\xfa\x0eF)LP!t\xeb\xbf\xfa\x96-0\xb1H\xe1\xf3\xb73 ܕ\xb0\xf0(\xfc\x19{\xae\x95\x85\xb8\x14\x84n\xd4FH\xff\x90\x1cF\xf6z\xf3&\xfd\x98\x89E\xda-r9\x1eD\xddV:H]\xa8\xdd\\\xb7!\xf8\x9ay6\x16\xfc\x93\x8f\xeawt\x94R?;\xaai\x97 \x1b\x94k\xffE\xf5\xcdd\xfe\xd1iE9[\a-\x97\xae8\xbd<\x97\xae8\xfaY\xb7 \x15\xaa\xeaD\xc0\x1ac\xfd\xd4Z\xf3J\xe5\x15\xe9vv\x01-\xb3~V\x83\xbd\x15\xc7S\x85\xe8\xb1ֻ\xfb\xad\xc9נ\xe1l\"\xa4\xf6\x82@*\xe3g\xef\xf0\xa6W\xf3\x05\xbc\xa0\x00=\xfd\\c\xaf:\x9c\xb2=\xd7;j\xf9Z+\xb4\xfd.7\xf0m\xb94\xa7\x84\x89\x8e\x13\xb1\xb0\xc9ӓ\xf5\xac{Z\b\x81\x15\xed\xbd\xbb{\x0e\xc7\xe4В\xf5ݏ\xee\"\xb6r\v7 \x92Tf\xd0k\xabu\xb3Ϻ<\xde\v\x11?\xa8\xe5\xb2')\xdeMhpY/V\x9b<\x85\x9eT\xdep\xcbmDV\x88\xf4\xbe\xa7\x19\x9cۓ\x92\v-\xd2\x14Ri:t\x81p[\xef\xeb`\xe7\\\xec\x1c\xd1\xc5\xce\xd1\xfd\x88qp8\xba\x1bQV~\xf7~vȉ]{ڡܴH\x97\x8d\xbb\x03\xce\xd5\xd9\xe1\xe2\\t\xeco\x87v\x88\xf4}\x87c\x97;Jr?\xe2\xe4\xdd\x12f\xfa\u007f\xd4\xfe\xee4\xe0H\xb3\x9d\xef\x10j\xd7]\xef\x12nS\x11\xfe\x9dj\x00\xe7O\xc4(\x9db\xb9\x94\x99\xb4\x0e<\x06\xd9{F%\xf0\xc1yn\xfc\xfc\xf5\bX\x82\u0590\xdc\x14%\x86\xde\xc7kH\x8aTf\xabϫL\xed\xff\xfc\xf1\t⢿\xaf\xc41\xa0bHh{?\xfe\x02w\xef\xb5\xff\x8a\x11\xfaE;\r\xe8#:\x80\xcf\xd6k@\x86\xe0\xbc\x04r(\xdb\x010\xef\xe7`\x9bp\u007fkO\x83\xc7\r\xd6\x10\xe0\x1b\xb0\xe12o\x81\x12\xf0w\x00L\xd8\xd0둎\xef\xfdi \x05\xea\xbe\x1eN;\xb5\x8a\xfa\xab@W&|,0\xe1GL\xf8\xf3$|\xf2\u008f Wk\x87\x88ޗ@\x8dH\x8d\xc8Q\xa9\xd4\xe0\xef\x83\f\x86\x1aV\x1f\vb\b\xe9\x02\xf0\xf7\xdd\x1cBh\x99\x98ƼK5\xa0\xb1\x1e}\a\x8d\xe5;$VŢ1m(\xbf\x90\r\xf0\vy\x89/$\x8b\xc6L\xf8L\xf8\x17H\xf8\xe4\x85)K\xe2/\xe7\xea\xad\x14\x85\x94\x1f\xd1\xe9K\xce\x03r\x95\xb0\x95\xb7w\xdc\xe1\x90\xca\xdb\x1bS\x9eM\xc5\x02\x1cܷ\xc3,\x1e\x05\x12\xa8#\u007f\xce\xec\xff\xbeF\xbeol\x14\xeay\b\xf1\xd6\x06܌\xef\x9b\x1b\x85xw\xa3p\xdf\xe3\xff\xfeF\xdeop\xe4\xf5\x0eG\xfeoq\xe4\xff\x1eG\x01N\xd2ٻ\xdd\x0e\x14\xbfw;\x04\xc0\xb1 b\x0eqx\xd6D1\x8e\xad\xdfx\x9e\x9b\x0f\x1eY\x95\xabT\xad\x9e\u007f\xa31o\x8f\x9dSy\xc0\xd5\xf1\x9e\xd9\xcc\xfb\x1a\x88fޗB\x18\xdbz;`4\xc9\xd8K<e;/m(\x9b{\x1a`s\xcf%\x9a{\xbc\xc5\xcap\"e\x10\xb1\x88t\x84\xe4\xa1t\x11\x92Hm\x1egD\xc5\x11\x0f\x91\x91\xb8[\x9aLC\x13\x13G\x11N\bv\xc4\xccJ\xb6%\xf6\x8ec[\"\xdb\x12+`[\xe2i`[\xe2[`[\"\xdb\x12C\x0fg[\"qi\xb6%\x9e\x06\xb6%\x9e\x04\xb6%\xba\x01\xdb\x12ٖȶD\xb6%\xb2-\x91m\x89m\xc0\xb6\xc4\x16x?\xb6DďEa\xd5F\x15\x99\xbd\xa7T)w\xab\xa2Uþׇ\x03\xce:\xa36\xf6\xe1\x17z\xe5L2H\xfa\"`*\x16\tb\xb5و\xcc\x19\xa9\xcf\xef\x03 \xdb\x0e\xb4ybj\x16\xba\xed\f\x91\x1fU\x92\xd5x+}\xd2\ni\x16\xa7K\xd0\xfb\x0e\x81\xbf\xc13\xb2m\x90\xefґ\x8f\xe0\xee)+\xe0Q'\xd0¸\xae\x16\xa7\x17w\xedr\xf1\x1a\xe8\xa21M,\xf6\x10\xc8(\xfd\xdbj\xf0\xc3Gz/\xb7\x1a<\xb1\x83\xdc\xd7-\xc0\xea>\xd8A\xe9\xf4V\x83\a\x8e\xf8\xb4<\xab\xc1\x0fW\xbcڟ\xd5\xe0\x89.\xc4VhA֦\xb6E\v\xb0\xb8\x0f\xa6\x12\x1a\xa5\xd5\xe0\x81\xa8u\x1dZ~`GY\x98\x1fء\x06\x92\xf2\xfe\xda[\xd4\a\xd9\x15A\x85\xc1Hף\xa81{\x11\x1c\xcd\x1e謁F\x9e\x1e\xa4I'K:I\x12\xc9#װ\x94O\xa3\xa8{N\x15\xcaO\xed\x90\xef\xbdw$ɤ7(\xf3\xe9mA\xf5vręW\xb3\xdf\x16iZ\x17&\x1fl\x9dT.!~\x8eS\xe7/\xa1`k\xae\x8c\xbd\xb7B\xa3\\\xf0T\xb2\x80\xa7\xbe\xf2\xa8\xe1֊\xf0\x86\xc0c\x98c\xd0\tYJY[\x9b\xff\xeaҊ\xf7%\xf8\\\xcdZ\x19\xf4z\x91\xef\xe1\x96\xdf\xf9\xdf \x12'\xe3\xfa[\x98\xd4\xf3N\x97\xf3\xa3\x80^\xef\tw\xe0\xeboFI\xc8/\x87V\x9f\xee\xc1\v&\xf16c[/\xbf\\\x93xQ\xb9½$\r\x88\xec\xf9\x1bAe\xaf\xbc\x91~\x88uE\x0e\b\xab\xc1\xc4k\xa0Q\xe6$!~\xe5\x05\x8d\xf5\xac\xd88\xbfW\xf1\xc3\x05<,\x97\x86\xf6s\xc0?Ұ\\ýU\x9d\xfd\x96\xdf\x0eaa\xb8\x1dX\x18vY\x92\x85a\x1c\xb00\xcc\xc20\nX\x18FM\xc0\xc2p\x1b\xb00\xdc\v\x97\x86\xf6s\xc0?\xb2\xd1\x1d1$\x95[\xc8\xc0\x98[\xad\x16\x83ڬ\xb1\x923\x95\x82\x88\x12\xf3\\\x02\xceI\xa8\xb4\x142-4|_k0k\x95\xa2\x0e\x87\x9a\xb5G#N\x82\x1cOE\x14\n\x9b%_\xb7\x87\xdcN\xc4L?y}\xb2\x00\x15\x0f\x19}\xb2\xa8)\x92\\N\x95ɽ\xf3x\xf0\xb28E\x0e'_\x06E\x10!\t!^\x02\x88\x8f\xf0A\x93\xb7GM\x16B\xca8$\x9c\x94\x99\xb4R\xa47\x90\x8ag\xe7F\x99\a\x18\xf7]\xcaAK\x95\x9c\xfd6M\x11\xc7`\xcc\f\x1ez\x92f5\x8b\xa7\xfe\xbd3\xb0\xf3\xe5(Vn@\x15\xf6̩\x14\xfdi\x18Y\f\x8d\x11\xe5\xbd\f\x955H\x0e\xf8\xac\x13\anфD\xaf0B%\x86\x92\xb3|\xbe\x1d%\x1a\xb2\\j\x0eG2Z\xdea\xae\x95U\xb1B\x06O\x92\x16\xa3\x04w\xbf\xc0cw\x1e6|\xa0\xa5\x06\x91H\xb6\xf9\xb0ͧ},\xdb|\x0e\xc06\x9f^`\x9b\x8f\xeb0\xb6\xf9\x9c\\읫Ll\xf3\xd9\x01\xdb|\xc2o\x93m>'\x81m>\xad\x83\xd9\xe6\x13\x9d\xbbͧI\xc4GT\xbeǓI*7\xd2\xdd\xf8\x13\x05\xa9fGD_rJ6\x98\xf7\xfb\x85\xe8!\x06\xe2BK\xfb|\xad2\vO\xce\x1c\x8b\x82[\"M\xd5㭖[\x99\xc2\n>\x9aX\xa4\x02WX\x96\x9a\xe3\x1a\x8b\\,d*\xb17G}hD\xf2n\r\x0fQ\x94h\\\\}4\xa3\xaf#\xe6\x1a\xecP\x1au\xe94Lε\x8a\xbf\xaa\"C\x89\x16D\xfb\xa6H\xbee\xe9\xf3\x9dR\xf6\x93L\xc1<\x1b\v\xa8*k\xb4/\xd4E\xf6\xc1\xfc\xaaU\x81B\xb2\xa3\x97\xfb_\xff\x1c\\j\xad\xf6\xf8\xbb\xcaʳ\x19\xe9D~\x18@\x95-\x1a\xf7@\f|\x91Y\xf1\xf4\xad\xaa]0N\xe9o\xd8\x02\xbaF\x02\x99\x9fh垽\xef\xbdX5p\xac\xc5\n$^y,Fb\xae\x8f2Kԣ\x19\x11\xb3V\x1b#\xae5$\x90Y)\xd2\xfb\x1c\x9f\xddE\xbe\x8c\xb7KS*\xa5ѱ\xbca4#\xae:\x8a\x8ck\x85\xb6Eξ\xadw,\x84\xb1o\xeb\xd4R\xec\xdbj\a\xf6m9\x03\xfb\xb6\xda\xd7b\xd3\xf0\t`\xdfV\x05\xec\xdb\n\xbfM\xf6m\x9d\x04\xf6m\xb5\x0ef\xdfVt\xee\xbe-c\x13\xe9\xec\a\xc0[Ѫ\xe9\xbfe\ued77\xf1KX\xd0\x1b\x99U\xfe\x8c\xaf`\x8cX\x01\xa6*=\x1a\xcbO,7l\x11K\xebڡ\x9brx[\x95\x16\x1b\xb8\x81\xadD\xb87G\x89<O\xaa=\xe1\x1b\f\x10\xd9\xd6H\x81Ҕ\xd8\xe5\xc3I \x06\x9d[5\xeb\x1a\xd1*\a\xcay\xe1YՓj44\xabW\xd3*\x17+\xb4\a\xf6\xdcq\xfb\u0e62,\x86\xaf\x9el\x8a\xc5h\xf7\xb6[\xeb\xe3S\x8e\xb4ُ\xc6%\xf6x<c&\xf1\xa8\xf4\x83\xccV7\xd2\xf9\x90\x91ǋ9X\xe7\xe3A\x1d\x8c\xfb\x91$\x99\xb9\xae\xca\xed\xf7o\x16\xc7\xf9\xaaF\x90\xa0\xb7\xce\xe6B\x14\x1f\xc6\xcb7($Q\x18w\x14j\xe3\x94\xe7\x03\xcbYI\xdc\x00m\xb5$\xac\x82&nܭ\x19\x10:^\xbb\x9e\xec\xf9\xe0\x1b\xe2X\x92̸*\x02\x88-C&\x16)\xecZf~\x91كù`\xdeS\xc8װ\x01-\xd2kn\x9ayz\b7\xcd<\x05\xdc4\xd3c%n\x9a\xd9\x0f\x9e\x9e>\xee\xe9\x85\x02n\x9a\xe9\b\x9e\xd8\xc1M3\x11\xc0M3\xb9i&\x12\xb8i\xa63\xf0\x03\xfb\x8e\x1fXr\x86\x167\xcd\xec\x02n\x9a\xd9\n\xb3i\x9e\xc8M3\xdf\xc2%\xdc\xfb\ḃ\x9bf\xbasRn\x9ay\x12\xb8OP?̮\x9d\x03\xf7\t\xe2>A\x94\xa1\xdc'\xc8y\xd1\vk\x98\xc2}\x82\xba\x06r\x9f\xa0\x1e\x98+\xda\xcf\x01\xff\x88\x85,\xb8i\xe6[`a\xb8\x1ffǳX\x18fa\x982\x94\x85a\xe7E/L*`a\xb8k \v\xc3=0W\xb4\x9f\x03\xfe\x8dQc\x86\x9bf\xd6\xc0Ef\xda\xc6r\x91\x99\x03p\x91\x99^\xe0\"3\xaeø\xc8\xcc\xc9\xc5\xdey\x8d\x06.2\xb3\x03.2\x13~\x9b\\d\xe6$p\x91\x99\xd6\xc1\\d&:\xf7\"3\xdc4\xd3\x15\xb8i\xe6\x1bঙ!\x16㦙l\xf3\xd9\x03\xdb|\xba7\xca6\x9f\xddbl\xf3q\x03\xb6\xf9\xf4\x00\xdb|\xde\u009cT&\xb6\xf9\xec\x80m>\xe1\xb7\xc96\x9f\x93\xc06\x9f\xd6\xc1l\xf3\x89\xce\xdd\xe6\xc3M3O\r㦙~C\xb8if\ap\xd3\xcc\x13\xc0M3_\x017\xcd\xecZ\x96\x9bf\xbe^\x8d\x9bf\x1e\x017\xcd<=p\xacŸi\xe6kঙ\xdc4\x93}[\a\x98\x87\x10ƾ\xadSK\xb1o\xab\x1dط\xe5\f\xec\xdbj_\x8bM\xc3'\x80}[\x15\xb0o+\xfc6ٷu\x12ط\xd5:\x98}[ѹ\xfb\xb6\xdeA\xd3L\xa1W`\xaf)\x15\xce\xd1(\xce\r:_\xcd\xcd\r:Ϸ\x89!7\xe8\xe4\x06\x9dܠ\xf3\xd4bܠ\xf3\x00ܠs\xa0\xd7tN\r:K\x1d\xe6C*\x85q\xe1g\x83\xf5\xcc+wQu\xf4\x1c\x88i\x13\xe8\x03\x8bU\xd2\xd9w\x8e\xdc̀\x17\xff\xf9\xf6\xba\u007f\xd3\x18\xc6YN\xfa;ؒ\xc2\xc2O|\xfb\xf9&\xfc\xa4n\xaf\x16\xe2\xca\xf6\x15\xee\ufaf6\tSRՀ\x19\xab\x03!\xa5̤\xe5&\x9e-C\xb8\x89\xe7)\xe0&\x9e\x1e+q\x13\xcf~\xf0\xf4<r\x8f1\x14p\x13OG\xf0\xc4\x0en\xe2\x89\x00n\xe2\xc9M<\x91\xc0M<\x9d\x81\x1f\xd8w\xfc\xc0\x923Ƹ\x89g\x17p\x13\xcfV\x98M3Gn\xe2\xf9\x16.\xe1\xdeG`>\xdc\xc4ӝ\x93r\x13ϓ\xc0}\x8b\xfaav\xed%\xb8o\x11\xf7-\xa2\f\xe5\xbeE\u038b^X\x03\x17\xee[\xd45\x90\xfb\x16\xf5\xc0\\\xd1~\x0e\xf8G,\xac\xc1M<\xdf\x02\v\xc3\xfd0;\x9e\xc5\xc20\vÔ\xa1,\f;/zaR\x01\v\xc3]\x03Y\x18\ue079\xa2\xfd\x1c\xf0o\x8c\x9a7\xdcĳ\x06.z\xd36\x96\x8b\xde\x1c\x80\x8b\xde\xf4\x02\x17\xbdq\x1d\xc6EoN.\xf6\xcekFpћ\x1dpћ\xf0\xdb\xe4\xa27'\x81\x8b\u07b4\x0e\xe6\xa27ѹ\x17\xbd\xe1&\x9e\xae\xc0M<\xdf\x007\xf1\f\xb1\x187\xf1d\x9b\xcf\x1e\xd8\xe6ӽQ\xb6\xf9\xec\x16c\x9b\x8f\x1b\xb0ͧ\a\xd8\xe6\xf3\x16\xe6\xa42\xb1\xcdg\al\xf3\t\xbfM\xb6\xf9\x9c\x04\xb6\xf9\xb4\x0ef\x9bOt\xee6\x1fn\xe2yj\x187\xf1\xf4\x1b\xc2M<;\x80\x9bx\x9e\x00n\xe2\xf9\n\xb8\x89gײ\xdc\xc4\xf3\xf5j\xdc\xc4\xf3\b\xb8\x89\xe7\xe9\x81c-\xc6M<_\x037\xf1\xe4&\x9e\xec\xdb:\xc0<\x840\xf6m\x9dZ\x8a}[\xed\xc0\xbe-g`\xdfV\xfbZl\x1a>\x01\xec۪\x80}[\xe1\xb7ɾ\xad\x93\xc0\xbe\xad\xd6\xc1\xecۊ\xceݷ\xf5\x0e\x9axrc͗ssc\xcd\xf3m>ȍ5\xb9\xb1&7\xd6<\xb5\x187\xd6<\x007\xd6\x1c\xe85\x9dSc\xcdL%\xe0\xe6\x0e@\x1cB9\xe9=\xa4\x10[\x97^,\xd4\xf8\x1bԭ \x8eOmA\xafA8\\߹\xed<\xd7\x00\x9bʓ\xe6*-\"v\x92k\xa9\xb4t\x11\x13q\xaa\bF\x01i\xf6p\x9d\nc\x82\xa3\xed>K\xeaWa'\xed3[*\u007f\x15b}G\xb8\x8a\aeR/v4\x1d\xb7\xd2P\xf9\xfa\x06\xc0n]d\xa5\xee=\fb\x99x\rI\x91\xba:^13c\xc3\xfbp\xa8\xb8\xc4D\xea\xe0CR\xb0\xb6\al\xec\xd0H;BE\na\xe5Pdt\xd0\xf0\x9fL\x89\x05\xc2+M\xc8\xf8\x1f\x82\x00\x8c\x8b\xf9!,\x80\x8b\xf3!,\x80\x8b\xed\xc1\x9b\x84p\xa2\xb8)\xf2<\x85\rdV\xa4\x15\x8d:\xde5J\xff\xa6\x84\xbd\xe1-\x9c8\x8d\xc2<\x9bئC|-\xc5րU\xc3I\xaa#\xda\xc5M\xa1O\xa4z\x8aR4q\xaek\xb4R\x8aC J\f\x1c\x1e5|\xe2\xde\b\xd7\xe7\x1b\xebF\xc1\x18Z|۰\x8c\x11\xf1c\x03z+c\xf8\x10\xc7n\x11\xe0(9\xf1x\xea\xf0b\xe8Zh\xb8\xd5*\x86Zz6\xb9pq\xc6`d!S,\x12\xb5\x11.n$\xc4Ə\xfc,\xbfj\x11\xc3-\xceI\x8e{\x8e0\x0f\x91U)h\xe1\xc8\x10\x06SDa\xb9\x84\xd8\xd9\x15\x8c&$D/V\xf4\xdc\xe5\x87\n'\xfb\x13q\x81\xc3\r!\xbd\xb5\xe3\xc80\xa8'\x1a\xf9\xf1\x03i\xf7V\xe5*U\xab\xe7\xfb\\\x83H\xaeUf\xac\x16\xd2ɕ3\x18\x05\xa4b\x01\xa9\xbb-\x93\xb6H\t\x1ba\xe3\xca\xf2\x0f\xc6`C\xe1\t\xf1\x95\xf4\xd8JR\x03e\x8f0\x0f,!\aY\xb4\xa2\x1eB\xd8)\xb9ۆW\xe4'5*\x92\x161\x8ao\xcd|\xb5\xbfD\xd40b\x9c(\xe54*\xea\xfbR\xd2:\xa7\x0e7\xb0\x11O\xf7\x0f\xf0Hx֜\x03\x90\b\xaa\xf9\xee\x8d\xf8m@\xd1\xe1q\rُ\xcc\b+\xcdR\x8a\x85\xbb\x99hP\x13\xfc\xee:\x9c~{tJN\xbf\u007f\xf3\xc5\xd3\t\x01u\bǔO\xbex4\x1fSa\xac\x8c\xff\x9d\xaa\xf8\xe1\xde*=hZ\xd1\xd2`\xdc<\x11\x95i\xe4B[\x89\x8d\x06\x197\xa2\x96\x12\xd9A\x8b먱\xec\xf3\xcd\xd9ٰ\x9a\x8d\r\xc5\xd4\xc5߅\x86\x1bi\x1e\x86\xc4\xe8X\xc4k\x99\xad\xbe\xaadx\xb4N\xa4y\xc0\xe63\x92\x17\xfaq\xf7y\xf0uFb\a\x0f\x12\x97aH\f1\x1a\x87\xa0\xf1t֠\rj\xc0\x8f\xbbσ\xd2\xe5'9l\x13\xf9\xf1\xf8\xab\x81X\x83\xa3\x9d\xf1\xf5ZH\x1c\xabl\x8f#\xac\x84Ǳ\xc3!\xb8\x0fi>f(<\x8b!_/\au@mT&\xad\xc2%\x89\x12\xd4f/\xc5\t\xa7\x1cb\x93\aϚU6\xb4\x89\xe15\x11\x996\xab\xa5\xee\x00\x95\x18D5MQ\x12~\xc7S\xbf\xf1\xa58F\xe1Q\r\xb5\x0e\xc6od\x96\xb8\u007f\xf6\x19\xabOc\xd3'\x13M\x05\x17\xab\xa8\xc5*[\xca\xd5W\xe1\\&\x8b\x82\x01\t,E\x91Z\xac\xa26\xaeU\x00-\x1c\xbckw\xc8\x06yW5\xd0{\x82D\xe4{\xab\x81\xd6љ|@\xe3\xb94rL\x16L4\xae;c\x94 0\x95\u05ee\x8f\xa1_D<\xeb4rP\xa6\xa9\xe5vpIn4\xb9&S\t\xdc\x16\x8bT\x9a\xf5=\v\x1e\a\x18\xdb\x16\xfd\xc1Z-\x17\x85S\xe2\xc9\x01\xe6\xe4\x80$\x18\xe9*J\x1b\x8aM$\xea1{\x14:\xf9p\xebl\xd4e\x19\x8b<\xc0G\xc6ZJH\x13$_\xf2]\xb3\x04\x91\xcb?@\x1bt\x06u\x03^A\x1d\xbb\xcf\xc6'\x1f\aYݧ\x98\xd8~\xdfD\xb1\x8fT\x19\x8c\xe5\xe2\x1eh\xfa\v|\x9a\x88\x98\xf6\xcd\xf1(\xd5Gk\xf0\xa4\xa7Dn\xa5\xa1\x04\x92\x05X\xbb9\xfd\x99\x91r\xb3\xed\xf1(\x99\xaa\xc0\x9d\xaf:\x86^\b6\xb9}F\x94' yi \x91\x05\xa1\xdc;\xd6\x01 \xff\x86/r#\x87\xaeg\x8f>\xe3\xa5s\xf6\xcb\x19ۤӂ\x83y\xcaQB\xaf\xc0\xfe\xf9\xe7\xef\xef\xc8\xe7\xf8\xf8(q\xf5\xdf\xce\xf9s\xf0ԙ\xc2\xd3\x1f\x95Z̆\x1c\xd7A\n_B\u007fN\xd6\x03\xf6\xc0\x1d\xc1\xe5\x1am\x96\xa9\x8a\x1f\x86u('\xc2\n3R\xf0\xd2n\xad\x1f?\x06\xf7p\xa2OzUeC\x1ai,dv\xe8\x88Q\x8e\x81\xdeo1\x19\x05\xf1\xce72\xb3>\x80\xc1\xb0Z\xda;\xc8ՠ\xfcC\xea*[\x90p\xb6\xe8K̕\x91#-\xb5\x95XC\xe8(q\x18\x873\x18\fe\xd2\xc2X\xd0\xc3FMB\x96\xe4\xca-\xd7\xf5\x00D\x1e\xf8\x8e\xa2\x19\xf1\xf8\xb2?hw\x86\xe4nYB\xe3\xd6Z\x19TYX\nj\x8dr\xe1v\xf8\xe7\x9b\xf0\x94\fxs\xd2\f\x1cf\x10\xafE\xfe\xa1\xb0\xeb\x1bib\xb5\x05\n\x8fG\xf7Q\xdd-y_\xe7\xa0\x0f\xbf\xe0Hb_ݧ\xc3*\xb4끶\xda_C\xbf\x92;\xec\xfb\x9c\x95ϒS\xa5\x15\xcf\xe5\xce\xdaИ+m\x05.o\xfb\x9c\x8del\xe88\x821\xedK\xb5\x19\xf9\xb6¦\xb3{\xca\xe4_\xae\xb7zUR\xab\xf3o\x8f?z\xa8\xa7\x12s\xf1\xe8\xc3̆\x95\xcbߕ\xb4\\\xd7\x01;\xc3,\x14\x84\xa0v\xb5\xfb\x86\xa1\x905\xdfۼj\a\xc4u*\xa4\xb3\x9f\x94$\xe7\x95\v\\\xb8\xcdg\u007f\x06\x83\xdd\xeaZY\x95\xbd?{fr\x86\x991\xe5\xa6\x06\xbbG\xa5\xed\xa3\xd2#8\a\xdf]Z\xd9\xc5fR\xe5Z\x95\xbfu\xdfһ\x8d\xf2\xadc\xb9\xce7\xce\x17\x9d\xf3\x16b\xd1ȧl\x9b\xd7P\xdf]\xd7@J@;\x80g\\c\r\xb4\xe0\xdb\x03\xf8\x85\xe1\x1e\xc0' \xf7\x00\xb4\xd0\xdc\u05fb\xf0:Rz\xccf\r\xf8\x84\xb6\xe3\xb1\xe8X\xca\x03xDp\x1fO\x80\xafeX\xc3dM\xbf)\xc9p\xaf\x17\xc77';\x1eM:sB\x0e\xcc\x01.\x97\xe7\xd2\x13R\xc2\xee#\n\x90\xa4r\x80 OA䟸\x12|G\xbe\xdc4\xf2Lk9\x807{\x8c\xf8\xb5=\xb9\vO\x1c\xf1O\x8e9@(\xc2\x0e\x900s\x80`\xb4\xed\x95D3\xc0~\xfc\x12k\x82o(\f\xab!\xa7\xdd\x1c \x00\xa7\xf1\x17B\xe7*Hz,^\xbb\xd4X\x98r\aV`\x0f\xf0n\x9eTV`Y\x81E\x8e&\xb2\xdb\xe3\xc6F\xdf\xd5\x03\x10\xd4 \xcf*\x01E\"!\x9b$\xb57\x8a\xe0)\x97\xa4.4/\x81ғ\xe6%\xf8r.:Ϛ,9\x9a\xc4eȸ>Z\xbe2\xa1\xd0m\xedW\x18ʁ\xf3W\xa1\x16\xcfvPo\xdfʽ\xa9l\rg\xee\xecӰ\x92Ǝ\x90\x17`!\x13.\xbd\xea<\x97\x19\xa1vj\xe3!=;\xffhs\x97H\x87\xeaPԨ\x17\x83:R\xc7\n\tވ\xd5\bE\xf6\xe1\xb9\xfc\xfd\xe0\xeb\xbcÚ\xdbJ\r\x1d\x84Ɂ\xb6G0f\xa0\xedY\x16®\x18\x82\xf3\xaf\x87.\x9bmb\x91\xc2\xe7o\xef\x80Ѯ\x84\x85G1\xbc \x92ke!.\x15\xa1\x1b\xc76\xb1\x9e\v2\xf3\xd8Ø\xccØ\xf4c&\x16)Ne#\x1e\xbcUZ\xac`\x94>F\xbb\xb5n\xc7x\xf7̳\xb10|\xf1\xa7Z\x0e=\xcb\xd6,;\xae\xe4\xae\xe16$\xe8>\xa2:\xe3\xc1\xde\a\x94\x97\xe1\xdd\x064\x9ew\xd9R.\r\xdf\r\\\x1a\xbe\x81\xd1l{\xe3Um\x1f\xb1\a\x18\x9e{\xd6o\xad\x1a4s\xec\xdd%\x10\xb0\x18z\x04\xa3\xc96\xc7K\x99|\xf8do\xf4ilM\xbe\x06\r\xef&#g/\x88\xa72~\x1e<]\xe6\xd5z#\"\x146>s\xc4\\ D\x90%\x12]q-\xb73\xb7\x8cD\xd4\x1e\\\xdfl\xb7\x9d^\x1d\xa2\x04\xbbmQN{t\xf8\x91\xb5\xe9\xce\xd1\xfcaiA\u007f\x92\x994\xeb\xae]\xba\x89\x97\xfdbd\xf7y\\E\x166y*\xec\xe9\xfb\xea\xfc\xb0\x8e\u007f|\xfd\xb5B\xa6ũf\xe0\xdd\x1f\xd9\xf5q\xce\xe7I_b+\xd2\xe2\x14\x9fmՅ\xfa\x98\xb3\xb0\xe5a[s\a\x1b!\xb3\x0e\xffF\xff\xad\x82\xd6\xed\xb1\xa4\xbd\x1c\xa7KH\xe8\x1d\\\x1d\vqt\x172\xb6\xb2\x8d\xabz\xc9\x13\xff„o\x19F\xcb c\x85-^]\\\xfb\x85\nc\xe4*\xdb\xc0\xc9\xc2c\x1d\x87\x10\xabM\x9eB\xa9I|\x97\xa7\xee\xa0\xc1\xd6DX\xb8\xb2\xf2\xc4ytN\x9e\xd5uiC\"m*\x8c\xbd\xd5j\x01\xa77\xec\xba\xed\xde\xcd\x1fV\xfb\xaeEfd\xfb\x19\x05^r\x03\xc6t\xf84{\xc7k\x10\xa6=\x87\xa4w\xf8)\xbcC\f\xb7\x1d\xa2\x9d\x171\xbe\xb8\xf7\xd6_\xbc\xbc\xab\x93?\xab?\xf0\xe4?\x95\x1b\fE\xd6Q\x94\xaf\x859q\x16\x1d\xa7`\xac\xd0v\x00Bl{9Z\x87\x9c\xbe\x8a\xabc6\xf3\xea_\xaa\x8f}\xf5\xb7z\xd9~f\xf7\xe6\x8fUe\x90\xe4\x97\xc8\xea\x1d\x9b\xdd\xc9ֿDK\x91V\xcb\xd4\xcc\xf9\x97h\xfb\x8f\x05X\xf1\x8f\xfaG\xf1\x1a6\xa2ٲ\xca!\xfbp\xfb\xf9\x8f\xff\xba\u007f\xf1\xe76\x06Ӗ}\xd5rDo\xbb\xee\xb7\xfcp\x03V$\u008aS?~\xcb\xf3s\x88\x9d9~\x9ek\xf5$7\xc2\xc2]\x91ٓHӁ\x12\x9d\x0f\x06\x999\x0f\xf7\x90\xbb\x046\xf6\x89)\xe7\xf0\xdaW\x91\x9e\xa0ey\xf2'M0\xddGܗ$\xd8s\xc8=\t}=\xa3\xdf\"\xbd\xe3\xc0v\xb4p\x18\xd8a,\xe9}P\xea\x80F\x9f\x13+$\xe5\x93;P\xa3\xaea\x98\xde@*\x9e[C}\xe9J\xc2\xffV\x8b\xef;\r\n\x8b[\xa7Y\x95\xc3'E'Y\x97ۢ%\x88\xd8\xca-܀HR\x99Ao\xfc\xb3[\x8c\xb3\x8bCc!\xe2\a\xb5\\\xf64\xb6\t\xa5\xf9\x1eK\xdbn\x9f\xe7\xb9\xdcFd\x85H\xef!\xad\xcaX\xb7\xaf\xe8bF΅\x16i\n\xa94\x1d\xfe\xe6p[7\xbd\x9bv\xb3<n\x84\x8d\xd7\x1f\x9fr]WB\xed\xb1R:y\x151&OG\xcf!\xca$W\xae.:\x8f\x868q\x9b\x94\xf8\x16\x10\xdeW\xa4\xb5\xd1\xdd\x13\xe6j\x01t\xf1\xfb]\xed\x0f\xb5\xe7\x87\xce\x16B\xb7侮\xf3\x8bX@_\xd5SJ\x83\x17\xe7\x93\x0ffRl}w\x1ap\xa4\xd9\xcew\b\xb5\xeb\xaew\t\xb7\xa9\b\xffN5\x80\xcb\xc9\xc18\xe2\xc5rY\x8a\x13\x0e<\x06\xe7\xa7\xc9T\x02\x1f\x9c\xe7\xc6\xcf_\x8f\x80%h\r\xc9MQbh\xa9\xa5%E*\xb3\xd5\xe7U\xa6\xf6\u007f\xfe\xf8\x04q\x81k\xf4\x81\x0e\r\xa1\xba6\xeb/\xa0d\x8c\xf9\xe4\xa9\xe1^\xb4\xd3\xe0\x91*\xec\x9f(\xec\x95&\xec\x99e\x17!\xdf\xcf\xc16\xe1\xfe֞\x06\xafd\xef(\xcc7\xd0\xe2V\x8e\xc1/\xb9\x98\x9eZ\xec\xfcޟ\x06r\xea\xdf\xf1pکU\xd4_\x157a\xc2\xc7\x02\x13~Ą?O\xc2'/\xfc\br\xb5FW1\xf1\t%\xa5\x06\x92RP\xe2\xeaH\x06C\r\xab\x8f\x051\x84t\x01\xf8\xfbn\x0e!\xb4LLcޥ\x1a\xd0X\x8f\xbe\x83\xc6\xf2\x1d\x12\xabbј6\x94_\xc8\x06\xf8\x85\xbc\xc4\x17\x92Ec&|&\xfc\v$|\xf2\u0094%\xf1\x97s\xf5V\x8aBʏ\x88OC\x0e\xc8U\xc2V\xde\xdeq\x87C*ooLy6\x15\vpp\xdf\x0e\xb3x\x14H\xa0\x8e\xfc9s\x98B\xbc\x9e\xe5)\x03=\x0f!\xdeڀ\x9b\xf1}s\xa3\x10\xefn\x14\xee{\xfc\xdf\xdf(H\xf1_\x9f\xb2\x97\xdeoq\xe4\xff\x1eG\x01N\xd2ٻ\xdd\x0e\x14\xbfw;\x04\xc0\xb1 b\x0eq\xf8>\x18nl\xfd\xc6\xf3\xdc|\xf0Ȫ\\\xa5j\xf5\xfc\x1b\x8dy{\xec\x9c\xca\x03\xae\x8e\xf7\xccf\xde\xd7@4\xf3\xbe\x14\xc2\xd8\xd6\xdb\x01\xa3I\xc6^\xe2)\xdbyiC\xd9\xdc\xd3\x00\x9b{.\xd1\xdc\xe3-V\x86\x13)\x83\x88E\xa4#$\x0f\xa5\x8b\x90Dj\xf38#*\x8ex\x88\x8c\xc4\xdd\xd2d\x1a\x9a\x988\x8apB\xb0#fV\xb2-\xb1w\x1c\xdb\x12ٖX\x01\xdb\x12O\x03\xdb\x12\xdf\x02\xdb\x12ٖ\x18z8\xdb\x12\x89K\xb3-\xf14\xb0-\xf1$\xb0-\xd1\rؖȶD\xb6%\xb2-\x91m\x89lKl\x03\xb6%\xb6\xc0\xfb\xb1%\"~,\n\xab6\xaa\xc8\xec=\xa5s\xa8Kɓ\x06\x0e\x95Y\xfb\xe7uFm\xec\xc3/\xf4ʙd\x90\xf4E\xc0T,\x12\xc4j\xb3\x11mţ\xde\xc2\xf9}\x00dہ6OL\xcdB\x97U'\xf2\xa3\u03a2l\xe1W\xfa\xa4\x15\xd2,N\x97\xa0c\x95-\xe5\xea\xab\xc8\u007f\x83gd5|ߥ#\x1f\xc1\xddSV\xb8\xc8\xce\xd4>\xed\x81)b\xb1\x87@V\xd5\xe8\x9b\x00\x1f\xfbJ\v\xf6\x81'v\xf4\x94&\x1ctu\x1f\xec\xd8\xef{L\x1ci*\x1b~\x9a\bW\xf6\"\x11\xb6\xcf\xc1\x01<\xd1%\x91[i\xa8V\aϵ\x9bӟ\x19\xa66\xdb\x1e\x13Q\xeb^3\xfc\xc0\x8e\xb20?\xb0C\r$\xe5\xfd\xb95\x1d!\uf2a0\xc2`\xa4\xebQԘ\xbd\b\x8ef\x0ft\xd6@#O\x0fҤ\x93%\x9d$\x89\xe4\x91kXʧQ\xd4=R\x172\xbew\xa7\x91$\x93ޠ\xcc\aզ\x1f}\xe6\xd5\xec\xb7E\x9a\xd6ͷ\x06['\x95K\x88\x9f\xe3t\xd0\xf6h\xb92\xf6\xde\n\x8dr\xc1S\xc9\x02\x9e\xfaʣ\x86[+\xc2\x1b\x02\x8fa\x8eA'd)emm\xfe\xab{c\xe0\x06|\xaef\xad\fz\xbd\xc8\xf7p\xcb\xef\xfco\x10\x89\x93q\xfd-L\xeay\xa7\xcb\xf9Q@\xaf\xf7\x84;\xf0\xf57\xa3$\xe4\x97C\xdbzr\xf4Ä\xdefZ\x9fdϋ\xca\x15\xee%i@d\xcf\xdf\b*{\xd3F\xc9\x03\xb1\xae\xc8\x01a5T}\x80Ht1I\x88_yAc=+6\xce\xefU\xfcp\x01\x0f˥\xa1\xfd\x1c\xf0\x8f4,\xd7poU\xce\xc2\xf0\v`a\xb8\x1ffǳX\x18fa\x982\x94\x85a\xe7E/L*`a\xb8k \v\xc3=0W\xb4\x9f\x03\xfe\x91\x8d\xee\x88!\xa9\xdcB\x06\xc6T}\x93\x87\xb4Yc%g*\x05\x11%\xe6\xb9\x04\x9c\x93Pi)dZh\xf8\xbe\xd6`\xd6*E\x1d\x0e5k\x8fF\x9c\x049\x9e\x8a(\x146K\xben\x0f\xb9\x9d\x88\x99~\xf2\xfad\x01*\x1e2\xfadQS$\xb9\x9c*\x93{\xe7\xf1\xe0eq\x8a\x1cN\xbe\f\x8a B\x12B\xbc\x04\x10\x1f\xe1\x83&o\x8f\x9a,\x84\x94qH8\xe9\xd4\xe5\xba\x1d\xc6}\x97r\xd0R%g\xbfMS\xc41\x183\x83\x87\x9e\xa4Y\xcd\xe2\xa9\u007f\xef\f\xec|9\x8a\x95\x1bP\x85=s*E\u007f\x1aF\x16CcDy/Ce\r\x92\x03>\xebā[4!\xd1+\x8cP\x89\xa1\xe4,\x9foG\x89\x86,\x97\x9aÑ\x8c\x96w\x98keU\xac\x90\xc1\x93\xa4\xc5(\xc1\xdd/\xf0\u061d\x87\r\x1fh\xa9A$\x92m>l\xf3i\x1f\xcb6\x9f\x03\xb0ͧ\x17\xd8\xe6\xe3:\x8cm>'\x17{\xe7*\x13\xdb|v\xc06\x9f\xf0\xdbd\x9b\xcfI`\x9bO\xeb`\xb6\xf9D\xe7n\xf3i\x12\xf1\x11\x95\xef\xf1d\x92\xfe\u007f\xec]\xcfr\xe38\x8f\xbf\xe7)T\xdf\xdd\xfd\xcdl\xedLm\xf5-\x93twum\xffI%\x9d\x99\xc3\xd6\x1eh\x89\xb1\xb9\x91E5I9I?\xfd\x96$\xdbr\x1cI\x04(\x8a\x91\x1d\xe04\xd31\t\x8a\x04A\x10\x00\xf1\x13+\x01w\xfeD^\xaa\xd99\x8a\xaf\xf3\x93l\xaeO\xf7\v\xd1M4\x8f\v%\xccӅ\xcc\f\u007f\x04k,\x17\xd9bi*\x1f\xae\x94X\x8b\x94/\xf8\a\x1d\xb3\x94\xe1\n˺\xbeq\x8dY\xce\xe6\"\x15ؕs=hXr\xb2\x8e\x87(J\x14.\xaf>:\xa2\xafs|k\xb0\x11iԢ\xbbIr\xaed\xfcU\x16\x19ʴp\xf4o\xb2\xe4{\x96>]Ki>\x8a\x94\xeb'm8\xaaʚ\xdb\x17\xaa\";ן\x94,PB\xb6wr\xff\xf9\x9f\xa3[\xad\xd5\x18\xbfɬ\x9c\x9b@3r\xab9\xaalQ\xd8\t\xd1\xfc\x8bȊ\xc7\xefU\xed\x820\xa5\xbf\xf9\x9a\xa3k$8\xeb\x13%\xe1\xaf\xf7\a3\xab\x1a\x86bV \xe5j\x003'\xe5\xfa \xb2D>耒\xb5Xiv\xa1x\xc23#Xz\x93\xe3_w9/\xc6K\xd6.\x95\xd2ܥ|\xabh\x02r\rb\xe3\x1a\xa6L\x91Sl넍0\x8am\xb5\xb1\xa2\xd8V7Ql\vL\x14\xdb\xea\xe6E\xae\xe1\x16\xa2\xd8VE\x14\xdb\xf2?L\x8am\xb5\x12Ŷ:\x1bSl+\x9azlK\x9bD\x80\xe3\x00x/Z\xd5\xfd\xf7\f^{\x1b\xcf\xc2p\xb5\x12Y\x15\xcf\xf8ʵf\v\x8e\xa9J\x8f\x96\xf2\x16v\xe3\x16\xb14P\x84n\x97\xc9[˴X\xf1K\xbe\x16\x88\xf0f\x90\xcc\xf3\xa4\x1a\x13\x1e`\xc0Qm\x05J\x94v\xc9]nf\x02\xd1hjլkA\xab\x02(Ӓ\xb3\n\x93*\x98\x98\xd5ܔ\xcc\xd9\x02\x1d\x81\x9d\xbal7\x91+\x17f\xf8\xeaɺ\x98\a[\xb7\r\xaf\x0f\x8f9\xd2g\x1fLK\xec\xe4\xf8\x88\x95ăT\xf7\"[\\\n\xf0$#\xa7\x173\xb1\xe0\xe9AM\f|J\x92L_T\xe5\xf6\xed\x83\xc5i\xbe\n\b\x92\xab5\xd8]\x88\xd2\xc3x\xfb\x06%$\x12\x13\x8eB\r\xdc\xe5\xf8\xc0jV'm\x80\xf6Z:pAonܪi\xceT\xbc\x84\xce\xect\xe4\r1-I\xa6\xa1\x17\x01Đy\xc6\xe6)\xdf@f~\x11\xd9=`^0\xe7)ϗ|\xc5\x15K/\b4\xb3\xbd\t\x81f\xb6\x11\x81f\x0e\xe0D\xa0\x99v\x1a\x18\xe9#L/\x14\x11h&\x90\x06J\a\x81f\"\x88@3\t4\x13I\x04\x9a\t&:`O\xf8\x80u~\xa1E\xa0\x99}D\xa0\x99\x9dt4\xe0\x89\x04\x9a\xf9\x92\xdeº\aP>\x04\x9a\tפ\x04\x9a\xd9J\x84\x13d\xa7\xa3\x83s \x9c \xc2\triJ8A`\xa6o\f0\x85p\x82\xfa\x1a\x12N\x90\x85\x8eU\xec\x8fA\xfe\x1c\vY\x10h\xe6K\"c\xd8NG\xa7\xb3\xc8\x18&cإ)\x19\xc3`\xa6o\xcc* c\xb8\xaf!\x19\xc3\x16:V\xb1?\x06\xf9\vQc\x86@3k\xa2\"3]m\xa9\xc8LCTd\xc6JTd\x06ڌ\x8a̴2;\xf1\x1a\rTdfCTd\xc6\xff0\xa9\xc8L+Q\x91\x99\xce\xc6Td&\x9az\x91\x19\x02̈́\x12\x81f\xbe \x02\xcd\xf4\xc1\x8c@3\xc9\xe7\xb3#\xf2\xf9\xf4\x0f\x94|>\x1bf\xe4\xf3\x81\x11\xf9|,D>\x9f\x97tLW&\xf2\xf9l\x88|>\xfe\x87I>\x9fV\"\x9fOgc\xf2\xf9DS\xf7\xf9\x10hf[3\x02\xcd\x1cք@3{\x88@3[\x88@3\x0f\x88@3\xfb\xd8\x12h\xe6!7\x02\xcd\xdc#\x02\xcdlo\x18\x8a\x19\x81f\x1e\x12\x81f\x12h&Ŷ\x1a:\x0e#\x8cb[m\xac(\xb6\xd5M\x14\xdb\x02\x13Ŷ\xbay\x91k\xb8\x85(\xb6U\x11Ŷ\xfc\x0f\x93b[\xadD\xb1\xad\xce\xc6\x14ۊ\xa6\x1e\xdb:\x01\xd0L\xa6\x16\xdc\\\xb8T8G\x8b8\x01t\x1e\xf4M\x00\x9d\xd3\x051$\x80N\x02\xe8$\x80\xce6f\x04\xd0\xd9\x10\x01t\x8et\x9a\x1e\x13@gy\x879O\x05\xd3\x10}6\x1af^9\x8a\n\xd1s$\xa5\xed\xb0?\xb0R%\xc0\xb1s\xe4`F\\\xf8\xcfW\x17\xf6Ac\x14g\xd9\xe97n\xca\x1d\xe6\xbf\xe3\xabϗ\xfe;\x85\x9dZ\x88%\xdbU\xb8\xbf\xa9`\x13^sW\x8d\xf8bu$\xa1\x14\x990\x04\xe2\xd9ф@<ۈ@<\ap\"\x10O;\r\x8c<\x12\xc6\x18\x8a\b\xc4\x13H\x03\xa5\x83@<\x11D \x9e\x04\xe2\x89$\x02\xf1\x04\x13\x1d\xb0'|\xc0:\xbf\x18#\x10\xcf>\"\x10\xcfN:\x1a0G\x02\xf1|Ioa\xdd\x03(\x1f\x02\xf1\x84kR\x02\xf1l%\xc2-\xb2\xd3\xd1\xc1K\x10n\x11\xe1\x16\xb94%\xdc\"0\xd37\x06\xe0B\xb8E}\r\t\xb7\xc8B\xc7*\xf6\xc7 \u007f\x8e\x855\b\xc4\xf3%\x911l\xa7\xa3\xd3Yd\f\x931\xecҔ\x8ca0\xd37f\x15\x901\xdcא\x8ca\v\x1d\xab\xd8\x1f\x83\xfc\x85\xa8yC \x9e5Qћ\xae\xb6T\xf4\xa6!*zc%*z\x03mFEoZ\x99\x9dx\xcd\b*z\xb3!*z\xe3\u007f\x98T\xf4\xa6\x95\xa8\xe8Mgc*z\x13M\xbd\xe8\r\x81xB\x89@<_\x10\x81x\xfa`F \x9e\xe4\xf3\xd9\x11\xf9|\xfa\aJ>\x9f\r3\xf2\xf9\xc0\x88|>\x16\"\x9f\xcfK:\xa6+\x13\xf9|6D>\x1f\xff\xc3$\x9fO+\x91ϧ\xb31\xf9|\xa2\xa9\xfb|\bĳ\xad\x19\x81x\x0ekB \x9e=D \x9e-D \x9e\aD \x9e}l\t\xc4\xf3\x90\x1b\x81x\xee\x11\x81x\xb67\fŌ@<\x0f\x89@<\tēb[\r\x1d\x87\x11F\xb1\xad6V\x14\xdb\xea&\x8am\x81\x89b[ݼ\xc85\xdcB\x14۪\x88b[\xfe\x87I\xb1\xadV\xa2\xd8Vgc\x8amES\x8fm\x9d\x00\x88'\x01k>\uf6c05\xa7\v>H\xc0\x9a\x04\xacI\xc0\x9am\xcc\bX\xb3!\x02\xd6\x1c\xe94=&`\xcdL&\x1c\x16\x0e@LB\xd9\xe9\rOyl X,\xae\xf97\xa8UAL\x9f\\s\xb5\xe4\f\xb0|S\x1by\xae8_U\x914\xa8\xb5\x88\x18I\xae\x84T\x02b&\xe2\xae\"\x98\v\xc8v\f\x17)\xd3ڻ\xd8\xee^I}b\xe6Uqf\xcb\xcb_%X?\x10\xa1\xe2Q\x95Գ\x11\xbd\x9e\xb6R\xbc\x8a\xf5\x8d ݪ\xc8ʻ\xf78\x82\xa5\xe3%O\x8a\x14\x1ax\xc5\xf4\x8cM\xefÉ\xe2\x1d&S\a\x9f\x92\x82\xf5=`s\x87\x02\x8d\b\x95)\x84\xb5C\x91\xd9A\xe3\u007f\xb2K.\x10\xfe҄\xcc\xffq0\x80q9?\x0e\fpy>\x0e\fp\xb9=x\x97\x10\xce\x14\xd7E\x9e\xa7|\xc53\xc3\xd2j\x8f\x02\xd7\x1au\xffvI{\xc3{8q7\n\xfd\xa4c\x93\x8e\xf1\xb5.\xbe\x06\xec5\xdc\xe9\xea\x88\x0eq\xbb\xecO\xe4\xf5\x14u\xd1ą\xaeїR\x9c\x00\xb9\xe4\xc0\xe1EcHޛ\xc3\xf2\r\xcdus\x91\x18\xb7\xfc\xb6q\x15#\xe2ǚ\xab\xb5\x88\xf9y\x1c\xc32\xc0Qv\xe2~\xd7\xfe\xcd\xd0%S\xfcJɘ\xd7ֳ\xce\x19$\x18\x83\xb1\x85t1O\xe4\x8aA\xc2H\x88\x81\xef\xc5Y>)\x16\xf3+\\\x90\x1cw\x1ca\x0e\"#S\xae\x18P!\x8cv\x11\xe5ww<\x06\x87\x82\xd1\x1b\t\x81Ŋ\xee\xbb\xfcP\x06\xf2?92hV\b\x19\xad\rcà\x8eh\xe4Ǐt\xbb72\x97\xa9\\<\xdd䊳\xe4Bf\xda(&@\xa1\x9c\xd1v@\xca\xe6<\x85\xfb2ݘ\x94\xb4b&\xae<\xff\\kl*\xbcC~\xa5{n\xa5\x13\x80\xf2\x804\x0f\xecF\xf6´\xda=\x0ei\xa7\xceh\x1b\x832?]\xb3\"\xdd2F\xf1\xd0̳\xdd\"\xa2\x9a9扺\xccF\xb5\xfb\xbe\x94{\x9d\x9e\x0eoi\xc5\x1eo\xee\xf9\x83ñ\x06N@r\xb8\x9aoΈ\xff\x1e\xd1txX\xf2\xec6\xd3\xcc\b}'\xd8\x1c\xee&\x1a\xd5\x05\xbfY\x0e\xd0o\xf7f\t\xf4\xfb\x17_\xfczF@\x9d\xc2\xf1\x9aG>{\xd0\x1fR\xa6\x8d\x88\xffJe|\u007fc\xa4\x1a\xf5YѝƄy\"W\xa5\x913e\x046\x1b$lF\xadKf\x87[^G-e\x9f/'\xe7\xc3\xda\x0el,\xa5\xce~\x15\x8a_\n}?\xa6D\xc7,^\x8al\xf1U&\xe3\x8bu\"\xf4=\xf6=\xa33\xa3\xdb\xebϣ\xf3\t\xa4\x0e\xee\x05\ue161c\x8aQ\x98\r\x8d\xdfg[\xb1A5\xb8\xbd\xfe<\xea\xbe\xfc(\xc6\x05\x91\x0f\xa7_5\x8f\x15\a\xfa\x19\x0fy!e\xac\xf2=\x06\xe0\x84\x97\xb1f\x12\xe0M\xb6\x1f3\x96\x9c\xc5<_ލ\x1a\x80Z\xc9L\x18\x89{$\xeapm\x1etq\xc2]\x0e\xb1\x8f\a'\xad*\xb7{\x13\xa3k\"\xe7\xbdY\xb1\xba樇A\xae\xae)\x97\a\xbf\xe1\xae\xdf\xf8R\x1cAt\xd4v\xb7\x8e\xa6oD\x96\xc0?{\xc2ק\xd0\xfb\x936MEo\xf6\xa2\x16\xcb\xecN,\xbe2p\x99,\x17\tH\xf8\x1d+R\x83\xbd\xa8\x85\xf5\n\xa0\x8d\x83\x93\x0e\x87\xac\x90kU\x93;&H\xe4\xbcn5\xb9!:;OP\xb8\x90F\x8ey\x05\x13\x85\rg\x04I\x02\x93y\x1d\xfa\x18\xfbDīN-FU\x9aJ\xacG\xb7\xe4\x82\xd95\x99L\xf8U1O\x85^ސ\xe1\xd1Ph_\xf4\xb91J\xcc\v\xd0Ó\x86\x8e)\x00\xe9फv\xdaXj\"\x91\x0f\xd9\x03S\xc9\xf9\x15ةK6\x96s\x83!6֝\xe0i\x82\xd4KCy\x96\xc4r\xf17W\x1a\xfd\x82zK\x83\x92:6\x9f\x8d\u007f|\xec\x85\xfb\x90bb\xbbq;\x9a}N\x95\xc1\xc8.\xb6\xd0\x16_\xe0\xe3+m\xa6\x1d8\x9eK\xf5њ\x06\xee\xa7D\xac\x85vI$\xf3\xc0{;\xfbG\xb6\x95\xb7\xc3\x0e\xb7\x93]/pӽ\x8e\xa1\x19\xf1Un\x9e\x10\xe5\t\x9c\xa24<\x11\x85C\xb9wl\x00@\xfc\xe2_\xc4J\x8c]\xcf\x1e=\xc7w\xe0\xd7/\x13\xf6I\xa7\x05%\U000d4b58Zp\xf3\xcf?\xdfN(\xe6\xf8\xf0 p\xf5ߦ\xfc9\xf8ݙ\xf2ǿ\xabk19r\xa0\x8d$\xbe\x84\xfe1y\x0f(\x02\xb7Go\xd7is\x97\xca\xf8~܀r\xc2\fӁ\x92\x976\xbcnoG\x8fp\xa2gzQ\xbd\x86\xd4B\x1b\x9e\x99\xb13F)\az7\xc4$\x88\xe0M73\xb3\x9e\x80ѤZ\x98k\x9e\xcbQ\xf5\x87P\xd5kA\x87\xb9E/b.\xb5\b\xc4j-\xb0\x8e\xd0 y\x18\xcd\x1c\x8c&2i\xa1\rW\xe3fM\xf2,\xc9%\xec\xadkC\x8e:\xf0\x84\xb2\x19\xf1\xf2\xb2\x9bh\xb8B\x82{\x96в\xb5\x94\x1aU\x16\xd6E\xb4\x82,\xb8\x19\xff\xf8v8JF\\9\xa1GN3\x88\x97,?/\xcc\xf2R\xe8X\xae\xb9\x8b\x8eG\xe3\xa8nX\xde\xd4o\xd0\xc7g\x18\xc8\xec\xabq:\x8cD\x87\x1eܸ\xfd\x1c\xfb\x94\xdcH\xdf\xe7\xac<\x96@\x95V\x06\xb2\x9b\xb4\xa31\x97\xca0ܻ\xed);\xcb\xc8ѱG!\xfdK\xb5\x1b\xf9\xaa\x92\xa6\xc9\x1de\xe2'tUg\xe5n\x05\xffv\xff\xa3\xc7:*1\v\x8f\x9e\xccl\\\xbb\xfc\xa4\xac\xe5\xba\x0e\xd8\x04_\xa1 \f\xb5\xd9\xe6\x1b\xc6\x12\xd6|\xe7\xf3\xaa\x03\x10\x17)\x13\xe08\xa9\x93\x9dW2x\xe3>\x9f\xdd\x1c\x8c\xb6\xaaKidvz\xfe\xccd\x82/c\xcaA\x8d\xb6\x8eR\x99\a\xa9\x02\x04\aO\xeeYٛ}I\x95+Y\xfe\x16>\xa4\x93\xcd\xf2\xads\xb9\xa6\x9b\xe7\x8b~\xf3\xe6\x83i4\xa4l۠\xa6CG]\x93\xd3\x03\xb4\x86\x06\xe65\xd6\xe4\x96|\xdbа4܆\x86$\xe46䖚{8\x8aASꞳY\x13\xfeA\xdb~[t.eC\x032\xb8\xf7;\xc0\xd72\xac\xe9\xd5@\xbf]\x1e\xc3\x1d2ǃ\x93\xed\xb7v\x9as\x8770\r\xbd]\x9d\xeb\xfe \xc5\xef8\"\x0f\x8fT\x1a\xf2r\x14D\xc3\x1f\xaex\x1f\xd1Pm\x1a\r|\xd6\xd2\xd0`\xf5\x18\xd1i\xdb:\x8a\x8122\xfcqLC\xbe6\xb6\x87\a3\ry\xdbۃ\x1eь0\x9ea\x0fk\xbc\x0fȏ\xaaq~vӐ\aM3\xdc\b=VCr\x00\xf3:\xa4F\xc6\x14\x9c\xe8\x02\xdb\xd0\xc9\x1c\xa9t\x81\xa5\v,\xb2\xb5\xa3\xba\xdd\a6\xfa!\xef\xb9\xc35h`\x95\x80\"\x11<{\x95\xa7\xbdQ\xc4\x1fs\xe1\x84B\xf3\x9c\\0i\x9e\xd3P\xcd害^\xedq\xb4\x93\x96q\x96\xf5`\xef\x95\x1d\n\xdd\xd6q\x85\xb1\x028?\v9\u007f2\xa3F\xfb\x16pPٚ&\x1e\xecS|!\xb4\t\xf0.\xc0\xf0\x8cA\xb0\xea\x06\xb2\tP;u\x1b!\x9d\\|t\xbb\x96Ȁ\xeaX\xbbQ\xcdG\r\xa4\x86J\t^\xb1E\x80\"\xfb\xfc\xa9\xfc\xfd\xe8|N\xb0涔c'aR\xa2\xed\x1e\x85L\xb4\x9dd!\xecJ!\x80\u007f=v\xd9l\x1d\xb3\x94\u007f\xfe~\x02\x8av\xc1\f\u007f`\xe3\x1b\"\xb9\x92\x86\xc7\xe5E\xe8\x12\b\x13;\x90!)\x8f\x1d\x85T\x1eZ\xa7\x1f26OqW6ǉ7R\xb1\x05\x0f\x82c\xb4\xe1u\x15\xe2\xdc\xd3O\xda\xf0\xf1\x8b?\xd5v\xe8$\xa1Y6Z\t~\xc3\xddnAx\x8bj\x8eG;\x1fPQ\x86\x93Mh\x9cv\xd9R*\r\xdfOT\x1a~K\xc1|{᪶\a\xc4\x00\xc3k\xcf\xfa\xac\x95\xa3\xbe\x1c;\xb9\a\x04d\x86\xeeQ0\xdbf\x9f\x95\xce\xc7\u007f썞\x8d\xb5Η\\\xf1\x93y\x91\xb33\xc4S\x11?\x8d\xfe\\\xe6\x80_@\x81\xc2\xe6g\x06|\v\x84H\xb2D\x8a+\x0er;\x83\xbdHD\x8d\x01zf\xc3F:k\xb2\x04\xfb}Q\xa01\x02~dL\xba\t4\x9f\xdf\x19\xae>\x8aL\xe8e\xdf(a\xe6\xa5\u074c쟏Yd\xf8*O\x99i_\xaf\xde\x0f\xeb\xf9cy\xb8\x8a\x8ck\xfd\x89\xb5\x02st^'l\xfa͞\xb4m\xddn\xe5\"T\xb5\xecJ\xadةF-\x17\x1e\xc0\xa6\xb6\t\xec\x1d\x13i\xa1\xf8\x8f\xa5\xe2z)\xd3NQ\x80\b\x82]\f\xeaR.\xe9%Oٓ%\xe3\xc1\x0f\xc3>\x9cd\xeb\xec\xf5Y1\xd6\xc69WB&A>R\xf3\xb4*Y\xd7\xc5\x05r\\\xaf\x98\x89\x97\x1f\x1esU\x17\x0f\xea=\xd8\x01\xd7p\xb8\x85\x00\xbaf#N\xaf\x92/\xeb\x99\f\xa7N\xd7,- \xc6\x0e\xd8A\x81:\x8e}\x1f:\xb6+\xf1l7\x89\xc3\x0f%\xd8\xe8+\xe9\xfb\xc2漿\x10\x10\xbe\xe21p\x9e!Gg\xffO\x00gT\xdb\x14to\xdd\xfe\x1d\x04ۮ\xbd\xe2\b٢\x96\xcd\t\x9a]Ȇ\x04ud߄\x80\xed\x87\x12\x88>\xa1\xb5m\xb6\xbemf\xdd`Vy\xec\x1f\x9fu;a6\x92u\xc6\\\xcd3\xcdM\x91_֎ꋺJ\xe7\xb5l\x83\xb5\xee\x19\xc1~\x1f\xd7E\xea\xdb\xca\xfb\xa4d\x91\xbf\xa2u\x96\xc9\xecz\xf3\x98\xe4\xf6\xfa\xcb+\x0ed\xfb\xa4\xa5\xf2c\xbc\xfe0^q\bk\xae\xe6\xafƾO\xe9\xcc\xea\xa1\xf9<\x9bL\x91\xdf<\xcb\xd0nwp\xd8\xf6\xe7\x0f\xa6\xef}n\xcc%OW\x17K\xa6:#h\xd6y\xde\xf50\xf4\x1aWv\xf4w\xef\xb9d\x11\t\x98\xa1|'U\xcco\x8c=\x1b\r\xea\x1b\xb6;h\x81\aeu(\xf7\xf7Ĳ\xa7\xef\x16\xaf\xf3\fn\x10\xcf\xc0A\xabjh\x1f\x95\xb4\x84\xca\xe1W\x95\x9c)\xb6\xe2\xe51\x05\xf1\xa2㜤p\x8f9\xea\xea\x00\xf7\x98\x01\xfde`o\x19\xe8\x87\xf6\xe1Y\x86\x056ܻ\x15z\xb3\x85\xfbd\xc5\xcb6\x06VȁK\x0eLj\xc0\x12\xe3\xcd\xcdh\x99\xf2\xde\xf4\xddq\xdd2\xfa^\xe4\x17\x8a\xb3\xee\a\tv\x15Z\xf6q\xc9S>\xa4\x8f\xdaU\xfe\xb5<T\xc7=:V%\v{\xb4\x00(#uoJ\xe6l\xc1\xecH\x1c\xc0N\xbd\x1dF\xb0h*\xf4\x8c\xd4\xc5\xdcۼm\xfa*o\xec\x1e\xfa\x83\xa8\xcdݺ\xbf\x9aj\xed7V;x\x0f\xb1U\xeb \xa6\xd7+\xe0\x83\xfe\x902mD\xfcW*\xe3\xfb\x1b#U\xa7\xa4B\xb6\xa3=\b\nZ\u007f\x10\x10\x0e43\bfNA\xb6\x16lcA*\x19\x02f\xc1\xee\u007f\xb1T&\xb4\xca7\xfbU(\xdeWj\x14\xb2\xe21\x8b\x97\"[\xd8\x12\xf1@˞\b}o\v1\x83;\xba\xbd\xee\xad\x19\x05\xeaǓ8\xf7\x05i\xc0\x9d\xf8\x12P\xbb\\m\x97\xa1\xf7\a\xb7ן\a\xc9\xddG\xd1\xe6\t\xab\t\"w\xfe\xf6+,\x11\f\xb4Fzɔ5\xeb\xc6\xcb\xdeo\x06\xdd\xfd\x93\xed`\\\xd7)\xe6\xf9\xb2\xbb|9(\xe8\x06x\xf8\x15ҧmO\xea\f\xba\x15\xb7\xb2\u05f7\x17\xc0\x83\x02\xe5\xbfAo^\x90{\x97\xbfГ\xfd͕\x97=cy\x15e\xdf\x0f\"K\xba\x87\x19\xd0<\xf2-\u007fG*4GchY\xdd\"\x90\x19\x06\xbex\xf0k\x15[\x95\xf3\xa4S%`/\x0ep/\f\xa0\xce\xd9\b\xfc\x82\x00\xfcA\xfe\xd2\x1f\xac/\x00<\xa6>ش\x020\xc6n\u007f\x16\x00\xd1x\xf6\xadڍ\x9a\x06ڤV\xcc\xee\x90\xf7\x8eL&\xfc\xaa\x98\xa7B/o\x8eY\xd1\xfb\xbe\x9b\x9f\x1b\xa3ļh͙l\xe85\x93q\x00\x97\xb4>\x1ck+\a@ae:\x93걃K\xae\xe2\xe2aغȨ\xa8\x18\xba\xc6\xf1h17xUbp\xe0\xed\xe4\xceu\x97\xfa\xbe8as\xa8Ջ\x947T\xdd]d߸\x1a\xba\xa3\x892\xb8\xea-X\x92\xa1\x06]8s\xcd\xda\x11_\xe5\xe6\xe9R\fK\xc5\xe6\x89(z\x93\x16`\x0e\x0f\xf1\x8b\u007f\x11+\xd1\xfb\xce\x1c\x9c\x91\xd4\xf3\xcdw\xf1\x04\xee\xfc\x16\x8cө\x06Cj\x00\xc7\u007f\xfe\xf96!\x1f\xe0Ã\xe8/D\x19r8v\xe9K\xb9\x05O\xec\xf8.&\xf5un\xb2\xd67y\xd8*\x1a\xfb\x12r\x97\xca\xf8~\x98C5a\x86iO\xc1\x9bM_\xb7\xb7\x83=\x8a\xd6/_\xc4\x1c\x06\xf6x\xda1\xf7<\xf1\xb2p\xe1\"\xa3\xf5\x80\x9dW]\x98k\x9e\xcbA\xf2.T\xf5\x9cf\xf8\xab\x15\xc5s\xa9\x85\xa7\xae\xd6\xc2v\x91\xf5\xe2\x87o\xc6\xec\xbc\x04\xf53\x8caQM\x9e%\xb9\x14=ym\x11|\xcfM(\x1ai\x9f\xff݇wo\x90\ue6caum\x96R\xf7\xe6\xf1A\x96\xc6˄\x9a\xe1\xea\x14\xa0J\x06̔\xd0\x03\xdd\xd4\xf1\x92\xe5\xe7\x85Y^\n\x1d\xcb5\x87\xe8\x00\x9b\x9d\xb3\xed\xf2\xa6~\xb07\xbcCO\xc7Z\xfd\"\xdbH\xab\xeb\x03\xd6\xdbϡZn\xb3z\x9f\xb3R\rY\xea\xa5L\xefb\x98WH\xfc\x93\xb9<\x91\xa1\xbe\xfdQu;\xaaVgt\xd5%~v\xcd⬔\xc6ο\xed\x0f\xd2U\xf5\rʍφ\x9d\xfb\x93:\xadkl\x91\x00Y3=\a\xd5l3\x06\xd7\xc5\xccww\xa0\xda\xc1q\x912\xd1\xe9\x17\x04\x9dk[\xa0\xfe\xc9,\x93}~wcv\x9eť42\x9b\xde}\xd2\x02\xfe\xefG8\xbb\xc1\xfc\xed\xf3\x06\x02\xeb?ʴ\xae\xa3Ɍ\xb2\x82\xdfO6\n\r\x00\xab\xf7\x1a\x87F\x80\xcf\xe3b\x83\x88\x9a\xb3\xa8\xf2\xb4.5\xf4\x90%i\x91qĚ\xb0ehÖ\f\xc6\x16\x9du\x98\x02|\xd9;x\x89YTqYD\xc4\u007f\xbf\x01\xb4\xa0\xecH/\x8e\xb15ja\x1a\xfb\xf9\xafAs\x82\x02G?^\x9d\xe0\x02f\xeeZ\xd6\xd5\x1d\xac\xdcI\x15EC\xc0\xc8\x1d9\xba\x94\x99v\x03\x17Go\uf234s4\x10\xec\xdbU\xf0\a\x81y;˾#X\xb73?W0\xee\xa0[\xcd\x01\\\xdba\xa7ፀ\xa9\x1c\xec\x88Ρ0\x14\xc7{8\x92\xc1L\x06\xb3\xb5\x01\x19\xcc59\x811#\xb3\xbaQ`\xcb\xc8it\x04Sƃ'cw\"|\x0f\x8e\x96\x9c\v\xdaE`Y\xf1\x96O\vx\xc8\xdd\vNl\x1d\x88\x05|\x18\"\xbdVp\xe1\xc0\xceK\b80,\x8eo\x05\xff\x05u\xe3\xe1m2\x04\xbc\xd7S\x86L/\x18\xaf\x05|\xd7*m=\xe0\xba\x01\xfd\xe4Vp\\P/\x00\xf0[P?\x13\xacq`\x01\xe9\v\x1e\xa7<\xe2\xc0z\x90\xc2\x04}\xe0\xae\xc3\xcb\x16X\xc0Z\x03n\\\x00\xd8*,\xb4\x88\x00S%a\xdf\xfb\x99E\xd8a\xe0\xa5\xc0\x89\x80\x81\x93\x82\x86\x0f\x04\x1f\x85\xf5e\x05\x17E\x9c\xe7AJ\xfd\xf4\x83\x81\xda\xc1?-`\x9fv\xfd\xd1\xebE\x99l\x80\x96JW\xb4\xb3\xa7\xd2\x15{?\xeb7\xa4|U\x9d\xf0Xc̾[m\xe0\x91G\x99\xd0r\xd4Ǫ\xb7\xb3\x02\x05\xb6\xe8C\x98@\xe0\x89\x01\x05\n\x01~\x88\xef\xcf\xe3\x02\xd9\xe2\xb9\x1es\xafz\x82\xb2\x96\xe5\rZ\xbe\xd60e~\x88\x15\xff~w\xa7ی\x89\x9e\x199\xc4\xf4\xab\x01\xdd^v\xd1\u007f\x18\xf6\x1d~`\xd4@w\x16]\xd8>\xee\x95{\x8d\xe1\xab\xdc\xe8k^ހz\x1c\x19\xf6S\x9f+\xd5\x1d\x84\xb5'U\x0f\xc9\xc8\xeeEW\xb0\xb4v\x92\xdfY\xcdҗdw4҆\x99\xe2`\xe1\xba\x17\x94i-\x16ي\xb7\xbe(뙄X\xae\xf2\x94W\x18\x8b\xa2m\r\xb6Қ0\xc3gF\xb4\xccGo\xe7\x1b\xfcF\x9fB\x9b2m\xae\x94\x9c\xf3\xf6\x01C\x87m\x1d|\xc3\xed\x87b\x99\x16\xdds\xe4\x99\xe5\x8ak=\xa4\xf4\xbf\xe2L\x0f@ki\x93;Ds\xd3sF\x0fڌ\xcfֽ\xf3\x17\xcfת\xf5g\xf5\a\xb6\xfe\xa9\x1c\xa0\xbf\x03+g&^~\xaf@\xcc<\xef\x01\x8f\x8a;a\x86\xd9Dz\xfe\xd4\x01:\v\x90\x87j\x12\xfa\f7\xbbDUO~z\xecv\x88\xf1\b\xcb\xca\x03\x99g\xa0l;X\xf8\xc0GMo/\xb5\x113O\xb7\x81&?\xca\xd7l\x17b\xe8\x14\r2a\xcb\xed\xd1\xfa\x87\x9d\\\xb7+\x92\xad\xccz\xd4&K\xa6q\xb8b;h\xe7\x8b%\x8f\xbd\x82\x8b\x81\xf5\x8f\x1f\xecީ\xe0@\aGf.O\xb4j\xf1\x82\x18\x1e\x84\xc6Lh\xcc^xÓ\xc6\b\x8d\xb9\x83\xb9\x0f\xaf\xa1\xf5'dY\x91e5\xa4\x8fA\x96\xd5\b&\xd2\xceC\xe8ُѥK;\x9b\xb4\u007f\xf9l\xdfKs\xf0\x97ʺ;\xf8\xb7\x9a\xed\x99ub^\xfcc\xf5\xa2>y\x1f\x19\xb5\xf1Rm|ԛ\u007fin\xf8,\x8eynx\xb2\x87X\\\xed\x9c\xe8_\xff\xaa\xfe'O\v\xc5\xd2\xcd\xff\xeeys\xa2\xff\xf9߳\xbaW\x9elı\xfe\xc7\xd9lv\xb6\xa7\x10\"5g\xf1;V\x98\xa5T\xe2Wu\r~w\xff_\xfa\x9d\x90\xff^\xff~V\xb3\xdaC\xb5>[qö\x17\xd2Xq\xb6\xbd\xc7k\xc3V\xf9\xfb(+\xd2\xf4\xac\xb4\x8a\x1a\xad\xcb\xf2\xfc\xdd}1\xe7*\xe3\x86W\x1dW\xbb6R<\xd1\xf7O2/\xa7o\xff_f+\x96\xb1\x05W3U\xf2S5\x1a\xf6\xec9\x8c\xf5\xac\xfe\xdeg8ʳf#\x97]\xee\x00\x8egQ*\xb4\x81\xf5\x90\xcbĹ\xed&\xef\x18\xd4~^\x1eM\xd5\u007f\xf1Góju^\xf6\xf8\u007fr~\xd0[5\xe3\xbc\xfa\xcf\x057\r\x83\xf2?\x1e\xaa>_\xb0\xdaM\U000fb12f_\xf2\xe0\x8f\xa5M\xb9\x95\xf7=V/\xfa/\xf2d\xcbۍ\x95Q\x82\xa5\xdd\x1f\x94TP\x94Pރ\xa4\xf8/\x91%\xa5B\xd8\x17fo\";\xdft^\xfe\xf7\xe6\xe0\xde\xceS\xcf8϶\xfbz\u007f\xb3\xf5m\f]Tڤ\xda\x1bu\xcb\xe7\x18\u05fbƛ\xb4\x8c\xb3\xfd\x83n\xdb\xe3&e\xe4p6Y\x9e\xebf\xe2.y\x9eʧRD\x06MX\xfd\xfcL\xc9t\x96\xa7,\xe3\xef\xb7\xff\x9br\xb5\xfd\xb2\xc3\x0f\xee\xfcE\xebW\xe8\x9cW%|\x15\xcfS\x113\xfd>\xfa\xfd\xec\xf0\x1e\xd3b\x13\xc2\xc6\x0e\x1b}y\xe7Nw\xa0\xac\xfb\xb3\x15E\xcfg\f\xc3\x19\xc6;\x8a\xb6\x13\x10\xed?\xf5\xdb\xf1\x9bE\xb1\\\xadؾ\xd55\x8b\xfe\xbd\xdf>\xdae\xdd\xeeqx_~\x8fn\x8e\xb2z\x88\x87\xcdZ\x11\xedS\xb1\x12\x87\xc1\x8e8/\xdeG\xbf\xff\xf6\xdb\xf3<\xa5\x15_I\xf5\xf4>\xfa\x8f?~\xfb*\xf6\xfeR\x9e\xd2\\\x0f\xe9C\xf3\xb8P\xc2<]\xc8\xcc\xf0\xc7g!I\x96\xa6\xf2\xe1J\x89\xb5H\xf9\x82\u007f\xd01Kk0\xd8莥ώ\xfam\x86õ\x94\x15\x8a\xd7&\xbb\xac9\xbd7?+\xb2s\xbd\xd9\xe8\u007f\xfe\xf1ǳ\xcbw\xf5\xb7o2+\xbbhox\xab\xb9z\xde\xcep\xb5\x12Y5\xa6O\x8aU\xc5D\xf7n\xff\xd1\uffdd\xfd\u007f\x00\x00\x00\xff\xffA0\xae\xc6\x01\v\f\x00")}
+var kustomizeBase = Asset{data: []byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec]_s\xdb6\x12\x7f\xe7\xa7\xe0\xf4]\xee\xa5\xedtn4Ӈ\xc4N:\xbe\xa6\x89\xcfv\xd2g\x88\\I8\x81\x00\x03\x80\xb6\x95\x9b\xfb\xee7 EI\xb6Eb\xf1\x87\xb6\xe5\xd2\xf4\x83-B\x8b\xc5b\xf7\xb7\x7f\x00\x82\xa4\xa4_A**\xf84\xbdy\x93\xac(ϧ\xe9'R\x80*I\x06I\x01\x9a\xe4D\x93i\x92\xa6\x8c̀)\xf3W\x9a\x92\xb2<YU3\x90\x1c4\xa8\x13*~䤀i*!W\xab\xb5(U\xdd*\x13\\K\xc1&%#\x1c\xa6\xed\xbf\f\xe4\xa4 \x9c,@&i\xba\xff\xbd\x89Z+\rE2\x99L\x92}\xc6HI\xe1N\x037\xff\xa9\x93\xd5?\xeb\x0eo\xde\xcc@\x93\x96\xe5\xd3JiQ\\\x82\x12\x95\xcc\xe0\f\xe6\x94SM\x05\xbf7\x02¹\xd0\xc4|\xbc\x19F\x06R\xb7\xbc\x18\x9a\x94\xff\a2=\xc9\xc8d.E\xf1\x80\xad\x1f\xdb\xff@\xdeP\xbe\x98\x98/\xef\x0fӌk\x01\xbc\x96ˬ\xa2,oh\xdel\xc5\xfb\x8f\x93\x9fN~J\xd24\x93P3qM\vP\x9a\x14\xe54\xe5\x15c\x1e\"n\x84\aw%HZ\x00\xd7\xead{\xf7$\x87\x9bD\x95\x90\x99\x91\x92<\xaf\xa5A\u0605\xa4\\\x83<\x15\xac*x\xdd\xd3$\xfd\xd7\xd5\xe7O\x17D/\xa7\xe9\x89\xd2DW\xea\xa4\\\x12\x055\x179\xa8L\xd2\xd2|y\x9a\xbe\xdfv\x946\r\xeb&\r\x13W\xbb\x0f\xf4\xba\x84i\xaa\xb4\xa4|\xd1с\xaa\xb2\f \x87\xfcZR\xc2\xd4㮮\xda\x06\xa9\u07b5\xd8\xf4\xd4\xde\xda\xeb\xcc\f\xaaѧC\xbd\xcd\te\xdd]}\xa8\xef>\xee\xa7\xf9\x1c\xdd\xc9\f\x94\xae\xbb81_\x7f\xdc\xcd;P\xba\xe9d\xaf\x0f\xf3\xe1!\x99e\x82\xb7zS\xdfVZ\x12\r\x8b\xf54\xfd\vfK!V\xf5\xa7\xb7\xcdߧ\x8c\x02ק\x82\xcf\xe9\xc2̨\xb92\xf2\xae\xe29\x83iz\xba\xf8\xed\xb7͇\xb5\xe6fжi\x99\xd8\xe8\xf5\x86\xdad\xd3\xea^\xa3\x1a\r\x1e\x9ai۠\xac\xe5\xf0cóN\xd2t!EUN\xd3\xfb\xba\xb8!\xd4t\xde\x18\xedN\x9f\xea\x0f\x19U\xfa\x8f\a7>ҍ\x80JVI\xc2\xee){\xfd\xb9\xa2|Q1\"\xf7\xef$iZJ0\xe3\x80/|\xc5\xc5-\xff@\x81\xe5j\x9a\xce\t\xab\x15[e\xc2\xe8\xe8\x0f?\x98\xbf\xab\x99\xdc\xe0\x86\x9a\xa6\xff\xfd_\x92\xa6;\x9b}CX\xb9$ov\x9fmlƌ\xe5\xde\xed4U\xd9\x12\n\xd2\nW\x94\xc0\xdf^\x9c\x7f\xfd\xf9\xea\xdeǆ3Q\x82Դ\x15Es\xed\xc1\xddާ\x8f\xf4\xa2\xbdj\xf9a\x1a\xee\xc3\xdf\ue9e1*f\x06\xed\xf6n\xb4p\x91\xa6\xfd\xccn@\xcf(%\xe5\xfaѭ4\xa5\x1a\x8a\x03\x1f\xf7\xd1\xdbSȃw:G\xb8\xbb\x84\xccA\x1e\xa6k\xef\xd9\\L܂\xbc \x92\x14\xa0\xbb)\xa1x1\xbfUY\xc6#'\xe1[E%<\x98\xf5\xdd5y\xc0}g\xb3\xfb\\u4\xebP\x90ݥ\xaa\"D\xd23Q=T`g\x89\xa4)U_\xcc`\xde\xe1\x88̈́`@xg\xbb\xb2\x95I\x0f\xdf=\xaa\xed&\x00\xbb\xba;ʢ\xf9\xbd\x05\xbaX\xea\x88$mj\xd7j\xd5\xd6\xe7u]\x93\ro\xbd\x8d\xacj\xb7ߌHI։\x1fۓF\x03;\xef\xeet!\xf1\xe2\xb4\xf7v7\xfb\x05hI\xb3\x98\x80\nR\n\xf9\xef\n\xe4\xfa\xf0}\x84*\x14\x94ӂ~\xb7\xe0r\x9fu\x05\xa1z\x1d]x\x7fY\xc8Nk |\xfdy\xdeusb\xa7\xbdk\xb4\v\f\x1f\xff|\v\x92}\x1dS\xf8\xcbN\x01\x83L\x8bN߃A\xaa\x82\xe8l\xf9\xfe\xce\x04T\xdb\xf8\xe7I\xc0q\x05k[\x13\x84\bv\x97\x19*\xe9\x11\x86\x17\xd1\x1b\xc2*\xfbPP\x92q\xee\xbb\x0fI\xdc\xe0\xb0U\xe7\x15\xf4әl\x85\xd8\xdb\xcc\x02\x8e.\xdc\xd7\xda\xf7q/->|\xed'\xb88\xfdB\xca\x191\x14k\x93\xbaA\xe2\xc5F\xdf\xc4u:\xddI\x03:\x89#\xab\xddӱ\xcd\x00\xaf:\xf1\xa4߬q\x18\xd2k#\x18ܰ \x06j\xca1(\x81\"dG\x06\x04&\xa0z\xea\x9b:\x8c\"\xd9l\xdfj\xf58\v\xe8\xe4\xcfj\xe3.\xd6m\x95\x18\xc2\x06\x0e\xde\xdc\x1a\xc15\x14%#\x1a\x9c\x8d\xe0`*\x8e\xe8\xf9pj\x8e\xeb\xd4\\s\xca\t\xa3\xdf{\x13\x1b\xab.\xa24Ѧ\x87\xbd\x83\xec\xb9)JmBкj:Mм\xdbD\x13\x14\x97\xd6\xf6\xfd\xb4\xb0^w\x99x\x89\xf5М\xf4%\xbc\xde2-\xc8\xdd\xe1\x1bi:\x17\xb2 \xba\x0e\x97\x7f\xfd\xa5\xa3\x8d=\xa0.(\x9f\x1e\xbc\x11\xab\x83\x00\xad\xf0\x9aW\xef\xd9\xd3\xd9\x12bN]ip\xf8\xf0-\xebȍ\x87!9\xe5\xa0\xd4\xefDw\xf5\xd0\xc3\x1c\x8e\xc5m\xed\xb1q\b\xd7=\x91\x15\x92m۴\xb5\x93w\xaf\xcf\xcev\xbdsi\x9b\xd1\xcd}\"\x17\xa0/\xa135\xc5Ȩ\xab\x9c\xec!\x9c\xb9)\x9d_\xf4$\xe1hJ\x8f\x8b\xd6\x1eD\xfa\xacӉH\xed˃)\xb5+\a\xb1\xa4]\xd1P\x11=_:R\xe3G\xe2\xc8Q\xb79H(\x19\xcd\xc8\x01-\xdf\xc3\xf9\x9f\x7fJ\\\x10\xbe\xbb 2&0c\x02\xf3\xba\x13\x18}ly\v)K)\xeehA4\\V\\\xd3>\xe0G\xe8\x19Q\x8a.\xb8Y\x1f\xee\xec\x11\xa1\xd2v\xb6q~\n\xc94\"\xd78\x80\x8a\xbf\xfebik\x8f\x82qV\xd7\x1b\xd8\xda\x13\x17\xa4\x12al\xd0\\\xbb\x1d\x00=\xe1\x13v\nq!\x14z\x12Qa\x14\x9a\x9a-\x94B\x13\xb2\xab\xa9\x03!kH\x85\xa6\xe6\x10V\xa1iZB+$\x1d\x84\xaa\xd6[\xcf\b;\x03F\xd6W\x90\t\x9e\xf7(Z\x7f,\xe3b\xafu\xf8\xf5\xb9\xf6\xfe\xdd!JT\x8c#\xda\xf8\x14\xad.\xa1 \x94S\xbe\xdd}\x142\x8c\xe6\xeav8\x8fE7[k@ulU\x91\x8d\x10\xedi\xa5\x03Ek>\xe7*u\x17pr\xe4\xd5\x01\xa8<(\xdbA˃(\xc6\xcfz\x11E\x80\x99\ae'`\xf3\xa0o\x059g\x9a(ߌ\x8d\x14\x8cY\xf76\xd8\xda_o\xab\xadM%\x81|\xdbc\x8amY\xebt\t\xd9\xeaE\xa2*΅\xb8\"\U0003d097\x85e\xc4\xe8=\xf5\xaeoj<\xbc\xed\x90\x02cD\xe9ZI\xae{3\x95\xfb,\xe4D\xc3Ĥ6I$\xc1\x99\bX\xe4\xcf*\bۮ\x13W\x83\xc0\x17`\xbctҕ\x15T\xa9\xc6s\xf6\xdc\xca8\xc1\x9d\xd8K<\xde\x12\xf5\xe6\to\xf9.\xae\aW(r,\x1by8\x1c\xbfQZ\vL\xfe妠\xf9r\x182\xba\xe9\x18/\x8f\xf1\xf2\x18/o\xe2\xe5\xe7\bt{\xd7o\x11>\x00k\x97x\x9bDK\xffXbV\xf3@Z%\xe1z)A-\x05˟'N{1\x913\x06\xe8г\x80\x01\xb81\x9a\x1e\xa3\xe91\x9a\x1e\xa3\xe9\xd7\x1eM\xa3\x9a\xd9Eg\x87 \x9cŻ\xc1\x0e\xca<\\\xa0\x06\t2N\xb3\xe6\x02,N\x84\xf1`\xe2\x00#N\x1c`4\xc3\x1540p\x81\x06\n\xa4\x11`ǁ\x86\x05\x1f@@K\xde\xc1d{\x1b)\xd0Uy\x06sR1}\xca*\xa5A^\n\xd6\x13\x19!8ܧyY\xb1\xbeQ[\x95\x12k\xb8\xa4\xa4\xbf\x9b\xe3\x04,\xcd\xd0f\x80\x9e\b\xacڤ)\x17\xbc=x\xe4\xcb\xe5\xc7\x17\xcch\x9bL\x7f\xda\x1d\xc4\xf0\x92\xd9|\xc1,ހ\x9c\xbdX\xf60`<1\xe7Y\xccTb\xeb\v\x05D}\f\u0558q\xd5\x1c<\xf26\xcbD\xc5\xf5\xa7\xde\f\r!\x8e\x9a\xe65QO\xb2\x1c\xb7\x04V\x9c.I\xf7s\xc4\x0e\x8c?\xa0\x18\xbb\xfca\b\x7fE\xc5\rH\xd5\xc4\xcah\x9b\xfffpU\xb3\x89i\x8eyr\xdc=\xb5w\x14\xd9^\xb0\x85\xa3\xdc\xfb\xd4\xf8\xe1\xc7\xc3\xd1l\xe0\x9e(\xbf\xffS\xb3\xfe\xc1\x9cV\x85\xea\xc0mF\xef=%\x84(ɇ\xf4\xe36\xc1\x9e\x13\x8d\aH\xc7=\x8e\x1e\xd8\xe9\xfd\x05<\xfbH\xb6\x1dz\xc7y\xa0}(\xc2\xe8\xe6 p\x94\xd5\ab\xfdI\xcai2\x8cƺi\xab\xb3\xa6:\xe9\xc4\x00SH\v\xb2\xb0\x8e\x0f=*\x8c\xb0\xd0\xc4Ԋ\x96\xa7\xe6\x14=$I\x8c\x8b14πAL\x9a7\xe6\x98=\xf8\xd3\x04=\xeaYl\xa00]c\x17'\x1d&`\x9f\xba\x14%Yt<\x9a\x1b\xa1\x13\xbc\x959\x126;\xbb>s\x86,<\xe3'}\xa3N\xd5l0\xb9oh\x9bCY\x06\xa0\xef\xe2^\xb6\xfa\xf5b]\x11f4V\xdeP\\\xd9\xf9\xa9\xf3\x96\xaf5&\xf4X\xaf\x15\b\xb0\x10@n\xd5{\xb3)\x8df\xef\x98\xc8VWZH\xab%\xb9\xc0\xcb\\a6J;\xeb_I\xa4\xa6X8qYss[ws\x85\b7\x80h<\xc3\xf9Yd\xe9amw\xb2e \x89d\x8f\xe4{%ጪUL\r\xcbH\xb6\xa4|\xf1\xa7\xc8\xe3\xabYNժ\xbf\x12\x11@\xf8\xcb\xe5yt\xba\x03\x99\xdb \xbb\x8c\x862\x1c\xbc~\xb7Ӌj\xf8\xe5\xf2<\xaa\x1d|\xa0\fb\xda\xc1p8\xa4 \x93`\xa9\xc8y\xea\x80Z\x12\t\x03P\xc6\xeb\xc0np\xf6\xa6-\xb3\xb1\xf4 \x83r9W1\x95\xa0\x10\x9cj!\x11-\xd1Ʉ\xa3\xe4qQ\x0e\xee\xe8Fo\x16\x86\xb6\x05\x8c\xed:3ݐFV\xd0\\\x94\xc2-Cr\xe4\xdaA\xdd\xcdo\xa5\xfaO9\xf6\xe0\x01o\xeb\xadu$\x91\x06\x94Q\xdes\x9a\xb4\xcfT\r俇\xb6\x87W\xae\xb4\xaf.\x10G\x97=]f,o\x16\xfd\xb1\x81\xf8\xb0Y\x19ڹ9x\xc1\x97\xb7e\xb1@\xca\xdaO\xe2>rws\xea\x9e\x03\xc7\x1a\x0e~?Q۲Ĕ\xa9\x9cL\xcd=\"¢\x9e\x93\xd8\xcc\xe9\x88f7Rl\x0f\x80\x87\x1cE\xa7I<\xfb\xca%\xbd\x01y,\xf93\x179\\T3F\xd5\xf2\xea\xef\xe00\x87\x8b7\x1aW\xfcVkIgU\xef3(a{\xf1\x86\x96\x11\xde\xf77\x9a\x9eD\xea9\x17\xb7\xfc\x96\xc8\xfc\xed\xc5yTs\x1c}\xffC\xdf_?\xf3\x87\xb4s\xdf>\xdc\x1e\x91\n\xd2m\xafG\x19\x03{s\xf3\xf3\xe6\x9a\xec\xf8C\x7f\xc7\xc1j\xff\xe6q\xd7n\xdb\xe5\x87'Rn\xf3:@B9Hl\x8d0ht\xe67\xa77T\xe1\xf6\xea\a\xf7\xd5J\xf3\x85\x9aR\xcb\xdep\x96\xe4\xc6\xd5K\b\xcfф͡W\xeb3jU$\x17\xbb( \xa7\xdd\xef\xd4\xf2\xd6\x11E\xbf\xc3GZP\x1d\x992ZV\xf3l\xda{\xffE\xd4\xd0X\xf5\xaa\x17\x9b\x9b\xa7\xc8\xff\xfa\xebS\xec\xc8\xc9I\xc8.\xa6\x98\xa6\xb7\xb74?\x1av\xf1\xd6\xc0\xe0\xae\xd9\x00\x12\xd3*\x8e+an\xca\x15G\x9bݍ\x15\xf7\xa0\x8a\xfbs%\xc7s\xb3\x05\ndT\xb3#\x9a\xa8\x81\x16\xcf\xcdAy\n\xf4\x97/\xd1W&\xd0\x12[dpa\x92O\xa5\x81\xeb\xd8;{^\xffޱ2\x1fD1\x86\x02\x1f\xbc]6\x03\x8b\xa6eT_B)b\xeaVNe\xfd\x96\xb2\xf5\x00\xc2/\x85\xa2\x03\x91\xbe\xa1\xd8B\x8f\x03a\xfc\xb4\xee\xc6\x16mj\x9bǎ\xe3\xee\x82\x01\x9e\x97\xe2\xf0[\xa7\x83\x04\x85/\xb0\x1c\x99\xb9n\x05\x96D\xc8\xc0\xd1s\xbf\x14\n\xf5܁\xcb\xd4\x0f2A}oC\xf1$\x8a\x9f\x9b\x98\x12\xa7*\xf22\\\xb6$\xe5\xdbJ/Ϩ\xca\xc4M\xcf[l}ux\xd7\xc5U\xf3n\xd9\xf8\x1d\f\x14f4'gi\x81.]\xbaQ\xff\x16\xdb\vl\xb4\xe3\x9ck\x90s\x92\xbd\xd2\x02J)\xa4&\xech\x8a\x06c\"\x19\x94H\xb6e\xad\x8bz֧IT^\xf0\x10N\xbf\xd9fc\x92\xb2\xca\xdef\x7f0I$!a&\n-\x14>W/ޙ\x0fiP2~\x89-j\xa00\xd9\xf0\x18Ky\xcam\xee\xdf\x14,O\x19\xa1EL\r\xc8\f\xc1W\x9a#o\xc7\x16m6\x96B\v~|\xf5\x98\xfc\x19w֖y\xbc]\xb5&\xb6\xb8\x15r\x80\xe2\xfd@\x92\x1f\x0e\n_ݎ\xe9R\n\xd3\x06\xf2\x98\xd3\xfa\xa2vM!\xcf\xd3r\x8c\x8c]\xc4\xe1\xb87=\xa4\x13\xc7Q\x04|ŗ;\x8f\r\xe4^&\x15\xba\xb9\xc9_=\xc3\xd4\xd57V\x8c(*,\x1a\xf9oHw\f\xef\xbc\xd1-$AuK%\xa2\b\xdfe3\xbb\xaf\a\v\x90\xa1\xc3\x1e\xdbP\x948\x06\fs\xdf\b\x1b\xa7ߐͱ\xc1*\x1a\xbca6\x1a\a\xbe(廝6\xc8tF/\xe4\xec\x85B6\xe5\xc63\xb4\x80\x8d\xba\x91\xa4\xe0\xbdy7b\xff\xedL\x1c\xa5\xa9\xb7\xcc?\xad\xa5\xfb\xf2\xfc\xf2\x03\"\x8fΚ\x92\xfc4\x19\xd6j\xc7\xc4gL|\xc6\xc4gL|\xa2'>\xea\xde\x11\xde\xd7b\x05|h,#UN\x81gO#~\xb8+i\xf3\xe6t\xe4۞:q\xe2\xd7_\x92\xa7@\bwl\xf0\x92\x8c;\x1e8Z\xb5\xb3.:~\x01o\xf6ءN\xdazj\x12\x89\xd1o\x950ﰟ&\xf1\xachaޙ1E\x8b\xe7\xd9\x17\x15$,\xa8\xd2\x03\xec\xab\xd4\xc0\t\x8f\xfd\xf4\xd3 g\x1d\xb5++\x91\xc9\u2d7a\x9d\x03kÆ\xcfX\xda/gyL\xcd\x1fh=\ru\x1a\xb63\xd5\x15\xac\xb1\xafKp\xa2{\x84g\xc5\t\xc1\xa2\x8ba\xdc`\x15\xb4\xc1\xeaY\x0ft\xab\r\xeeɏ}S\x19ap\xfey\x9aěс\x00iA4ܒ\xf8\x0e\xb3\x94BCf\x02\xe13Q\x10ʣw0\x1ae\x90Q*\xc5\xdes2cv\x1b\xf2\x10\xa0\x16\x92,\x00\xbbi\xc1i\x98\x1b\xda\x17C\xe0\xbcZ+\r\xf1\x1f\x9eo\xe2\x9cO\xe4\x19c\xb2\x8d\x95[\xdbmU\xdf\u07b2\x96U\x12I#qUͣ\xdd8\x83\x8e\x8d\x1c\x82(W\xe0q,\x98:i\xb8Oq\xd4U\xe2>r\xf7)q8\x0f\x1ck\x85\xae\x05N\x87\x02\x88\x13\xf2\xbb\x05\xd4.\xf5D\x1f7;\x00,\xe2Q\xa7\xf1%B\xc5\x04\x9e\x81\xe2\xb41\xdc\t\nw\x06\xf3\xc1\xfb\xa4UI\xb2gS\xe6\x1bU.A\xc2\xd1\xec,\xde\x06r\x8cf\xeb\xf3\xb3a\xe9\x0f8\xf1\xd8};\x83\xc4u;\x16\xe2\xa8ыz͑&R_\xd3\x02>\xcf\xe7\xaa/8DHVCQ\xb2\xde\xf7\xbc\xe1,\xa2\x00M̙\x1a}m\x90\x02HSU\x82\xe5T+\xbc\x99\x92L\xd3\x1b8\x03\x923\xca\x01\xbd\xf6\xe4\xb6\xde\xe4\x12|\xcdH\xb6\x12\xf39\xf2\xd00\xb7`Ѕ\x8fL\x14%\x03\xe4QAñQ\x10^\x11v\x05\xac>Rb\x9a\xc4t\xe1\xe6ժ\x8c\x01\xa3\xaax\xce!*\xf4\xe0\xf0j\xbd}\xa1\xbeyI]\xf3t7\xea;N\x99\x94+C\x1e\xd9\x14\n\xa4\x1e_\x86+\x82\x12i`G7\xa8\xd7={\xcb7\x803\x8c\xa7\xf0\xf1b~9\x99i\xdbN\n\xf2\vHg\xe0;\xde\xda:>\x92\x19\xe0\x9eX\x0f9\f\xcdc\x06\x9d\x06\xef\xd0\xd8\xee\xcf\xfdL\x1b\xe7\xdf=\x18\xc6\xf9{?\xa6\x03\xfc\xff\x01\xaf\xe0\xb0\xef\xc4\xc5;\xb4?d>7\xc7^8`\xa7\xbb0\xda\xf3\xf4\xdf:\xf7\xe5ߟ\xb9J\ts\x90\x12\xf2\xb3\xcaX\xc8U\xb6\x84\xbcb\x94/\xce\x17\\l?~\x7f\aY\x85=\\,\x18tCǴ?2\xf7]T\xf18\xf0\x8f\x04\"\x8b2\ue43cb\x89H\xe0\x1c7\xee\x18\x9c)\xf7\x18e\xb0\x99\x8f>6W\xaf\x1f/\xf6\t\x8f\x88\"\xc5IQ\\\xec\x90ҭѧ~\x80f\x04\x9e\x11xF\xe0\x19\x81\xe7I\x80'\x98\x91[\xa0\x8b%\xa2\x06g\xcd\f\x10\xf5\xa2xyB,՚\xecŮ^_oė<\xf1\xc4\xf9\xebM+\xac\xa1r\x900\xe7cҲ\xb6\nz\r\xd2\x17\x0f\x83\xa0tLE\xc6TdLE\xc6TdLE\xc6TdLE\xc6TdLE\x9e*\x15\ta\xc1\x7fR'\x8f\xa3\xce\xe4Ɇ\xee\xf9\xc5R\xe4\xe3\xeaE\xb4Ջ\x9d0M\xce\xe1G$\x9c\rs1\xb3R۪\xa2?\x998\xcc\xc4Nd\"z\x96XË\x18[Dww1c\x8c\x01\x98\x8b\x15kDԊA\xc6\x19\xe6\x96\u2e69\xf8\xf1G\xc4\x18$\xc0\x95\r)q\xe7\xdd/\xc3\xec\x8by\x12]\x8d$\xfe(dx\xbb\xdb=HD\x11P!\x92|c\xe8\xa3\x16\xa5`b\xb1\xfe#\xcc\xd9D\x18Q(\x06M\xf6ǒ<\x93\x9a\xfd\xed\x97-\xee\a\xad\xe3\xdaE\x84\xb5\vo\xc0\t\rG\xa3\x84\xfd\xe3\xbaŸn1\xae[\x8c\xeb\x16G\xben\x11\x1e\xae\xc7\x0f\xd5#\xe9a\x04Q\a\x93\b\x0f\xcd\x03\xad;\x82,Cu-B(\x1e8\x8a\x10\f\t\r\xbf\x83T\xc8W\xf4\x9e\x9d\x9a:7״\x8dt\xa7\xc9\xd3D\x0fc\xad{\xacu\x8f\xb5\xee\xb1\xd6=ֺ\xc7Z\xf7X\xeb\x0e\xaeu\xff\x9f\xbdk\xd9m#W\xa2{}\x85\x7f\xc0\xbb \v\xed.\x9c87\xb8I,D7\xb3\xa7\xbb\xcb2\x11\xaa\xd9 ٲ\xf4\xf7\x03\xea5\xf1 @\xc2:\xe5fZ\xae\xc9l[$\xeb\xc5:\xa7\xcc*\xf1\x80\x01\xa50\xc2?\xa3\\\xb7r\xdd\xcau+\u05ed\\\xb7r\xdd\xcau+\u05ed\\\xb7r\xdd\xcau+\u05ed\\\xb7r\xdd\x05\\7\xe3#3$\xbf\xf6C\x97\x96\xc8Ԭ\xb2n\xb0\xff\x9a\xd5Y\xe0\x1bŮ\xc4M\x88LX\x15~\xc1\xf6s\xc03\xb8\xc6\xd5\xf8\xf5\xdat\xed\xe5\x1e\x90\xba\xcdH\x87\xc32\xee\x92\xd6\xc5bB=g\xae\xf5V\xbe\r\x9eY6\xc2\xe4}\x8c;\x0fv\xf5\xd9\xf4\xff\xa3\xddov\xa4~\xa9\xad\x88\x00-P\x1f\xb8)\no\x847]R\xe2F\x92M;$`\v+\v\x90\x9b{/i\xe7\xf8\f|Q+\x83\xe7\xe1\v\xeeF\xc2ʐ\t\xf9b\xb6&1\x02^\xd2\xe6D\xc6\xc1\v*\x1a\x1e\r/\xba\x97\x93\xb6.\xc4\x03Nǩ\xe9\x00\x879\x17\x9aXhbq\xe9\x89\x05\xf4\x03\xfc\xd3\xffr0\x84\xf8n\x01\b\xcaA9U`\xe8\x19\x12\xb1\xc3\x16\x1e\xb2\xb00!\x10\"\xf0\xf0\x80\x87\x06ȫ\xf2\xc5A\x0fv;\x9fU\x90`ф'\xb5\x9f?\xd1~*\x04\xc9\xdf\x1eU-\xa2\xab\xfdj\x8b\xc1\xb9\xc3\xc0\xa6\xd1\xd6u\xf6\x81\x9a]\xe3\x8aO\x8axE\xefcZ\xe6\xf1F\xf3\xd9\xf8\xeeH\xdb\xdf\x1dC \xbf6@p\v\\\xc4\"\x06#\xe3\\\"\x9e}\xf8\xff1\xa5\xfeï\x87\xa7\xbe\xa4J\x1f}LsX\x8a\xa02\xb2\x1c\xfeK\xa6-*Z\xbd\x88mI\x88T\xe2\xe2\x14\x15/\\\x9bx\x91\x1d\xe1X\r@.?\xfe\xbb>\x14O\xa0߀C\x81TL*\x9d\x99\xfbB\n\xee=\xef\x86<\xfdg\xba\xdd\x1d3\xe7=)U\xc8P\xaf\xe1?\xb0=f\xf2\xcd#\xad\xa9\xb2bP\x9f˽-B\x9aU\xf2\x91\xd4\xf4K\xdf|\x7f\xe5\x17f\xbe0ս\x9e\xbbה\xed\x1a\xfa\xbc\x0f\xb4L\xbe\x9f\xcf\xc6w\x06\x05#\nF\x14\x8c(\x18Q0\xa2`D\xc1\x88\x82\x11\x05#\nF\x14\x8c\xbc^0\xc2\xfe\xd4\xd9\ru\x14\xe3\"\xf8\xfb☄x\x11\x17\xc1\xa0\x9e\v\"\x170\xb3\x14\xf0\x15,3\x00l\xec\xea\xea\xc1X7\x04\xfa\xffc\xa0\xf8\xe8\x1dK\x88\xe8\xab{,H\x008\v5<亀\xcdF\x00W\x81\x96\x8f\x8aO\nK\tx\xa0\x10\x86\x12\xd9\tz\xdf\xc1\xb8\t\xc5LP@\x92\x88\x88\x18N\x82\x95\x88$pP\xf2&\x92\xb8I$m\x18\x1e\x02\x0f\x81\xf8\x0f;W\x84l>\xf7(\xb4ƽ#gvKj|\xd7\xc6\xe9\xdd\xc3=\x05\xeb\xdb\xc9n?\x0eMC1N8\x11\x82\x10\xf4\xa4S\xa1\xd7\x1ep\xa7\x17\xf1\x92]\x93\x1f\xd2D\xa3\x05\xfb\xe8\x9c\\\x97maY\xafŢeB\x02,|\x9c\x1f\xc0-؎\x8c\x9a\x03f\x10\xa7\b\xf8q\xc1\xdb=\x18E\xf2\xd2S\x16\x1d\x1f\x02\x82\x82\xeb\x83O\xbe\xf1\xae\xc2\xe2\xfc\x98}\xfd\xdc_f#\x06n.,\vdZ\xab\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xcaIV\xe3$O\r\x89\x8aO\x8c\xb8\xa7\xb3k[NN\xcav\x81\x06\xdd\x04\xb2\xb5\xec\x1d\x14_\xaf\x04؟Fj\x86`\xd3\xee\xc6w\x89\xb6iL\x9b5\xce\xf9\xa7E\xb0\x1b\xebhE\xefcc\x9c\xe1\r\xa4@{h4\xa67\xf7\xd6Y\xae\xe6\x11)\x1c-\xb0\x12\xbc\a-V\x02Ƶ\xc1\xf7\xaf\xf5\xf4\x80\xc7g\xab;\xbaN;\xbe\xc7\xf4\xc17\x9fs\xff\xf0\xf9ld\xb1g\xea\xfb\xaes\xbb\xafާ[\xeb(\xeeb\xa2\xf5\xf8\x12\bC\xf7\x9f\xf8!\xf8\xa1\a3\x9b\xb7oF\xcel\x8e{\xff\xe2\xbb,\xc3J\x92\xfb\x16)LOp\x91>\xd9n\xd8\xde\xed{>U\xb9*\x1cm\x88\xddk\n\x8ew\xc1\x97w3\x12[|\xff\x03\xb5\x16\x1f\x98\xf6*\xb08tI<ٮ\xf5O\xb1\xa2Ů\xd6\xd1\xdc\x04j\xa9KָeOM%9\xfel+H\x87`x;\xe7@Xq\x17\x80q\xb1?\x8d\xb9?\xd9\xd0k\xedZk\xd7Z\xbb\xd6ڵ֮\xb5v\xad\xb5k\xad]k\xedZk\xd7Z\xbb\xd6ڵ֮\xb5v\xad\xb5\xeb*\xb5\xeb\x98Z[\\\x7f\xe3\xb3\xc1\xfb\xe5\uee86\xc6[2QX\xdbn_g\xfcL1\xe6\xce\xf0\x8cT\x8c\xedU?Y~ܦ\xf4)\xed\xc6\x13\xf6ƻaM\xef(O\x98-v\"&\xf8\xc3.\x8av\xbfW\xfe\x808\xb6^P\xac\t-̏\xcf\xd7?Hl6\x12\f\x04\x02;\x17\xb6\x1d\fy_\xf0\x9c\x86\x1d\xefg;W3\xe3\xc3\xea\xc1\xf7f\xc5\xfe\x8b\x8e\xa9\xfa\xd2?\x15jdqN\xb4=ީ\xc3}5\xbd\x1f\xd7~\xbf\xedC\x85\xf5\x91(v\xf6\x97\v\x0ebO>|\xb7\xdd\xea\x9d-V\x0eS-\x1c\x85\x14\x8b\x93%\xc8r\x11\xb6]\xbcُK\x9b\xcf^6\x82\xe7\xe3G\n\x9bbڜuϰ\x1d\x8eg\x84\x9eS\x86f\x1d\f\xb9>\xb97\a[\x98\x10\xbb\x0f\xac\xca\x0eB<\xedG2\xa1y\xa41\xd4?\xaa]3\xc4\xd8v\xb1t\x1a\x19\xe3Hԙ{GK\n\x19\xd4|\xb2\xdd\xf7\x029r\xf2\x10\xeas\x9b\xaa`\xdc\xcd\xe9A}\xc1\x82\xc5z溸\t\xab\xc2/\x98F\xc8\xd2\x19b\x88\xc0\x1f\x1bL\xe7\x80\xd4mF:\x1cr\x87T\xc4\x1f@\x9dXbe\xce\xf8[\ty?\x9bh\xab\xb3\xb8u\x16\xf7\xa5\xcf⾺z\xb0\xe4\xda?\xc0\xceMo\xff\xa2\x10\xd9L\x8f\xb0\x95\xed\xc5\xc2'ADw#ae\xe7\xf3Դ\xb5ӳ\xc6\xdb?\xc4\xe6\xce=\x9b\x90?\x9e\x15T\xf4\xd5Uk76z&\xf3%\xbc\x97\x93\xb6.\xc4\x03Nǩ\xe9\x00\x87Q\xe7\x9aXhbq\xe9\x89\x05\xf4\x03\xfc\xd3O\x87I\xa7n\xc3A9U`\xe8\x19\x12\xb1\xc3\x16\x1e\xb2\xb00!\x10\"\xf0\xf0\x80\x87\x06ȫ\xf2\xc5A\x0fv;\x9fU\x90\xe0\xe1\xe6S\xfb\x99\xb0\xfdT\b\x92vmV\xc5Zc\xebj\xbf\xdabp\xae\x94V\a\xd7u\xf6\x81\x9a]S\xfe,\x16\xf1\x8a\xdeǴ̏\xe8\xe6\xb3\xf1ݑ\xfb\x8aNbm\x81\xd7t\xc0E,b02\xce%\xe2\xd9\xf0\xfb4)\x95\"\x7f\x9c-\xa6\f\x81\xf7jB\xb6%!R\x89\x8bST\xbcpm\xe2Ev\x84c5\x00\xb9H\xbei\x13\t\x05R1\t{\xdf&\xa6`\xe4\xd9\x05\xfc\xf4B\xec\xf9\x85\xcc\x13\f\x89woB\x8aA}\x8e\xfd\"D\xc4G\xa0WL\x17ua\xaa{=w\xaf)\xdb5\xf4y\x1fh\x99xM\xdc\x14\x8c(\x18Q0\xa2`D\xc1\x88\x82\x11\x05#\nF\x14\x8c(\x18Q0\xa2`\x84\vF؟:\xbb!\x1d\xa8\xa7\x03\xf5t\xa0\x9e\x0e\xd4Ӂz:PO\a\xea\xe9@=\x1d\xa8\xa7\x03\xf5t\xa0\x9e\x0e\xd4Ӂz:PO\a\xeaU\x1b\xa8\xc7\xc9u\xd9\x16\x96\xf5Z,Z&$\xc0\xc2\xc7\xf9\x01܂\xedȨ9`\x06q\x8a\x80\x1f\x17\xbc݃Q$/=e\xd1\xf1! (\xb8>\xf8\xe4\x1b\xef*,Ώ\xd9\xd7\xcf\xfde6b\xe0\xe6²\xdcG\xce*'\xa9\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xcaI*'\xa9\x9c\xa4r\x92\xd58\xc9SC\xa2\xe2\x13#\xee\xe9\xecږ\x93\x93\xf9\x9fi[{\xe8`\xb0\x00\xc3\x03\xe8&\x90\xade\xef\xa0\xf8z%\xc0\xfe4R3\x04\x9bv\xb9-/mӘ6k\x9c\xf3O\x8b`7\xd6ъ\xde\xc7\xc68\xf6H\b\xac\x87Fczso\x9d\xe5j\x1e\x91\xc2\xd1\x02+\xc1{\xd0b%`\\\x1b|\xffZO\x0fx|\xb6\xba\xa3\xeb\xb4\xe3{L\x1f|\xb3\x9fy3\x9f\x8d,\xf6\xd3\b\x95\xafާ[\xeb(\xeeb\xa2\xf5\xf8\x12\xd8Oh\xff\x10\xfcЃ\x99\xcd\xdb7#g6ǽ\x7f\xf1]\x96a%\xc9}\x8b\x14\xa6'\xb8H\x9fl7l\xef8\xb3*d\xae\nG\x1bb\xf7\x9a\x82\xe3]\xf0\xe5\u074c\xc4\x16\xdf\xff@\xad\xc5\a\xa6\xbd\n,\x0e]\x12O\xb6k\xfdS\xach\xb1\xabu47\x81Z\xea\x925n\xd9SSI\x8e?\xdb\n\xd2!\x18\xde\xce9\x10V\xdc\x05`\\\xecOc\xeeO6\xf4Z\xbb\xd6ڵ֮\xb5v\xad\xb5k\xad]k\xedZkׅ\xb5\xeb\xbfٻ\x96\xdeFr#|ׯ\xf0\x1f\xf0%\tr\xf0\xcd\x19\xcf\f\x8c\xccC\xb0\xe2\xd93\xdd]\x92\tS\xcd^\x92-Y\xfb\xeb\x03\xb6\x1e\xb6\x91\r\x16\xfc\x8a\"-\xb9 _\xdbE\xd6\xe3c\xbdȒڵԮ\xa5v-\xb5k\xa9]K\xedZj\xd7yj\xd7>\xb4:\xb9\xfe\x86g\x83Gr?\xbb\x86ʑ\f\xca-(\x1c\x86\x90\"\x99\x17ؤ\x02\xb9\xa5\xee\xc6\x1a\xe7w\xf2>\xbeJ\x0f\xb40\xe6$_\xf6A\xfc\x106\xe5\x04\xbd\xb2fX\xd2\r\xc5\xf9\xb6\xc9\x06\f\x06\x9e\xbcC\xaa\x1d\u05ca\x0f\xa7\x83\xe5R\xf5\xd2\x10~6\\\xbe\xe2ؤP\b\xca8TАq\xab\xc8c\xb1\xf54\xf4x\x19\x97ZM\x8d\xb7ԝ\xed\xd5\x02\xee&9U[z\xa9\x8es\x88#h\xbb;χ\x87jr\xdf\xd1\xfe\xfcܻ\n\xf49(v\xb0\x973\x06\xb1\xb5uO\xba[\xdc\xe8d\xe1\x80bA\x04\x92\xccN\x88\x91\xe9,\x8c\xb1\xeb\xb5\xd1ʧ\xe0q2\xf4\xa3\xa0\x1fW\x17\xf9V\xeapb\xd8)\xaa\xbd\xba/\xa4\xb5\x05\x15\xeav\xfa\xe9jr̃!\xea\xc5\x0f\n\xd1\xf2\x8fOhz{s|\"i\xa7:\xa0\x02\x87Ie\xb3q\xac\xde{\xb4vĳy\xdf\xc6\x10/f\x1d\xf2\x01\xef\x91\xe5\xca-\x12\xbf\x00\x16ǒ\x14\xc6x\xd6\xc5\xfe\xd3\xd9 u\xabB\x9bC\x15\xacr\xcc¨k砌\x8c\xeb\xcd\xc1\xef7\x13xev\xb8\xcc\x0e?\xf7\xd9\xe1\x17\x17sM\xa6}\az\xaez\xfd\x8b\x9c\x87\xb3C\x99\xb5ld\v\x9e8ɺ\x9a\x1cZv\xd8OM]\xdb_\xc3\xfc\xf2Nt\xaeᔜ\x8e 苋V\xaf\xb4\xb7`\xb6,\xf3Z\xf6\xd2:\x13\v\xd8o\xa7\xa6\x01lG\xb3\x8bc!\x8eŹ;\x16\xac\x7f\x80\xef\xfet\xb2\xefԭ\x90(\xa7J\x18z\b\x89`\xd8\xe2C\x16\x0f&2@\x04\x1f\x1e\xf8\xd0\xc0\xb2\xaaxp\xd0\\?_M*pp{\xf2\x89\xfe\x9c\xb0\xfeT\x00\xc91\xf9~5)$\xabC\xaa\xbfp\x0f\x96\xd1sj6M\xfa5^\x8eU\xf4ևY\xbc\xf4w5)o\x8e苵9hgx\xb9\x96q\x10gQ\x98<ƕŲ\xd9\xf7\xe9r\x89\x94\xd3L\x9eM\x18\x19ކͤ[9X\x9a\xe3\xe0\xcc\xca^vm\xe2(+\xe2\xc7j\x8c\xc8%\xe7\xfb\xb1Y\xa0 \x17&\xf1\xee\xe3e\x130\xe7\x9a\b\xfb\xaaH\xb6\xeb\"y\xae\x8c丧\x97I0\\\x9b\x83o\xb0d\xb1\x11֭\xab\xb3:0żޚ\xd7)\xeb5\xeb\xf3\xde\xd1,`\x8f\xceI0\"\xc1\x88\x04#\x12\x8cH0\"\xc1\x88\x04#\x12\x8cH0\"\xc1\x88\x04#\x12\x8c\xa0\xc1\b\xfc\xa9\xd1+\x92\x01\x802\x00P\x06\x00\xca\x00@\x19\x00(\x03\x00e\x00\xa0\f\x00\x94\x01\x802\x00P\x06\x00\xca\x00@\x19\x00(\x03\x00e\x00`\xb5\x01\x80\x88\xaf\vkX\x94k2k\xc1\x90\x80\a\x1f\x87\vpSؐ\xb9\xea\xc0S\x88=\x02\xdeN\xb1\xd53Q$\x92>e\xd6\xe1! \x93q\xbd\xb3\xc16\xd6T \x8ec\xf6\xe5[{\x99\x14\x04n4,\x8bo\xcfi\xc9IJNRr\x92\x92\x93\x94\x9c\xa4\xe4$%')9I\xc9IJNRr\x92\x92\x93\x94\x9c\xa4\xe4$%'Y-'\xb9\x7f\x90(y\xc7\x1c\xf34z\xa9ӓ\x93\xf1\xa7\xdaVo_0\x982\xe1\x81i&,]\x8b\xd6A\xfe\xe3r\x00\xfe\xd4S38\x1d6\xf1Y^z\x0e%uV\x19c\xd7S\xa7W\xdaЂ>\xfbF\x19x\x8c\x04\xef\r\x8dF\xf5\xeaA\x1b\x8dJ\x9eÅ\x9d\x06V\n\xef\x99\x1a\x9b#\x8ck\x9d\xed?\xea\xee\x19\x16\x1f\xb5ng:my\x8b\xe9\x9dm\xc699W\x93\xc2lߏ]\xb9\xb36|ц\xfc\xc6\aZ\x96\xe7\xc08Q\xfe\xab\xb3C\xcf\xf4l\xfe\xf9\x8f\u009e\xcdn\xed?l\x17yX\x89s\xf7\x9e\xdc\xe91\xce\xd37\xdd\r\xcf?\xc77ê\x1c\x15\x86V\x04\xbf5\xc5\xc6;g\xd3_3\xcaF|\xfc\a\xb5\x88\x0f\xa0\xbef \xce:$ֺk\xed\xdaW\xd4\xd8\xc5ҫO\x8eZ\xea\xe2`\xe3YOM%>\xfe\xd9R8/\x04\xb3\x97s\x00\u008a\xab`(\x17\xfc\xa9\x8f\xef\x93\r\xbdԮ\xa5v-\xb5k\xa9]K\xedZj\xd7R\xbb\x96ڵԮ\xa5v-\xb5k\xa9]K\xedZj\xd7R\xbb\xaeR\xbb\xf6\xa1\xd5\xc9\xf57<\x1b<\x92\xfb\xd95T\x8ed \xb7\xd4\xddXg\xfcN\xdeǗ\xe1\x01W\f\xb6\xaa?!_\xf6Q\xfa\x106嘽\xb2fX\xd2\r\xad4\xd0\x0e\x01\x06\x7f\xbc\x83\xa2\x1d\u05ca\x0f\x88\x83\xe5\u008d5Y\x84q|\xbe|űI\xa10\x90\x01\xechضU\xe4\xb1\xe0y\x1az\xbc\x8cK\xad\xa6\xc6[\xea\xce\xf6j\x01wt\x9c\xaa-\xbdT\xa89\xc4\x11\xb4ݝ\xa9\xc3C5\xb9\xefh\x7f~\xee]\x05\xfa\x1c\x14;\xd8\xcb\x19\x83\xd8ں'\xdd-nt\xb2p@\xb1 \x02If'\xc4\xc8t\x16v\xb6\xa5\xb42\x1d\xc0\xb4HdF\x86\x9a\x902+\x94ۿ\bI\x17`\xbb]\x91{$՞\xdf\xcezG\xb4\x1c+\xec\xa9#\xa6\x80\x15\xf6N\xdbرy59n(\x8a\x04\xa0\xfb\xb5}2\xca\xfb\xa3\x9b\xcb\xe1\x16\xf4W\x15R\x14#\xd9#C}\xb1\xc6v[\xd3\xfc\x0fкR\x14t߬4\xe1K\xc0X\x10\xf4u4\xf6\f\x14\xb0.7t1\xf7SF\x81\xe33\xc7\xed`\xc8\x1d\x9f\x12\xda捩\xfe\x1c\xe9\x8c\xc4[\xfb\xd0\\\x19\xda\xc3Yi\xa5P\xc7&\x1aO\x80]\x9a\xe5Y\xc3\xe9\xc9D\x81\x1d\xee\xc3\x04L\x97\xd7{\xc9 \x88\xf5[2\bb=\x960A\xe8\xec\x8a\x01v\xdf\x1bZR\x17\x94\x19Q.Qw\xa0|\x10nV\xb8a!\au\xfc\xf9\x8do\x82)\xc1\x15\xdc|\xf1\xf4\x10C\xbf\x19-<\f\xaa\x88C\b'6\xb0\xd6\x1c\xd0\x12Q\x05\xe5\xf4D\xe3*\x97\xa3\x0f\x9a\xa1\x06\xb9z\x9f9\x9a\xc8\xebw.\v\xf4\xc0G\x9e\\\xac\x1e]7M\xda\xcd(`coI\xa5\xf1\x13!\xf7\xa8\x1cM\x9dmh\x1b\xd6\xfb^\xa5\x14m\x11\x1f\xd4\x0f\x0f\xad]*\xdd\x1duc\xaf\xea\xb1_\x9djh\x8a5\ta\xc73r0\akȩD\xe0J>\\Q\x94\xa3\xf9\x9c\x9a\xc4\x00\x05\x92\xdb\xf6\xef\x896\xc5hE\x86\xa8\xa4\xbc,\x93\xe0\x8b\xa4\xc1.\x94:>#\xe4\xe2\x80Lb\x00{\x8a\xcf\x10lo\x8d]lf}\xcc8~\xb2\x9d\x0fN\xe9.\xbcG\v4\xea\x81Lz\r\x81G4\xfe\x96*4c叼Ow\xab@.\xe5Y:\f&l\x05\xce\x010Y\x171Z/\xccB\x96\x04\xb3\xed\x011\xf1<!\xd3>\x06z\"\x8c\xee\xe5A\t\xa0\xcf!8\xccõ\xd1\xfa\xbfE쁤\xcf-\xf4e\xd3\x1f\x06\v\xe1O\x97\xeay\xf6Dk\xc6\xf1\xfe\xf7\xbf\x15;\xde\xf7g\xe1\xbf\v\xba^\xebG\xea\xee;\xaf\x82\xf6s\xad\x1e\f\x15\xa2\x8c\xc0\xc0\xe5^\x9cI\u07fc\xe2j\xd2w\xffÙɑ\xf55\x1d#\xb6\xads\t֜|\x84\xa0G\xbfZ\xfb\xcfF\xf9\xa0\x9b\x7f\x19\xdb<͂uɚ\xc5q;\xe6\x1e)\v3\xf4y\xb7d\xe5\x82F\xbb\xf28\xa0\xc3\x03\x1eng\x1dZ\a{\xadŷ7\x85\xa5\x85:\"\x97\x87\x05\x97:\xc4\xd4\x1f\x83\xa3\x1b\xed\x9fJZP\xa3\x9aG\xdd-\xbe۶\xbc\x19\xb5\xda?ay\xcc\f\x84\xef\xefn\x8bӭ\x04WO\xbak\x8b\x13\xad\x054\xb8\xbd\xef\xd5\x11\xfa\xf0\xfe\xee\xb6(N\xc4w\xbbJ\xe2D\xbds\xc3S\xe3(1?\x9fI\x87ǜ}\x05ʸ\x0e\xbf0+\xfd\xd3\xfdfK\xe9qC\xfd\xe3ܗT\xe2\xa5\xedt\xb0\xd8c\x1a\x8c\xb4\fK\a\x91\x80\x81\xffH\xc2IB\xff\x1e+\x10ldozK\xfa\x8e\xa0\x8b\xc6\x1c\xa5\xe6]\xd4a\xee\x9aa\xfex\xbbR%lݣǤ\x10\x83\x1aݵ\xe4J\xe2c%\xff\xb46^\x88\xd1&\x19\xad\x04\xf2\x7f\x11\xc87\xb6\x9b\xeb\xc5w\u0557\xb4ݖ\xe6j0\x01\r\xe4\xebf\xa5`\xe7\x8aᕝ~\xb9s\t\xca:\x8f\xc4sȝ\xe7\xa4fb$\n,\xfcRe\x8fܖeA\x13?\xa2@O-\x96\x98\xec\xd8g\xaaLi\x0f\x01?\x02\xbc\xbe\x9a\x94ã\xd6\xe9\x15\xb9\x8f\x92όw|\xa7Ã\xd1\xfeq&\x0e\\\xba\x03W\xcf\xdf\u07ba\x8e\xd7!8\xfd0$]8=\xafF\aܗ\xddZ\xfa\xa4\xd0J[\xbb\xee\xd6ʵ\xd7\xd3ۢp&\xbeli_v\xaeɴ \x8e\xe6ZC\xfc\xa9^\xff\"\xe7\xe1\x17o2\xd9\xf6\xfe7\xb2\x05\x7f\f&\xebjx~j\xfc]\xbe\xec\a\xfe\x1f\fԓ\xb8%k\xdc\xf22'\xef\xcb;1\xde\xc6vA\xe9\x0e\xbd\x9d\x94\x99;\xf1\xaf\xd5+\xed\xad{\x17k\xd9K\xebL\xa0d\xbf\x9dzH\xc2\xdb\xc5)\x86\xdf0\xe1\xf8\xb6\xce\x06x\xb6\x8a\x83\vKj\xf5\xc0\x18+\x06\xea\xb8\xd7\x7fз8˳0eX6\xf3\xa6\xa4T*\xc5\xecf\x90fɄfɠ܂\xc2o\xbf\xfd(\x1dI\xb0\x84́\xb6\x8b\x8b\xf5Z\xb7\x1ff\xbb8Z\x18z\xfe5\xa6QJ\xa2\xc6\xc7J0Z\xe4Y\x83\xf3\xc9NIE\xfe\xa4*\xf2\xa7\x92L\x9c\xc7+\"\xe4\x8a\u0096\n\xcaWj\x1e\xddѾ\xbf/\xde)\x01Kh1\xbe\xde\xe0\xb5\x0fԅ\xd27\x11*a\xfd\t\xdf\xe5\xe9\xdb*\x8a]\xebp\xc0qn˨bV\xa4\xc3\x1d\xf5\xb6\xa4\xed\xb4ڍ/\x1eo*(Co\xbd\xaeDz\xa5\xd1B\x01\x830\xae\x86/\xbc*\xa6\x8af\xf0\x81\\\xd9.{\xea\xdaަ\xbd\xed\x91E0xB\xfe\x83\xc1\xe1A@\x93\x02\x19SXw\xe3\xe4U\xa4\xf2\xc6Q\xdd*\n\x84\x0ee\xaf\x82a%5@\xfb\xc2mTͣꯇ\xf0x\xa3}\x13\x9f\xda/n\xd3/K\x98m\xdf\x00*\xbf\x80Jn\xf8v>f\xb0p\xe9\x90G\xfd\xf7\xd2^\xc4N\xbbo\xbbx<\xab\x86\x8a\x93?Ʉ|\x9cc\xa8̇I\x12K\"\xee\xa4\x12q\xfb\xb2\xcdt\xd4ҫIѵ\xe3G\xba\xfe=U;./̐\xfe\xcdk\xe6L\n\t\x05Q$X\b\xdd\xff\x8f\xb3\xfe\xcb\xde\xf5\xac\xb7m#\xf1\xbb\x9eB/\xa0C\xb77ݲq\xd2\xf5\xd7~[o\xec\xdd;LA\x12\xd6$\xc1\x02\xa0c\xe5\xe9\xfbA\x14%\xdb\x11\x80\x01fHZ6\xc2^j\x91\xc0\xcc`\xe67\x7f\xf0o\bC\xf9Pَ\x05$5\xfe\x14Ԩ\x81\xf2\xe2\xc0\xe3X\xc6\xd1\x1ck\xbd݄\xe2璉jL\r.l\x87\xb9\x86\t\xaaa\x1ee5\x9avl\xa5\x91\xf5Ǜ\x0fX]\xd0\xce\xd6f5ޮV\x1b{\x7f\x97j\x82\xc5\a\x13i\xc2t\xae.\xef\xb0\x0e\xec\xb0n\x94\xb4\xdf\xf0\u0558jxѻR\xba\xb5\xbacg\xce\x18q#\xf7\xd2S\x12\x81\x94\x02a\x13T\xdc\x10l`'\x81\x1c\xea\xcd!t\xe6Fk~T\xb9ۀ\xa2OE\x7f\xba\r\xf1\xc8\xf4\x89̻P\x16\xf0p\xa5\x88A\x06\x1b\xb3\x99\x9e*\xc2!\x1c#\xc4\x1eUjT}\x8f>\x02\xbf1t\x18\xba(7\x8b\x92\x9b\x18\xf9\x06\xd2\xc1(\xa4B}\xaa\xed\xa5\xa4А\xa3\x8aɣ\n\xcaM\xaa\xc3\x01\t\xe1\xc6Ձ\xa4H\xb6\x99u@\xfa\xfa\x91\xfe\x10P\xd73\xfb\xb6\x90\x8e\x8a\xc7\xf7\x17\x80\x13\x10\xd3M\x89/gӢV.D\xe4BD.D\xe4BD.D\xbc\xb1B\xc4\xcb\v\x85\xef\xe4\x03\xaf\xa7\xf6\x15\xac]\t^\x17oc\xb8\xf9S#P\xb7\xb1:q5\xfan\xd6a\x10\x15\x8f\xa5$\x92\xc6\xe3'\x12\xf5ж\x84l \x1d&SE\xb7\xe8\xe7\xd7f#1\xfaW+\xefw&ڮ1\xa8\xb2Q\xb2M\x9a\x85\xbb\xd0Ix\xc57B\x9b\t\xf6\xb5\x19^\xb3\x98\xbb\uf27a\x9d\xe0.\x86~\xa5\xc3\xc8ݦ[y\xaf\x13\xd1\x1fv|\x8e\x85\x0e\xea~5&2L\xb4>GTl3~\xaf\x0f|g\xa9\x1d\xbd\xdf\x0fxw\x91\x94\xe5\xe8b\xce\x1b..j\xc3\xc5E] \xb4\a\xac7\x7f\xed\x90.Xɯ\xff\\\xce\xc6\xd3\xc0\x89\x1cȆ\x19\xfe\x9d\x8d\x1f\xe05J\x1a^\xd8D\xf8JVLԣ\x13\x90A\xee\xa2@N\xeb\xf2Km/m_-\x93\xbbN\x1e0#\x15\xdb\xf0\xd4E\xaf(\xb1\x1d\xfa\xbe\x99\"\x0e\xd0;m\xf8\xf8\x87\x97vy¿\xd9\x05\xe5D\a\x14\x8d\xfe\xee\b\x05\xf1_\xee\xc7f6\x92\x05\xa6\xcd\xfa}\u0605\xe6ɹ\x06\"I\xc1:\x06\xe4\x04$\xca\xe2)&\x1b\xb1#N1\xee\x14%p\xb4 SQ\f;a\x88(\x98\xa3\"\x03\\\x82\x8d\x99\x7f\xa3\b\x03'ps\xe9^\xa0\x8bE\xa4\x1e\xd3\x11L\x94\x17\xe5\xf4\xe0\xa2҃\xc9b\xc6\xe7]\xeb\x86\x15\x17c̏\xba\xd9r\xc5?\xccN\xd6c\"U\x8abw}5m\xff\x13*j\xea:\xfcI\xf2\xaa\x13\xc9\xe3\x98E\n\xa5\x8b=h\xce\x06\xa6-6Ɖ\xe3dqZ\xfd\xadg\xe4<D\xbclLyX\x18\xf3im\xb8\xfa*j\xa1\xb7\x10.\xe2\xc2\x7fx\x98\x0f\x93\xe3bnxՔ\xcc\xf0\x19Z\x10\x80\x97^K\x89\x89\xb2U\x1e@\x81\t\a\"\x94\xe8\xf1\xa1\xeb\xfa\x91\x95\xad\xcf\xdf\x04sg\xa8\xd3b\xc6\x0e\xa6\xd1߸-L\x03\xe69!\xd4\x1f\x96\x7f)\x15\u07bb\x00FZH\xb0\x06nl/^\xa2\xd6 F\x13\x84\xcdEGҌĜ\xdc\xc0\xe9m\xc4\xf9\xe3y\x0e\x17\xf3\x8a\x1b%\x8a\x97\x18j\x13c\xc5*n^\x83\xab\xa3um\x98i_\xe9\xa8[wYa\xc4#\xbfS\xe2와~\xf3\xf3)n\xb3e\xfa\x8cv95\xc0%\x91\xe7\xf4\xbd\xfai\xdfEX\"?\xfd\xd1.2\xe5\xab\xe5ܨ\x83z\x1cb\xab\xe5|\xcdJm\xff\xd4)\xd7r\xfe\xf8\xcb=7\xec\x97\xee\xa5b\xcb+֓'\x1b^\x7f\xba\xb9\xfe߯\xb7/\xfe쒴k\x97\xa5C\x1c\x0f\xa2^\x81^\xac\xb8a\xf6\x92\x84eX\f\xf3\xb9nx\x01U\x8bB\xd6\xda(v\xfe\bj'T\xba\xdb\v#\x8e\x83\xc3\xd3#\xd5\xca=Q\x1d\xea\xd9>\xa5\xfc\xce\xd5MoK\xee\xf7\x00\xb4\xd8\xffڦ\xa1k\xee\xbc\xfa\x9f\xfe-^Q\xef|\xed%U\x8e\xd7\x1c\nrzt[a$}/\xdb\xd7\n\x1c-\x11{\xba\xeb\x7f-3\xff\x845\x16\xaa\x97\x9c@\xd4\xd7V0\n\x80\t\x00\xee`\x81\xb28\\\xef\xc5\xc5fk\b\x9b\f\xa9\x1d\xd0\xd7\xdaW:ڼ/\x05\xd5\x0e\xe6r!ֲ\xd7@\xe7\xaf\x0e\x87\n\xa6\xd4\xfb\xb3\x9b\xfc\x83w_\xce\xc0Z\x17\xd25\xb6\xd9(\xbea\xbe\xbbW\x82\xbapLݒ[؇\xa5\xffi=\x87}\a\x9b\xa8\xd8S\xfa\xb7\xa2\xc6|+*\xf1#\xe0\x95|\u0602\xf3i\x8dAv\xef\x9b-\nvoϣs}\xcc\xeaݟ\xce\x1a\xf2\"\xdc\xf6\xe9%_f\xf3\x17Jk\x14_\xb5\x05Bq\xb5\r\xde\xd2GO\xf3r\x7fe\xccr\x96\xee)*f\x8a헧Fu\x87Տ\xe7\x9c@ӵA\x11\x9c\x1e\xdb/\xf3\b#\xa9\xd1P\xd2\x1e!\x99\xe8\xbe}H\x1e玠S\xa3\x8b\xa3\x10\xbd\xaf\x05\x9cS\f\xf5{\xed\xfb\x83\xdds\xff\x81\xf0\xf172\x02\xe5\f`%\xf8\x8a6ܹ\xa7&HFw\xa0\xb6oF!\xdcD\xfa\xc7>\xc5q\x06]\x8b\x0e4g\x91\xa2r\xabC\xdd\xcf=\xdd:\xf1\xcc\x0f+0\f\xf3\xda(\x04\xb7\x02\x88\x15\x1c((J\x81\x1a\n#\x13\x00\x93@=\xf9\x86\x0e\xa2H!\xec\t\xa2N\xd0\x02\xfd\xf4\x051&\x06]\x82\x12\x03\xd8\xc0\xd9\x1f\x8fFpw\xa8\xc4/gq:z\xbe\x14\x03\xe8\xf9|i\x06֩}֢f\xa5\xf8\xe1Ml\x83\xba\b\xd2Đ\x1ez\x99\xf4\xfcx\x88\x82\x1di\x8c\x93\xf6\x90hP\x91\xb9\xb7\x9e=\f\xac\xbb\xea\xd5\x00\xb1\x9e\x1b\x13_\xc1#Y\xa6\xf7L\xf3R\xd4|ڬ\xc1\x93-\x8eD\x80\xa8\xa7%\x00\xa5ۈ\x88%\xe4\xf8\x9c\x8a\x05lݧ\xd0ɦ\x95l@\xa6\xd8~\x93eyϊ3\xc7\xf6{xi\xac\xcb\xe3\x94v\xb7o\xf1\xfcO^Jz\xa9\xb1\x95\xa8\xb9ֿ1\x93<v!\x12\x8fդΛ\x87W\xe9\x04\xc9\x0e\rx?\xec/\xfat\xbe\xe7Ղ\x90.\xe4\x84?'\xfc9\xe1\x7f\x1b\t\x7f\x97\xb3{V\xb8Bl\x02v\xee-\x88+\xd0\xf9\xb4\xa0\x96~\x9e\xf7Mh\xc4\x17\x1aD5\x12\\\x8f\nj\xa9?\xe4\x91Jڭ\xc0\x8a(\xac`\xe9\xf1\x91\x0f\x19\xecB\x0eSlg\x91\x14\xb9ͺQ\xb2\x92\xe7\x93&\xbf\t\x1co\x84p/$\r\xf0\xd9\xd9``ղ\xb7\r\x0fϊ7\xa5(\x18\xe9z\x14\xb7\xebε\xad\\\xdbzߵ-mdӈz\xf3Y\tÕ`\xf16\xf0\xf4Y֚\x17\xad]\x8buX.\xea`\xc3o\xa1!+=\xf4v\xd5*Ϝv@N\x15{r-g\xa3!\xb0\x96\xd7U\xa3\xe4#\xafxm\x86\xec\xca3\xa4\xc6v{q\xa5J\xd64J>\x89\x8a\x19\xfe\xad\xad\x8d\xf0\x05*Ak\x98ϙ\xd6bS\xdbQp\xf6\b\x80\xaa0٧\x90\xc8\xff\x06\x88\xe8g\x10\x1bj\xcd[Jz]-\x02t\n+-\xc1P\xd7[p\t\xd74\x81\xca\x06\xc1\xe0ù\x98\\\t\xab\tލo\xb0\xa1\x86\xa5\x06s\xa8\xdcA\xe9\x01\xb8\xb5P\x8a\x00n(\xac\xce\x11\r\x05S\x05pk\x11\xe9\x02\xb8\xcd@\xca\x00l\a\xa0\xaa\xdd\r\xfc\xe5\x15/\xd9\xee\xb0\xcf\xc1\xddq\xd8;\x84=D\xf7\xfc_\u07bb]A\x9c\xee\xfb]C\x84(\xfc\xae\"\x8e\xa8\xd3:\xf5+\xceVv\xfa!(\xdc3B\x06\x9cp\v\x05G\xfbآ\xb0\\\xaf\xff\x10\x950Qt\xfc\xfa\x8f\xe0\xdb1t\x14\xb2jJnB\xa5ġɨXݲҽ\x82 ~e\xdb韝\xc9*K^\n]M\xc9b\xa8\xfa\x9b\xa2\xd6\xf0\x143:\xaeI'\b\x94\x92&ahJ\xcaJ\xd2\x11\xb4\xae\x9c(_\x04e\x90\xf0&%8\x83\xa6\xc8\xd1)s\xa23H\xe5\x17X\xb1NK\xb9\xd1#\x18\xc5|\xc4\xcb\xfd~\xd0\xe5\x8cִa\xfe=\x81`\x98\xbfO#\x1a\xe1\xff\xcfx\x85\x88\x93\xeec\xbcC\xff\x8f\xad\xd76\f\x8c\xc0\xcexaا\x96+\xfe)\xba\xaf\xf4\xfe\xec\xd3(\xbe\xe6J\xf1\xd5Uk-\xc4\xee}[\xb5\xa5\xa87כZ\x1e\xff\xfc\xe5i_)\n\x05\xefD\xa0\x8b\xe5\xe99g\x98{\x1e\xb0\x14\xa4G\x02Ģ\xa4e))\x96 \x02gڸcp\xa2\xe2c\x94\xc1F\x9e\x9c\xb7X\xafO\x17\xfb\xe0#\"\xa28\x89\xc4\xc5\x0e)\xdd=\xfa쯘\xcc\xc0\x93\x81'\x03O\x06\x9eQ\x80\aM\btWt03\x00ԋ\xe8\xf2\x04*\xd5Z<\x8b]\x93>\al\xdc\x1e`\xe0\xd2\xf5\xa6\x17\xd6P9\b\xce\xf9ش\xac\xaf\x82\xdeq\x95\x8a\x87((ͩHNEr*\x92S\x91\x9c\x8a\xe4T$\xa7\"9\x15ɩ\xc8X\xa9\b\x86\x84\xf4A]\xfc\x1cu\xceFc=\xf1\xc3F\xae\xf2\xec\x05\xd9\xec\xc5I\x986\xe7Hk\x04O\x86}J;S۫bz34\xc4P'2\x84\x9e\x85\x8a=\xc2\u0602\xdc\xddQ\xc6\x18\x03\x10G\x15k\x10j\xc5 |\xe2\xdc\x12\x9d\x9b\xa2\x8f?\bc\x10\x84+\x1bR\xe2ѫ_\x86Y\x173\x8a\xae\x12\x89\x9f\xa4\x99\xe3\xa2f\x94\x88\bP\x81H\xbe\x14\xfahd#K\xb9\xd9\xfd\x8es6\x04\x1ca1h\xf1\x9c\x97\xd9Dj\xf6\xe1\xa7-^\x06\xady\xee\x82`\xee\"\x19p\xb0\xe1(I؟\xe7-\xf2\xbcE\x9e\xb7\xc8\xf3\x16\x17>o\x81\x0f\xd7\xe9Cu\"=$\x105\xba\t|h\x8e\xb4n\x02Ybu\x8d \x14Gr\x81\xc1\x10l\xf8\x8dR\xa1T\xd1'vj\xebܵ\x11}\xa4\xbb\x9c\x8d\x13=\xe4Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\xe7Zw\xaeu\x13պ\x13>b\xad\x91\x95lks\xcbգ(\xf8\xa7\xa2\xb0\xffw'\x1fxD@\x18s\x94\xdcO\x97\xdeF\xd8F\xb4)\xa5\x06DLm\"\xbfH\xb6s\x84e\xa4*W!\xab\x8a\x85\x0e1\xbdd\x06y\xfd8\x12s\xb8\x88\x1br\xa01\xb9P\x8f\x91\xebt=\x7fU2q\xda\b'\xef\x177/\xfc\xcewޣ\x8a\x87'\x85$\xd1B\x8e\a^\x15\x89\t\xb1W\x11\xdaX\x94\x82\x988\x8fD\x1bvP\xa4-IQ\xc0\xcbg\x7f\xf6\xf5\x1b\xd0s\xe8\x91\xde#i\x19\xf0H\xf0Q\xa8\xa1в#?S\xeaZ\x7fr\xf8\xd77\xa2s\xc7\x10\xd3}\xc9Ψ\x03=\x9f\xafģ\xd0R\xbd\tZ\xfa\xd1z'\x16г3\xa5\x01h^(nr`\x91\x03\x8b\xf7\x1eX\xa0\x1aH\xe7>x\xdf\t9\xb5\x88\x144%˙$\r=\xa6Dɰ\x85\x87,\x1cL\x10@\x04\x1e\x1e\xf0Ѐ\xb2*\xeb8\xf8Z8\xef\xe5\x1eT\x82\x9d\xe7\xcb\xfas\xc1\xfa3\x01H\x8a\x8am\xa2G-y\xac\xf6\xbdݴey#KQ\xecF\xeb\xb7\x14k^\xec\x8a2\x9aS\x8cU4R\x9b[\xc3TҒ\x1f\xac9\xf2'\xe85\x04\xf4}#\n\xdc\x04\x8e\x98Dah\x8c\x8bĲ\xbb\xff\xb6\xc64\xbfq3\xe5\x90n\xa56K\xb4\x14\x91\x83a\xe5\xf0/\xceVQ\x93V\x83\xe8\x16\x85H)\x1c'\xa9x\xd1s\x13\x83P\x84\xcf\xd5\x10\x99K\xdc]\x8e#@\x01\x15&\xd9;\xc5p\xd5W\x92\x01nd\x9a\x87\x8c\xba \x14r\x1f(ZQ\xe1\x17\x8b\xfa\xff\xe9b\xcb+>\xf1\xc0`mΞm\xa1\xccl\"\x1b1Es+\x8b\x87\x0f\xee0\xad\xc3\xcc\xe6\xf5Ҽ.Y\xafQ\x9f7\x8a\xdf\x1a\xd9,g\xe3\x1bCNFr2\x92\x93\x91\x9c\x8c\xe4d$'#9\x19\xc9\xc9HNFr2\x92\x93\x91\x8f\x9b\x8c$\x7fZ\x8aG^s\xado\x94\xbc\x8f\xc6$\x8c\x15\xa5f0X\xcbEf.\xc8Ȓ\xc0Vp\x91\x01B\xc7\xe6\xf35\x13e\xab\xf8\xddVq\xbd\x95e\x92\x10\xb1\xbb\xeeq \x81ȳ\xb0\x8a\x87q\x17h\xb5!ȫ\x90\x9a\x8f\x15\x1fU.\xf5J\x94\x7f\xb3w=\xbdm\xfb<\xf8\x9eO\x91/\xd0\xdb\x0f;\xe46\xf4\xcfP`[\x83\x05\xdb]\xb5ټ\xc2\x14K\x90d'\xf9\xf6/\xe4$\xde\n\xec\"\x92\x95\xea\x94\xd8m\x80K\x89|H\xe9!C\xf1\xf2\xdf\x158\x14\xcbJ\xa8\xe7\x1d\x997Q9\x13) qDD\x1aO\"\x1b\x91r\x81#]\xdeX.n\x1c\x976\x1a\x1f\"n\x82\xe2?\xe8\xbb\"\t\xf3\xe9\x8dB\xad\xcc\x1d\x18u\xdc@c\xbb6\xcc\xef\x1cv\xe0\xb5mg\xbb\xfc\xd07\r\x840\xe3\x8b\x10\x89A\xcf\xfa*\xf4\xd1\x03\xee\xfc\"^\xd4;\xb0}\x9ci\xb4@o\x1ds\xd7E#,\xd95[\xb5HJ@\v\x1fS\x03\xdc\x1a\xed\xc8T8\xd0\x00q\x89\x80\x8fk\xdc\xea\x89Q$\x89\x9e\xb3\xea\xf0\x14\x90\xa88\xe7m\xb4\x8d5\x15\x84\xe3c\xf6\xcdk\x7fY\x14\f\xdcXZ\xe6A\xb5Zr\x92\x92\x93\x94\x9c\xa4\xe4$%')9I\xc9IJNRr\x92\x92\x93\x94\x9c\xa4\xe4$%')9I\xc9IV\xcbI^\x1e$\xca\xde1\xc5=\x8d\xde\xe9\xfc\xe4$\xef+\xd0D7!a-y\a\x84\x8f\xab\x01\xf4\xa7\x01\x9a\xde\xebx\xbc\xb5]\x84C,\x89Ye\x8cݯ\xbd\x1e\xb4\x81-܇F\x19\x85\x1bHA}C\xa3QN=k\xa3\xb1\x96\xa7h\xe1\x8c\xc0J\xf4\x9e\x88X\x0e\x1a\xd7z\xeb>\xea\xee\t\x1e\x9fPwv\x9d\xb6\xbc\xc78o\x9bo\xe9\xc5\xf0բ\xb0\xdaS\xea\xfb\xa93\xc7\x1f\xd6\xc6\am \x1cC\x84]y\r\xf8\xbe\xfb\x1c\xbex\xdb;\xe2\xcd\xe6\xd3\x7f\x85o6\xe7\xb5\x7f\xb7]\xd2a%\xcd\xfd\f\xe0秸\x00_u\xd7\x1f\x9e\xc67ê\x1c\x15\x06\x06@\xbf5E\x8ew\xde\xe6\xbff\xc4&|\xfc\x03\xb5\x84\xf7H\xbc2\b'\x1d\x12{ݵv\x1f*\"v\xbb\v\xea\xd6C\v]\xd4\xcal\x1c4\x95\xf4\xf8\xaf\xa5P^\b&/g\n\x84\x15WA\x00\x17\xfaӐ\xde'\xeb\x9dԮ\xa5v-\xb5k\xa9]K\xedZj\xd7R\xbb\x96ڵԮ\xa5v-\xb5k\xa9]K\xedZj\xd7R\xbb\xaeR\xbb\x0e\xb1\xd5\xd9\xf57|6x\x14\xf7\xd45PNd\x04\xbf\xd3\xddXg\xfc\x06!\xa4\x97\xe1\x11W1\xb4W\xfdC|\xd9G\xe9c<\x96S\xf6`M\xbf\x83;H\x13f\xb3\x9d\bI\xfeh\aE;\xae\x15? \x0em\x17*\xd7$\t\xc6\xc7盿4\xb6(D\x03\t\x81\x1dK\xdbN@\x1e\v\x9e\xf3\xc0\xf18۹\x1a\x8cOҽuj\x8b\xfeE\xc7\\}\xe9O\x85\x9a\"\x1c\x13m\xcfgj\xff\\\xcd\xeeg\xd9\xf7\a\xe7+ȧD\xb1\xc9_\xae8\x88\xed\xad\xff\xad\xbb\xed\x9d\xce6\x0e\xd2,\x18\x83d\xab\x13\xa5\xc8|\x15\xb6]\xb8\x1dǥ\xad\x16o\x1b\xc1\xd3\xf6\x03\xf8!;m\x8e:g\xd0\x0e\x87\x03\xa1Ŕ\xa1Q\x1b\xa3\x1c\x9fؓ\x03\xadLRv\x9f \x15\x1d\x84p\xd6\x0f\xa0|\xf3?(a\xfe\xa2\xb8F\xa8\xb1\xedB\xee42Ė\xa0S\xcf\x066\xe0\x13\xa9\xf9\xaa\xbb\xdf\x19z\xc4\xdcC\xc0\xa5g\xaa\xbc2\xb7\x97\x86\xfa\f\x81\xd9vƺ\xb8\xf2\xdb\xcc/\x90 Dٌ\x02D\u008f\r\xe6\xb3A\xe8\x86B\x9b\xa3\x9c!\x15\xf9\a\xa1N\xcc!\x193\xfe\x96C߯&\xda\xca,n\x99\xc5}\xed\xb3\xb8\x97\xcb\x17\r\xa6}\a8WN\xff\x02\x1fЙ\x1ef\x94\x8dj\xc1'AXWÁ\xb2i?5\xb1vik|x'\x98\x9b\xdel\xa2\xfcx\x96\xd1\xd0\xcbe\xab\a\x1d,2\xf3ż\x96\x8b\xb5\xae\xc4\x03.۩\xe9\x00\xa7Q\xe7r\xb1\x90\x8bŵ_,H\x7f\x00\xbf\xfb\xf9dҡ\x1b0,\xa7\n\r\x9d(\x11:l\xd1C\x16-L0\x84\bzx\xa0\x87\x06\x92W\xa5\x83\x03^\xf4a\xb5\xa8\xa0\xc1\xd3\xc9'\xf8\x991~*\x04I\xbdS\xdbl\xab\xa1m5J[\xf7\xc6\xe4\xa6Չr\x8d~\x81\xe6\xd8\xe4\xb7\xc5R\xbc\xc2\xd9\x107\xa9\x89n\xb5(\xef\x8e\xd8.:\x0e\xd9\f\xddt\x84\x83\x98\x050<\xce\xc5\xe2\xd9\xe4\xfe4.\x93R~\x9c\xcdf\f\x86~5&lq\xa8\x94\xe3\xe0dU/\xb96\xf1&+\xa2s5\x02s\xe1\xecic\t\x05\\1\x89\xd6\xdf\xc6f`J\xdb\x05\xb9\xf5\x82\xad\xfd\x82\xa7\x05\x83\xa3\xef\x8d\xc90T\x9fCw\x84\xb0\xf8\b\xa9\x8b\xe9\xaa\x0eLq\xaf\xd7\xee5g\\\x93>w\x1e6\x11\xf7\x88\x9b\x90\x11!#BF\x84\x8c\b\x19\x112\"dDȈ\x90\x11!#BF\x84\x8c`\xc9\b\xfaS\xa3\a\x90\x81z2PO\x06\xea\xc9@=\x19\xa8'\x03\xf5d\xa0\x9e\fԓ\x81z2PO\x06\xea\xc9@=\x19\xa8'\x03\xf5d\xa0^\xb5\x81z\x98\xbb.\x1aaɮ٪ER\x02Z\xf8\x98\x1a\xe0\xd6hG\xa6\u0081\x06\x88K\x04|\\\xe3VO\x8c\"I\xf4\x9cU\x87\xa7\x80D\xc59o\xa3m\xac\xa9 \x1c\x1f\xb3o^\xfbˢ`\xe0\xc6Ҳ\U0010e716\x9c\xa4\xe4$%')9I\xc9IJNRr\x92\x92\x93\x94\x9c\xa4\xe4$%')9I\xc9IJNRr\x92\xd5r\x92\x97\a\x89\xb2wLqO\xa3w:?9\x99\xfe\xa9\xb6է\x17\f\xd6\xc4\xf0@t\x13\x12֒w@\xf8\xb8\x1a@\x7f\x1a\xa0齎\xc7\xf4,/\x1cbI\xcc*c\xec~\xed\xf5\xa0\rl\xe1>4ʠGB\xd0\xde\xd0h\x94S\xcf\xdah\xac\xe5)Z8#\xb0\x12\xbd'\"\x96\x83Ƶ\u07ba\x8f\xba{\x82\xc7'ԝ]\xa7-\xef1\xce\xdbf\x9cy\xb3Z\x14V\xfbe\x84\xca\x0fk\xe3\x836\x10\x8e!®\xbc\x06\xc6\t\xed_\xbc\xed\x1d\xf1f\xf3\xe9\xbf\xc27\x9b\xf3ڿ\xdb.鰒\xe6~\x06\xf0\xf3S\\\x80\xaf\xba\xeb\x0fO\x98Y\x15<G\x85\x81\x01\xd0oM\x91㝷\xf9\xaf\x19\xb1\t\x1f\xff@-\xe1=\x12\xaf\f\xc2I\x87\xc4^w\xad݇\x8a\x88\xdd\ue0ba\xf5\xd0B\x17\xb52\x1b\aM%=\xfek)\x94\x17\x82\xc9˙\x02a\xc5U\x10\xc0\x85\xfe4\xa4\xf7\xc9z'\xb5k\xa9]K\xedZj\xd7R\xbb\x96ڵԮ\xa5v-\xb5k\xa9]K\xedZj\xd7R\xbb\x96ڵԮ\xabԮCluv\xfd\r\x9f\r\x1e\xc5=u\r\x94\x13\x19\x95\xdfB\x9c\x86\x90b2/h\x97\x8a\xe0w\xba\x1bk\x9c\xdf \x84\xf4*=\xe2\x1a\xc8)\xbe\xec\x83\xf81\x1e\xcb\x19z\xb0\xa6\xdf\xc1\x1d\xa4\xf9\xb6\xd9\x0e\x8c$\x9e\xb4C\xaa\x1d\u05ca\x1fN\x87\xb6\v\x95\xe7\x92\x04\xe3φ\x9b\xbf4\xb6(DA\t\x87\n\x962\x9e\x80<\x16[\xe7\x81\xe3]Zj5\x18\x9f\xa4{\xeb\xd4\x16\xfdk\x92\xb9\xfaҟ\xea8E8&ڞ\xcf\xf3\xfe\xb9\x9a\xddϲ\xef\x0f\xceW\x90O\x89b\x93\xbf\\q\x10\xdb[\xff[w\xdb;\x9dm\x1c\xa4Y0\x06\xc9V'J\x91\xf9*Li\xfc\xcfF\xab\x90\x13\x8f\xb3C?6\xe8\xa7\xd5%\xbd\x95:\x9c\b~\x8aE\xafv\x85P[\x10P\x8f\xeb\xdb\xd5\xe2-\x0f\x86\x84\x8b\xef\x10\x93翽\xa0\xf5\xe3\xdd\xdb\v\xc9;\xd5\x11\x10\x98&\x95mƱz\xef\xd1\xdb17\x9b\xf7\xed\f)\xb9=\xe5\x03ޣʕ\xdff~\x81X\x1c\xc9R8œ~\x1c1\x9f\rB7\x14\xda\x1c\x16`\x959\v\xa1\xae\xcd!\x193\xae\x97C߯&\xf0\xca\xecp\x99\x1d~\xed\xb3×\xcb\x17\r\xa6}\a8WN\xff\x02\x1f\xd0\xd9!f\x94\x8dj\xc1'NXWÁ\xb2i?5\xb1vi\xc3|x'\x98k(%\xa770\xf4r\xd9\xeaA\a\x8b̖1\xaf\xe5b\xad+\xf1\x80\xcbvj:\xc0i4\xbb\\,\xe4bq\xed\x17\v\xd2\x1f\xc0\xef~>\xd9w\xe8\x06\f˩BC'J\x84\x0e[\xf4\x90E\v\x13\f!\x82\x1e\x1e衁\xe4U\xe9\xe0\x80\x17}X-*h\xf0t\xf2\t~f\x8c\x9f\nArL\xbe\xaf\x16\x85l5\xa5\xfa\v\xff\x06\xcb\xe8\x17h\x8eM~\x1b/\xc5+\x9c\rq\x93\x9a\xfeV\x8b\xf2\xee\x88\xed\xfa\xe3\x90\xcd\xd0\xfdG8\x88Y\x00\xc3\xe3\\,\x9eM\xee\xa7\xe32)\xe5\xc7\xe4l\xc6`\xe8\xafc\xc2\x16\x87J9\x0eNV\xf5\x92k\x13o\xb2\":W#0\x17\xce\x1e<\x96P\xc0\x15\x93h\xfdxl\x06\xa6\xb4\x89\x90[E\xd8\xdaExZF8\xfa\xf4\x98\fC\xf59t\a\v\x8b\x8f\x90\xba\xae\xae\xea\xc0\x14\xf7z\xed^s\xc65\xe9s\xe7a\x13q\x8f\xce\t\x19\x112\"dDȈ\x90\x11!#BF\x84\x8c|l2\xf2\x7f\xf6\xaef\xb9\x8d\xdc\b\xdf\xf9\x14\xfb\x02\xbc$\xa9\x1cts,\xafK\x15\xff\xb0\xac\xd5\xee\x19\x9ai\x92(\x0e\ac\x00C\x8a\xfb\xf4)\f9\x94\\qj\x83\xaf\xc1\x86FD\xd17ר\x81\xfe\xf9\xd0\x7f@?\xffO\tFJ0R\x82\x91\xf8`\x04\xfe\xb4\xd1;*\x03\x00\xcb\x00\xc02\x00\xb0\f\x00,\x03\x00\xcb\x00\xc02\x00\xb0\f\x00,\x03\x00\xcb\x00\xc02\x00\xb0\f\x00,\x03\x00\xcb\x00\xc02\x000\xdb\x00@\xc4ׅ5,\xc85\x9a\xb5`H\xc0\x83\x8f\xf3\x05\xb8\x05l\xc8\\u\xe0)Ĉ\x80w\vl\xf5L\x14\t\xa4\xa7\xcc:<\x04d2\xae\xb3ƛ\xca4\x19\x88\xe3\x98=\xff\xd1^f\x82\xc0\x8d\x86e\xe1\xed9]r\x92%'Yr\x92%'Yr\x92%'Yr\x92%'Yr\x92%'Yr\x92%'Yr\x92%'Yr\x92\xd9r\x92\xe3\x83D\xd1;\xe6\x98g\xa3\xb7:>9\x19~\xaa\xae\xf5\xf1\x05\x83\x05\x13\x1e\x98f\xc2ҵ`\x1d䮗\x03𧎪\xdej\x7f\b\xcf\xf2ғ\x97\xd4Y\xd54f\xbf\xb0z\xa7\x1bZ\xd1\aW\xa9\x06\x1e#\xc1{C\xa3R\x9dzԍF%\xcf\xe1\xc2I\x033\x85\xf7L\x8dM\x11\xc6\xd5\xd6t\u05fa{\x86\xc5\a\xad;\x99N-o1\x9d5\xd50'\xe7f&\xcc\xf6q\xec\xca7c\xfc\xaf\xba!wp\x9e\xb6\xf2\x1c\x18&\xca\x7f\xb4\xa6\uf61e\xcd?\xff!\xecٜ\xd6\xfeŴ\x81\x87\x998\xf7\xe0\xc8N\x8fq\x8e>\xe9\xb6\x7f\xfa:\xbc\x19\x96\xe5\xa8hhG\xf0[Sl\xbc\xb3&\xfe5\xa3dć?\x90\x8bx\x0f\xeak\x02\xe2\xacCb\xaf\xdb\xda\xec]F\x8d]m\x9dzo\xa9\xa6\xd6k\xd5\xdcwTe\xe2\xe3ϖ\xc2y!\x98\xbd\x9c3\x10f\\\x05C\xb9\xe0O]x\x9f\xac\xefJ\xed\xbaԮK\xed\xbaԮK\xed\xbaԮK\xed\xbaԮK\xed\xbaԮK\xed\xbaԮK\xed\xbaԮK\xed:K\xed\xda\xf9ZG\xd7\xdf\xf0l\xf0@\xeek[\x91\x1cIOv\xabۡ\xce\xf8\x99\x9c\v/\xc3\x03\xae\x18lU?!/\xfb(\xbd\xf7\a9f\xefL\xd3o\xe9\x96v\x1ah\x87\x00\x83?\xdeAQ\x0fk\xc5\a\xc4\xc1r\xe1ƚ,\xc28>\xcf_pl&\x14\x062\x80\x1d\rێ\x8a<\x14<\xa7\xa1\xc7۰\xd4lj|\xa4nM\xa7VpG\xc7Tm\xe9\xb9B\xcd!\x8e\xa0\xed\xe9L\xed\x1f\xb3\xc9\xfdD\xfb\xc3Sg3\xd0\xe7\xa0\xd8\xd9^\xde0\x88\xed\x8d\xdd\xe8vu\xab\xa3\x85\x03\x8a\x05\x11H4;!FƳ\xb055ŕ\xe9\x00\xa6\x05\"\xf7\xd4P\xe5cf\x85r\xfb\x17!\xe9\x02l7;\xb2kR\xf5\xdb\xdbYg\x89\xb6C\x85=v\xc4\x14\xb0\xc2\xcej\x13:6of\x97\rE\x91\x00t\\\xdb\xfbF9wqs9߂\xfe\xa8|\x8cbD{d\xa8/V\x99\xf6h\x9a\xbf\x01\xad+\xa2\xa0\xfb\xc3J#\xbe\x04\x8c\x05A_KCπ\x80uپ\r\xb9\x1f\x19\x05\x0e\xcf\x1c\xd7}C\xf6\xf2\x94\xd06oL\xf5\x97Hg$\xdeڇ\xe6\xca\xd0\x1e\xceL+\x85:6\xd1x\x02\xecҔg\r\xa7'\x13\x05v\xb8\x0f\x130]^\xef%\x83 \xd6o\xc9 \x88\xf5X\xc2\x04\xa1\xb3+\x04\xd8]\xd7ЖZ\xaf\x9a\x01\xe5\"u\a\xca\a\xe1f\x85\x1b\x16rP\x87\x9f;\xb8\xca7\x12\\\xc1\xcd\x17O\x0f1\xf4\x9b\xd1\xc2à\x8a8\x84pb\x03k\xcd\x01-\x11UPNO4\xaer)\xfa\xa0\x19j\x90\xaa\xf7\x99\xa3\x89\xbc~gY\xa0\a>rdC\xf5\xe8]U\xc5\u074c\x026\xf6#\xa98~\"\xe4\xd6\xca\xd2\u009a\x8a\x8ea\xbd\xebTL\xd1\x16\xf1A]\xffX\x9b\xad\xd2\xedE7\xf6\xa2\x1e\xfbѪ\x8a\x16X\x93\x10v<#\a\xb37\rY\x15\t\\ч+\x8ar\xb4\\R\x15\x19\xa0@r;\xfe\xdb\xd0A\x8cV`\x88\x8a\xca\xcb2\t>K\x1a\xecB\xc9\xe33B.\x0e\xc8$\x06\xb0\xc7\xf8\f\xdet\xa61\xab\xc3}\x172\x8e\xefM\xeb\xbcU\xba\xf5\xaf\xd1\x02\x1b\xf5HM|\r\x81G4\xfc\xb6\xcaWC叜\x8bw\xab@.\xa5Y:\f&l\x05N\x010I\x171X/\xccB\x96\x04\x93\xed\x011\xf14!\xd3\x18\x03m\b\xa3;?+\x01\xf49\x04\x87i\xb86X\xff\xa7\x80=\x90\xf4\xb9\x85\xbed\xfa\xc3`!\xfc\xe9V=\xddoh\xcf8\xde\xff\xfe7\xb1\xe3}<\v\xff-\xe8z\xed\xd7\xd4>\xb4Ny\xed\x96Z=6$D\x19\x81\x81\xf9(Ψo^p5\xea\xbb\xff\xe2\xcc\xec\xc2\xfa\x1a\x8f\x11\xc7ֹ\bk\x8e>BУ_\xed݇F9\xaf\xab\x7f5\xa6\xda\xdc{c\xa35\x8b\xe3v,\x1dR\x16f\xe8\xf3i\xc9\xcaz\x8dv\xe5q@\x87\a<\xdc\xce:\xb4\x0e\xf6R\x8b\xefn\x85\xa5\x85:\"\xf3\xf3\x82\xa5\x0e1\xf5go\xe9V\xbb\x8d\xa4\x05U\xaaZ\xebv\xf5\xd9\xd4\xf2fTk\xb7\xc1\xf2\x98\t\b?|\xbb\x13\xa7\x9b\t\xae6\xba\xadŉ\xe6\x02\x1a\xdc\xdeGu\x84>|\xf8v'\x8a\x13\xe1\xdd.I\x9c\xc8wn8\xaa,E\xe6\xe7\x13\xe9\xf0\x90\xb3\xcf@\x19\xd7\xe1gf\xc5\x7f:nVJ\x8f+\xea\xd6K'\xa9\xc4[\xd3jo\xb0\xc74\x18i\x19\x96\x0e\"\x01\x03\xff\x91\x84IB\xff\x88\x15\b6\xb27}$\xfd\x8d\xa0\x8b\xc6\x1c\xa5\xe6]\xd4a\xee\x9aa\xfex\xbbR&l\x1d\xd1c&ĠJ\xb75YI|\xcc\xe4\x9f\xe6Ƌb\xb4QF[\x02\xf9\xbf\b\xe4+\xd3.\xf5\xea\xb3\xea$m\xb7\xa6\xa5\xea\x1b\x8f\x06\xf2y\xb3R\xb0s\xc5\xf0ʦ_\xee܂\xb2N#\xf1\x14r\xe79\xa9\x89\x18\x89\x02\v\xbfT\xd9!\xb7eY\xd0ď(\xd0S\x8b%&3\xf4\x99\xaaF\xdaC\xc0\x8f\x00\xa7ofrxT[\xbd#{-\xf9\xccp\xc7w\xd1?6ڭ\xef\x8b\x03\x17\xef\xc0\xe5\U000f73ee\xe3;\xef\xad~\xec\xa3.\x9c\xbe\xadF\aܗ=Z\xfaLh\xa5\xb5ٷ{e\xebw\x8b;Q8+\xbe\xac\xb4/\xbb\xd4\xd4\xd4 \x8e\xa6ZC\xf8\xa9N\xffN\xd6\xc1/\xde$\xb2\xed\xf17\xb0\x05\x7f\f&\xe9jx~j\xf8͟\xf7\x03\xff\r\x06ꕸ%i\xdc\xf2<'\xef\xd7Wb\xbc\x95i\xbd\xd2-z;)1w¿Z\xef\xb43\xf6U\xace\x94\xd6\x1b\x81\x92q;\xf9\x90\x84\xb7\x8b)\x86\xdf0\xe1\xf0\xb6\xce\x01x\xb6\x8a\x83\v[\xaau\xcf\x18+\x06\xea\xb8\xd3\x7fҧ0\xcbS\x982,\x9be%)\x95L1{ӗfɈfI\xaf\xec\x8a\xfc\x1f\x7f|\x91\x8e$XB\xe6@\xdb/\xbf\xec\xf7\xba\xbe\x9a\xed\xe2h\xd1\xd0\xd3\xefC\x1aE\x125\xae+\xc1h\x90g\r\xdeNv\xaaT\xe4'U\x91\x9fJ2q\x19\xae\x88\x90\x15\x85-\xe5\x95\xcb\xd4<z\xa2\xfd\xf0 \xde)\x01Kh5\xbc\xde\xe0\xb4\xf3\xd4z\xe9\x9b\b\x99\xb0~\xc2wy\xba:\x8bb\xe7:\x1cp\x9c;2J̊\xb4\xffF\x9d\x91\xb4\x9dZ\xdb\xe1\xc5\xe3C\x06e\xe8\x8cәH\xef4Z(`\x10\xc6\xd5\xf0\x99Wb\xaa\xd8\xf4Γ\x95\xed\xb2\xa7\xb6\xeeL\xdc\xdb\x1eI\x04\x83'\xe4\xaf\f\x0e\xcf\x02\x9a\tdLa\xdd\r\x93W\x91\xca\x1bGu\xb3(\x10:\x94=\v\x86Ij\x80v\xc2mT\xd5Zu\xefz\xbf\xbeծ\nO\xed\x8b\xdb\xf4\xf3\x12\xee\x8fo\x00\xc9/ \x93\x1b~\x9c\x8f\xe9\r\\:\xe4Q\xff.\xedE\x9c\xb4\xfb\xae\rǳ\xaaH\x9c\xfc$\x13\xf2a\x8e\xa1j\xae&I\\\x12q\x93Jče\x9bŠ\xa573ѵ\xe3G\xba\xfe\x1e\xab\x1d\xf3_\x9a>\xfe\x9b\x97̙\t\t\x05Q$X\b\xedҽyg5' Y\xf9\x12\x94\xa8\xa3<?\xedQ\xca8\xbas\xae\xf7XP|\xdf(\xbd\x95\xd4\xe0*\x10,9\xcc\xff+\x87y敘v\xac\x8d7\xed\xf5\xd5\x03\xea\t\xddl\xedj\xb9[\xad\xc1\xf7\xde\x1b\x9b\xa1\xf9 \x93&\xe4;\xea\xca\r뿸a\xddY\x13\xbe\xa1ZR\r'}+\xe5ث+\x1d9s\xd8ͼK\x9fr\x11L.$\xfc\x13\xa9v\x93\xe0\x02{\x12\xc8I}9$\x9d\xb9\xa55\xbfT\xb1\xdb\x05Y\x8f\xa2\x7f\xba\v\xf1\xcc\xf0)\xd9\xe9\x922\x81\xc7KE\\D\u061c\xcb\xf4\xa9<\x9c\x842b\xdcQM\x8d\xaao\xf1\x8c\xe0_\f\xbd̺R^\x16Mnb\xc9/\x90^l\x85\xa9P?\xd5\xf5Ҥ\xd0P\xbc\x8a\xec^E\xcaK\xaa\x97\x03\x92\x84\x17W/\xc4\xc5d\x97Y/\xb8\xbeQ\xd2W\x01u\xe3f_\x17ҥ\xda\xe3\xdbs\xc0\x13,\xe6X\x12\xbf\x99\xe5E\xad\x92\x88(\x89\x88\x92\x88(\x89\x88\x92\x88xe\x89\x88\x1f\a\n\xfff6\xd4\xe6>+T_kj\xab\xd7!nz\xea4k\x1a\xeb\xff\xc4\xd5\xe8٬\x97AT>\x96&\xe14\x1f?\x99\xa8Ƕ%\xe6\x1f\xc0a\x12e\xdd|\xac\xaf̈́6\xfa\xbd7\x8f\a\x1fm\xd7\x1cTYY\xd3CU\xb8\x89\x16\xe1-\xad\xb4\xf3\x19\xee\xb5yjU\xcc\xec\xfbDd3\xccb\x18;\x1d\x84\xc9\xe2V>\xeaD\xf4\x87\xc7}J\xa1\x83}\xac%\x91!S\x7f\x8eު\x95<\xd5\r\x1d\xc2j\xc5\xe9^\xe1\xec\"c\x1aq6\x97\v\x17\x93\xbap1\xa9\x01B\x03`\xbd\xfa\xb1C\xaeR\r\xdd}\xbd\x99\xc9i`\xa6\x03d\xa5<핼\x83\xd7Y\xe3\xa9\n\x81\xf0\xad\xd9*݊/\xa0\x80ܤ@ι\xe6C\x1b\x86\xb6\xd770iX`\xdeX\xb5\"\xb4\xe9\x95Ŷ\x13\xedE\x0e?\xc0\x1d\x9c'\xf9\xc7K\x8fq\xc2\x175\xa1\x98脢\xd1ߝ\xa1 \xfe\xcbA63!\vĪ~W\xdbh\x0e\xc7\x1a\x8c \x85{00\v\x90,\x8bOQl\xe4J<\x85\xdcS\xa4\xc0ٌDQ\x8c[0d$\xccY\x9e\x01/\xc0\xe6\xd4\xdfR\xb8\x81\x19\x8e9\xfc\x148\xfa\"\xc6I\x1e\x04\x99\xe2\xa2\x12\x1eL*<\xc8\xe63\xbe$\xed:UMƘw\xae[\x93\xa5\xab\xb9\xc9z\x0e\xa4\x1a]\x1d\xeen\xf3\xd2Ϩ\xa8h\x1f~\x96\xb8\xeay\xc92f\x81\xact>\x80\xe6\xec\xc2k\x8b\xf5q~\xbe\x93\xff\xb0w=͍\xe3\xb8\xfe\xaeO\xa1\x9a\xbb3\xaf\u07fb\xbcr\xd5\x1c2IwWv2\xdd)'\xe9>l큖`\x8beYT\x93\x94\x13\xf7\xd6~\xf7-\xea\x8f\xedd,\x12\x90\xa8\xd8θ\xfaұe\x10\x04\xf0\x03@\n\x04\xdb\xfe7\xdaV\x7f\xab\xc0\xfb\x1c\b\x0fk\x9dօ1\x973\r\xf2\x13ϸJ0\xb3\xa0\xa5\xff\xf84\x1f'\xc7Q\xa8a\x99\xa7LC\xd0[\x10\x88\x87R1\x9f\x80\xe9Ga=\xf5\x83s\xd2\xc8\xc3\xc5\u061cfcG\xe3\xc0\x837ь\xa7\xb7<s\x85\x19|\x19\x14N\xf1\b\x15\x98\xfc\xd00\xa6>3\xeb\x1d\x9e\xce\x15=6\x94\xe2\xcfy\xa1=\xb5\xc1\x197\xcb\x1f\x13\x90\x1dã\xf7&У\xe3}ڌ\xf1\xb4\x90\xf0\x90HP\x89H\x9dހ\xe2\v\xf0\x9e\xa0\xeaΘ^C\xca\xd6\xc8\xe2\xbda\x18Y\xf0,\xf6f\x03\x98\x84\x1fM,\a\xc9E|P\xe1(H\xcb.\xe0\xaeѱ\xb03\xff\x96LG\xc9\xc7\xe7\\V\xfdH\x11\xbf@\xa3\x85\xcaJ\x87\x9d:\xb4\xf6\xb6\xff\f?\f!\xc4^\x83\xacXZ\xe0\xa7L\x92hg\x9e\xf0>\x89\x96\x1f\xd0w\xd7F\x1b%\xa0\x1eGD\xac\xae\xb3,\xad\xff\x96M\x01\xd7s\xb4\xfb\xe5?D}\x11\xa6\x8c|\x14\xf5\x98[t\x12T\x91\xda:\xbf\xe3\x00\x8f(\xedBJ̽!\x8d\"\x84\x10\x8f\x04-\xd7\xd5\x12\xb7\xef\xf4S\xf7\xf5\x85\xf8\b\x82\x8d\x1f\x12\x98r:x\x94#B\xaa\x06cO(ɻ\xe3\x1eN\xec\xb4X\x87\x12\x05n`RdCK\x97\x1a\xcdH\x84\xf1\x11\f%\xa7\x0e\x1c\xe0̇\x16\xa901\n\x1d\x9d\x10\x96K\x99\a:\x16u\x89Bhɣ&\x85xH\x81.\xf2몣\xdaUuK\xc9D\xa4\x16\x9f\x8f\xe0p\x97\xe6\xa4Hm\xb3v\x1a%\x16\xb8,\xe7\x9f\xcd\xc1\b\xc7ch\x18\xa0\x15\x815\x9b0\xccD6\xa9\x0f\xab?Nn\x8f\x98\xd1\xe6H\xbd\xd9!>\x016\x8f\x98\xc5\x15\xc8\xe9Ѳ\x87qƣj\n\x81\x17Gdc\xa8\xf4\x19\xb7|\x06\xd1:\xf2\xe1~\xee_\x1c\x02\xb5\xbf\xeb\xc0\xd2|`jaQ\x94?_&\xe7Gk4fwu\xb9dY|\xbc\fƐ\x82\x86ˣ\x16b\x02\xe9\xf2*a\xd2Yφ\x1e{C\xd1\xf7\x0e\xad!\xfc\r\x95e\"ŉ\x85\xc1f\x81\x15\xc1\xbdƟ\xf5\xa1V>\xe0\xcb\r\xd0\"\xdbI\xcdq\x94Y\xb6\xfe\x8a\xac\xb5\x18Q\xd9\xd8\xfe\x04\xb3w\xb9\xc3\xfa')\x90\x85\xb74\x8dv\xebfG\x1f\x83\xf6Ƣ\x97\xaa{v\x99\xeb4\x1av-կ/\x1c*\xbc\xbf\xfc\x973ɖ\xa0A\xbe\x81~cH5\xa3\xfc\x80\xf2\xa2\xae\xeb\x0eNw\x0f\xd3\xcb ʋ$\"\xc8\xf4\xb1ˣ<\xd9t\xa4\b!\xd5Qt\x00GUY\xf7\a\xac\xdf\x00\x1c\xa4W4\x9d\xa5\xfc\x86\xf6ݭP\x96\x9a\x10t7\x1e싕N\xb6C\xfa\x01\x9e{\xa4\xc9\x13F\xa7\xe4\xc0Ub\x89\xc94\x06I.\x89]\xed\xe90\xa4A\x83\f\v\x92M4\xce\xe7\xef0\xd7\x01\xcc\x15\xd5\xe6\x00=\xabE\xa1\xb4X\xf2\x9f\xfe(.Y\xc6g\xa0\xb4:#ix$\x15\x12\x19\x84H\x8c\f`\xb5\x18\xb1\xa1yT\v\x9e_I`\xee\x86G\xf8\x98kh^\x97;6\xfehV%\xbb\x7f\x8a\";\x10\x1a\x96fh\xfc:\x90d$\ru)r6g\xf6\xf2\xcb\x1e\x83\xe0\xf1F$L;ՂWzmN\xc5t0\xb9״M\x15\xd6\x00\xf4))\xdbƾ\x8e6\xbd\xc3\xcc\xc6\xc9\x1b\x8a+7?廅ꐉ\x05\xbdNG\x80u\x01\xecI}L\x99\xd2<\xfa=\x15\xd1\xe2^\v\xe9D\x12Ž\xe0\x0f\xaf\x90\xec/gRs\xac;\xc1\x97\xbdt\xd9*\xa0\xb8\b\x9a\x83\xa0\xdc\xe8F\x90\x1e\x16\xbb\xe8\x1b\xda\xd0xd?\v\t\x98+!)\x16\x16\xb1(\xe1\xd9\x1c{\xf0\x9ddf1W\v\xec\xd1#2\xe1\xc7ɍw\xba\x03\xc1\rSAM&:\x14p\xf0\xf6ݨ\x17\xf5\xe0\xe3\xe4\xc6+\x0e>\xf1\x14|\xe2`8?D;xM\xb2\x01\x950\t\x03P\xc6\xdb\xc0vr\xeeG\x1bf}\xd9A\x04y2S>\x8d\x80\xd2\xf0\x0f\xb9\x98 J\x1e\x97\xe5Pjm\x8fȵ4X\xc0`\x97\xcc4\xe9\x1c;\xc5(h+$\"\xd7\x04s\xa7\xf4\xde\x1b\x04\xebȮxx\xfc\xf2,\x06\xe9\x13\xbf\x03\xc5\xef\xa1\xf1\xf0\u038d\xf6\xdd%\xe2\xe8\rP\x8aƈ\x1d\xa8\x86]\x95\xa1\x83\x1b!\nR͗\xf8R\x93h\xc1\xf4\x0ePT\x89w\x91;-\xa8w\x9c8\x168\xd4W\x9d\x84\x8eL$\xffAˈ\xb0^\x8f$6\xca\xdbhJ\x04\xc0\xbb\x1c\xc5ǁ?|Œ\xaf@\x9e\xca\xfa9\x131\xdc\x15Ӕ\xab\xe4\xfe\xef\x100\x87\xcb7\xaaP|\xa9\xb5\xe4\xd3\xc2\xdaH\xa0\xdfٖ\xa1e\x84\x8f\xfd\x95\xa5\a\x9eF&\\||\x8e\xfd\xbdb\xff\xb9j\xf4\xfdV\x8d\xfeM\xf3\xae>\xf7\xf2v3\xeeMw\x1c\xec\x1ea\xaf\xd9u\xbe/\xb7\xe3X\x8d4\x8f\x14J\r{\xc3!\x89\xc6\xd51\xa4\xe7h°\xcc\xf5\xfa\x9a;\r\x89\x82\x8b%ļ@\x1ds و\xe2?\xe1\xd6\xdd=\x80L\x19-\xabY\xe4SJ\x03\xe5\xf0i\xf1\xae_6k&砿\x7f\xff\xe2;s\"\t\x99\x02\xc50|z\xe2\xf1ɰ\x8bGC\nϸ.\xa3\xefw\xc1\\mW\x9c\xec\xea\xee\xbc\xe3\xdek\xc7\xfdP\x8b\xe3\x99)\x81\x02\xe9\x15vL35\xd0\xcb\xf3\x9a\xf6\xe3\xa3\xf77\x13h\x89\xcd#\xb83\x8bOe\x9ak\xfa\xae\xecy\xff\xb5cy<\x88a\f\xe5|\xf0\xb8\xac&\xe6\xcdʸ\x9e@.|\xdaV\xcce\xd9\xf6p=\x80\xf0s\xa1\xf8@\xa4W\x1c\xbb\xd1C \x8cW\xebvn\xdeT[\xb5\xf1\xf1[\x05\x03Y\x9c\v\x8e\xa8\xa7'\n\n\xbf\xc1rbp\xdd\b,\xf0\xb0\x02G\xeb>\x11\nu\ue022\xfaA\x14\xa4\xfd\x87!\xbcn|J\x9c+ϯᢄ嗅N\xae\xb9\x8a\xc4\n\xa4w\x1b\xde\x0eq_5\x93\xf5?\xc0@iF\xd5\xfeX\v9H\x88\xe7?|G\x81\xda:n2\rr\xc6\"\xff,\x1f\xc5\x06J.\xa4f\xe9\xc9l\x1a\x9c\x17\x92\xbd\x16\x92Ͷ\xd6]\xa9\xf5q\xe0\x95\x17\xbc\v\xe7?\\\xda\x18\x85i\xe1~fw2\x81'!a\x14\x85\x16J6SG\x1ḟ\x04\x94\xf4\xbf\xc5\xe65Q\x18\xd5<\xfa2\x9e|\xb3\xf6\xaf6,\xafRƗ>- 2\x04\xdf\xe9\x1ay37o\xdaH\x84\x16\xd9\xe9\xed\xc7\xc4\a\xac\xac\xcdc\x7fU\xb5&\xb7x\x12r\x80\xcd\xfb\x81$?\x9c+|w\x15ӹ\x14\xe6\x19\x88}\xaa\xf5\xa8\xaa\xa6\x90\xfdi\x89\x991E\x1c\xc4\xda\xf4>\x83\x10g\xd1\xe3']\xb9\xebP@\xde\tR}\x8b\x9b\xba\x9bg?s\xed\x9a+z\x14\x15\xd6\x1bu/H'\xa6w\x9d\xbd[\x9f\x05*m)\xe1E\xf8o\xd9Z\xad\x83\f\t5\xb6}\xbd\xc4)\xf80z!\xac\x9fq\xfb\x14\xc7\xf66\xd1\xde\x05\xb3\xde8\xe8ꥺ\x96\xd3\xf6\x82\xce9\n\x91\xa3P\x9f\xa2\\\x7f@\xebQ\xa8\xebI\n\x9d\x8bw=\x8e\xdfh\xe2$\xa1\xde0\xff\xb6H\xef\xca\xf3\xf1'D\x1d\x06\xa3\xf4\xbc|\xdfI\xc3y\xe1s^\xf8\x9c\x17>'\xb6\xf0Q/\xee\xb1y\x10\vȆ\xf6e\xac\x889d\xd1ۈ\x1f\x9es.\xcb.\x96\xc8+{[\xfd\xc4\x1b5\xaf\xa7\xfb\x86N\x92\xa1\xfb\x03\"\xaaɶH\xfc\x01\x1e\xf6ة\x8e\x9a\xfd\xd4\xc0\x13\xa3?\n1]\xbb\xfb\xceRP47wЍ\xd1\xe29\xf8K\x05\ts\xae\xf4\x00u\x95\x1a2\x96i\xefd\a\xe8uԼY\xf1L\x16oՍ\x0e\x9c\x0fV|\xfa\xb2~9\x8d}Z\xfe@\xef\xd3P]\xd7\xc9T\x17\xb0\xc6^(E\xa2{\x82\xbd\xe2\x84H\xbd\x8b\xe1\\`ի\xc0\xea\xa0\r\xddJ\xc0\xbdy۷\xf2J\xa0\x9b\xaf\xe3\xc0\x9fF\arHs\xa6\xe1\x89\xf9\x0f\x98\xb9\x14\x1a\"\x93\b_\x8b%\xe3\x99\xf7\x01Π\xec\x05J\xa5ҏ\x19\x9b\xa6n\fu\x10\xa0\x16\x92\xcd\x01[\xb4@\x9afM\xfbn\b?\xaf\xd6J\x83\xff\xc3\xf3U\x9e\xf3\x85\x1d0'\xabQ\xee|nc\xfa\xee'KY\x05\x9e,\x12\xb7\xaby\xb2\x853\xe8܈\x90DQ\x1d\x0fqÔd\xe1]6G\xa9\x12\xef\"\xf7.[\x1c\xe4\x89cQH\xdd\xe0$l\x80\x90<?-\xa1\xa6\xec'v\t\xb3\x03\xb8E\xbcשb\x89P>\x1d\xcf@y\xda9\xdd\xe9\x95\xee\f\x16\x83wI\xab\x9cE\a3\xe6\x95\xca\x13\x90p2\x95śD.\xe5\xd1\xfa\xe6zX\xfa\x03*\x1e[\xb73H^\xb7e\xc1\x8f\x19\x1d\xd55G\x9aI\xfd\xc0\x97\xf0u6S\xb6\xe4\x10!Y\xad\xd3\xfa}\xcc\xe5L\x83\xfc\xc4xZ\xd8n1\xc2%'\x98d\xe4\xf5\xc8<\xe3*\x81\xf8-\x86^9n\xb9w&\x9bX/\xc1\xb46\xbd\xcc\xd4\x04\xccJ\x1f\xb1\x11\x89\xe1\xbe~\x9f&\xa5\xbbX\a\r-L4B\x13C݇\x8f\xa4\xe6\x05w\xa3\x8a\xa5\xa1\x91i%\xd2\xfa\xe5\xfe\x19\x8e\xc2%hɣ\x97\x1bo\xa3\xed\x9d\xeb*@PW\x9a\xe9╍\xb6\xdb.\x9b\xb2,\x16\x19\xc4\x0f\x92\xef=\xe7kG\xa0\xcdvY\xa4\xf9\n\xfcӝ2\x05)Ϡ\xba\xacr\x8f\x95\xd82\xbe)(]\xb24\x0eh\x00gJ\xf1y\xb6\x84\xd6.\x19V\ab\xa7\x8d\xc3#\n=N\x1c\xb2l\xfd՚\u070ep\x03m\x1fܯ%\xbb\xa9#\x81l\aq+\xbcp\xe0m\x97\xb7C\x00\x99=\xbbu\xfc\xda\x16\x88z\xdb\x10)\xf8`ԇ\b:\x8e\xf9\xba\x84\xedӸ\x11T\x8e\xd5\x1e\xdb\xf9j\xe1\xc82\x9a\xc9\xedxKC\xc0V\x13s\x99\x97\xb9\xf5\xf2A\xb2L\x95\x94M*\xba\xff\xb9\xadk\x8f\x99\x86\x91\xe6\xad\xd2tjk\tJY\xde\x10;\x7f/\x81)\x91u\xfe\xf9\xbeHJ\xf8\xb9\xad\v\x8e\xe3\xc76#\x1d\xed\xd1\xc4\xde\xc7*\xf6\xf7~e\x86\x0f\x88\x06\xdcn\xbc\x91\xc8\x14D\x85\x89\xf6\xf5Z\xc2k\xbc\x9f1\x9e\x0e\x91\x9f\xe4\tS{\x14dQM.\xc5R\xe8\x9a\x17\xd2/\xdb,\xd1\xf2\x13UD\x11@<\xc4̵I\x80ԕ(2\r\xf1c\xa6y\xdaN\xbd\x1d\xc4\x16ޫ\x01\xbes\x9d\x88B\xdf,s)V`R'\x7f\x93؏\x90ы\xa4\xf3\xd5W\xa5\xbe\x03\xa7\xbd\xff\xe5CS\xa5\t\xf18Բ\xf6\xfc\xf5fJ\xfd\xc9\xd6K\xb0(\x82\\C\xd9ʯ\xd6Wy\x11h\xf8\xcb/\xe5\x1fyZH\x96\xd6\x7f\xee\xf8\xe8\xf0\x9f\xff\n\xcc\n_H\x88\xeb\xd3WՇ\xa3\xd1(\xd89\x91\x15\xb2\x9có\x86\xcc\xfc\xa5.\x16\xff\xaf.\xb8\xf8u\xf5a\n\x9a}\b\xaa\xa1\xae\n\xa5\xc5rR\x1fM\xb8\x86Y\xd9VId\xc1\x1243\r0\r_,˄f;\x01\"\x02\xa9GK\x96\xb19HC\x93gf\xf6\xa3\x88\x8dfR,ǡ\x84X-֣\xea\x95\xe0\xaf\xcd_\xa6z5\x9b\x8f̏\x83\xda\x0fh)\xd2\x14\xe4h\x0e\xd9Ţ\x98´\xe0i\\\xd1\\5\xb3X\xfd\xcf\xc5\xff^\x18]G&\x81\xaf=\x98\xd2l\x99\x8fìH\xd3\xc0Ě)4&\xcf\xf2\xbc$%3\xd0P\xce\u0604Æ'\x91\x9bEQ\xf5Ieu\x17\x9b/.bX\x05*\x87\xb2[\xf6n\xdb[cP\xf2\xca\xec\x16U\x02\x18\x85\xff\xb8\xff\xfa\xc5l\x1c\x8dËJ\x9f\x17[k\x89AE\x92\x97;\xff\xe3\xb0\x04\xe3\xaek\xad\x86\xbe\xdf~\xf0\n\x16\xfbh\xef\xac%\xfe:\xc2U!%dzw\xc1\xb13\xd0\xe5\xabO\x11\xa3U\tg\xfb@;\xdfW\x93\xf9\xb6\xfd\xe0\x15\xf9Hd\x8d\x1ak$H\xa6a\xbe\x1e\x87\xdfa\x9a\b\xb1(?}\xaa\xfe\x7f\x95r\xc8\xf4UyuZ\x03ӈ\xfd^dq\n\xe3\xf0j\xfe\xdbo\xc1\x8b2\xe8-\x94w\x15<\xaa\xa9\x8d\xea\xa7^<Te\xe1͓\xaf\xde\u009a\x17F\xe3\xf0\u05cag\x83\xef\xaa~3|i\x1f5\xa1]\xb8\x96:.\xffN\xb9\xd2\x7fl?\xbb\xe5J\xbf@\xb2\xde\xfa\x19ųy\x912Y\x7f\x18\x98\xf5$\x18\x9e\xe11[d\xe2)+O\x9b\xa9q8ciiX*\x12F\xb6\xa53PŴ9M\xa4\xc6\xe1\xbf\xff\x13\x84\xe1\x16.\x1fX\x9a'\xec\xc3\xf6\xb3\xdaf\r\xdf/\xbe64\x13X\xb2F\x90\"\x87\xec\xf2\xee\xe6\xdb\xffݿ\xf8\xb8-\xd5k;\xfb\xf9\xca\x06\xda\xef8nyp\xd7\xf3\x84a\xab\x9b\xadm\xa1\x86k\x18ڙ\xad=\x83\x14\xcf|\xc94L\x8aL\xef\xcdH[xr\xae\xe8;\xa7ɶ\x85\x8e\x85\x19\xc4\x02\aS\x0eo\x8b\xfa\xeeĲe\xe5Ӿ\xeaiQ\xa2+a\x84\xe7\x1c$7\x92\xdf\xfb\xa2\xcd.\xe263E\nys@\xb7ӯ\xdb/\xf6v\xfc\xb0\xdd,\x06\xddlh\xbcJ\x1f\x89\x15\xbc˔-\xa6Q\xa6$,\xbd\x86\x94\xad\xeb\xd7\x01\xe3\xc0b\xed\xc4\xec6g:J\xbe\xe6 w3\x1c/\xd8F﮸`X\xf59w\x01ݜ_hyġ\xb2Z\b\xb6W\x95N\nU\xf3<˛p\x97\xb40`E\xf2\x82\xee\v\x80\xa2\xd4\x0eb\x02\x11\x9b\x9f'\x11\xb1\x80\x9b@\t\x05t\x02\xbdVУiXc\x83+\x12\x19x\xec\xfdbc\xd7{\xbf\xdd\xd8l@\xe4\xa7=V\x99R\x13\x9e\x81RW\tD\x8b\x83x\x13\xbb+\xc4z\x9c͂\xd3x\x85\x16\x96,\xb3!\xea}\x9f(\t\xde\xdf\xe7\xc4\xcdvY\xa9\xbc7ٳ4\x99\x8d\x88\xdfdb\nҲ'\x7f\x1f\a\xbd4x\xfa\xf8l\xd6*\x9b\xa5E\x18v\xb2\r쐄\xfaK\x84\xb95\xff̸\xcc\"\x8cND]E\x03\x04ɐ\xc7v#\t\xe3J)\xb5\x96\xa3\x8d\x10\xad\x8f9\x1c;\x85\xfb\xd2\xfanw6{|]Y\x84\x943b*\xceG\xcey\xd29O:X\x9e4d\xc2\xf3\x99\xed\xbd/\xba\xd5\u05f9\xec\xdcm\xe3Ni\x1dK\x0ec^I\x15\x12\x1e\x12\t*\x11i<l\x9c\x7f\xf3\x8c\xc9\x06|\xa7\xf4l\x80?gO\xe7\xec\xe9\x9c=\x9d\xb3\xa7&{\xb2~\xdd.\x82v\xe8\xda\x11\x84\x83\xab\xd5\x1c1\x10u\x80\x13%]\f Q\x84\xdc D\xc0\x0f5\x92McX\xb0\xd9`\xe6\x04\x98\xc3\xd8\\\xfc9\xe1D\x01\x92Sb\b\xd3\xdf\xfb\xa5\x02]\xe4\xd7U#\xf4\xab\xea2\xb8\x89H\xf7D\\\v\a\xbb4&E\xbao\x16\xadF\xe1\x02\x00\xcb\xf9g\xf3\x9e\xb5\xe5k\xa7\xb99\x05\xe7Rc\x18f\"k\n \x1e'\xb7\ad\xa4Yl\xec\x14\x84\x1c\x92\x8d\x03\xb2\xb0\x029=\xd8\xf06\xa732/է*\xe8\x04\xd0}\x03\x96غ\x7f\xd1\xd8m\xffy+˴J\x1a\x0fLy\xddnN ]^%L\xb6\x9e\x1dr\xcayC\xa1\xef2\xce\x10\xfaf\x8dK\x0e\x93p\xcdu\xb3N\x88\xe0^\xbb\x9b\x01a\x8f\x88\xba\xf62\x10S\xdf\t\xcavJ\xce:\xfdm\x01\xbes\xb8\xed\xa3\xb6\x05\xd1\x0ek\x9fL\u0557\x95 N\x03\xf5\xf5\xc1\xd5\xe9\x15\xcb\x16Y\x17\xba8\x85\x10\x15\xe3v\x18Ț\t\x82/!?\xe8f\xcf\xc1\x16b\x14\xbbG݅\xb0\xcdV\xbc\xc0\x18y\xc1\t\xderpV\x83\xb6\x18\x94\xce<\x88\xdc\xda-\xcdɭm\xd2\xce\x1f\xab\x05ϫ\xe3V㠫\v54\xae!\x85>4\xaa\x13\xbe\x7f\x9a\xc2e5\xa8\xcd-\xcd\x10\xaeMt\x84\xe0v\xa9I\x91\xb39s_\xa4\x8e$\xea\xb6b$!\\3\x05\xb7rj5\x17Sor\xabi\x99ד\x1e\xe8a\xdc\xe6F\xef\as\xad6.[Ƕ\x8e\xda>^\x99gV=\x12\xf6\xa0\xa1\x15H.\b\xb1'\xf5Ѽt\xe7\xd1煮\x16\xf7Z\xb4\x9f.\xc7\xc0\xd1\xdds\x01\xa5\xff\x9cI\xcd]\xf0\xc3\xec-\xe3\xf6\x97\xb1\xd0\xc2\x01\vsA\x1cB\n.\f8/\x82s\xda7\xfbYH\xb0ݤ\x88\xd1xĢ\x84gsW\xbf*\x94\xdac\xae\x16\xae\x8e\x17hB\x8f\x93\x9b\xdet<\x99\xb3\x97\xb7\xb3\xbe\f\xd4mW\x8d\x1a\xac\x0f<Nnz\xd9\xdd'\x9eB\x1f\xbb\xf3\x87W\\?'\x94\x8eT\xc2$x\xa0\xe4\xd6і\xe9\xf6G\x1af\xba\xea)\x82<\x99\xa9>J\xc2\xf4\xe1u$\x81H\x89٣'\xbe\xa7ڛB\xb1\xb1=\x1b\x16\xd0L\xa1\xdaaa\x94\x86\xcbX\x91\\!\xcc\f\xd3j\xd7\vf\x1c\xcdr\xddx\xe0Y\f\xb2\x0f\x1e<\xc5\x13\xdf\xf6w\xa2Fs2\x89\x96s[\x04#adcP\xbfY\xb1\xd39#\xbc\xf7\xe1J%p\x8d=\xb1\x12\xa3\xc8\r\x17l\x88\x13r\x19\xaa\xfb\xbd,\xbaA'\n\x7f\xb8\x88\xeb\xf2\n\xa8\xe9c\xbayb<\x9e\x1b\xaa\x8a\x8f\x83\xeev\x1cK\xbe\x02y,\xeb\x8eL\xc4pWLS\xae\x92\xfbSv\xf4\xfe\xe2]\x152.\xb5\x96|Z쭙\xecVC\xe0{\xce\xee\x98TYZ\xd0q\x04\xc4=\xbe\xe7\x98T\xc6$\xfc\x15\xbfx\x9a\xb8\x12\xdbN\xb6E*-\xefH\x1d\x17\x87\xa8\x97\xec\"P\xf1N\xe3z\x97kni\xc6\xd6\xe1\n[\x92E\x10\xaf\xa7%\xd2n\xa4s`Sn\xd8\xf0gɸ\xd1\xdf2]s\x122\xfdR\xd7\u05fcU\xd1\x18\xbb\\B\xcc\vk\xd1\x02J\x87\x8a\xff\x84[\xbe\xe4\xba'%\xe7\x9cg\xd1x\xef\xe7o\xba\xe6O\x8b\x93|\x19R\x9dz\xf9\xfe\xfdK߈\x8d\x12\x12\xc6\xc4\xc3\xf0\xe9\x89\xc7GÎ\xdb\xfaRx\xb67G?\xbd\x85I\xb5\x9c;\xda\xec\xfb\xbc\xc3V\xee\xb0\r\xbd\b\x99\x99W\xce {\x995\xd3Lyzyc\x1a\x19(Џ\x8f\xbdw\x14\x9d3\x9fGpg\x92\x7f\xa5!\xd3}\xdf\xc0\x9e\xee;\xf7<\xf6\xa28_`u\xdb{\xc5pg\xads=\x81\\\xf4\xd1u\xcce\xd9G`\xedAh\xb9P\xdc\x13\xa9\x15w-d\x11\x84\xdc\xe2\xdf\xf2\xdcY\x05\xd51\x8c~o5!\x8bs\xc1-um\xc8\t\xbb\x17\x92Gf\xfe\x9b\x89\a\x1dV*N\xdd$BY\xeb\xf80\xaa\xf1\"P[7`o\xb6\xdcGR\\\xf5ܦ\x8e\x12\x96_\x16:\xb9\xe6*\x12+\x90\xbdmgK\xf2\xbe\xeaNҟ\xa0\xa7\xb0V\x9d\xc8\xd6¹\xf5\x81\xa3\xf6\xa3\xaf\x97\xab\xb5wc\xfa\x9a\xceXtb\v\xc3\\H\xbd\xb7\xc3\xf1a\x16O\xe7D\xbdLԛe\xf6]\xa9\x1d۸z\x9d\xc38TZ\xf2\xff\xb2w5\xcbm\xe3H\xf8\xae\xa7\xc8\v趵\aݦ\xec\xcclj~\xecZg\x1f\x00&[\x12\xca\x10\xc1\x01@\xdbz\xfb-H\xa2\xe2\xecZb\xff\x80\x8c\xe4t2\xa7\x89\xc8\x06\x1a_w\x7f\xfc@\xa2\x05\xa9\xcb\xfe}ʋ\xf3O\xae;\xfdoo\a9cN\xf6\x9cc\a'\xd7,\xe3\x0f/.%\x01\x1b\xe4\x8f\xf4\xa2B5?\x8c\x81\xbb\x98\xed\xf1\x19h/p\xdc8c7\x92\x15\xaa\xf2\r\xae\xec\x99\xe28f\xb6\x17\xd7>\xf9\xe6\xf2\x9e'\xeb\t\xde\xccik\xfe[9\xb9\x96\xbd\xf8P@\\+\xe4\xb1r\xa9\xe1jތj\x83\xcf\xff\x06\xb5\xc4\xfd?d\x17z\xe0;m$\x13\xc2L\x0f\xf9\x0e\x19\xe7\xa6\xc8Q2~J\x1d\x05\xe1\x050\x12\x84\xb9\x9b\xc7t\xd8\xf0`D\xe5\x18\x05\\0\x14\xd5\xf4\x17ʐt\x81\x9c\x1d8\x0f\x048\xaa(r\"\xa5\xb546c3|\x82x\x87\x87\x1b\x8d\x97\x94\x13\xf0/\xe0\xc8\xecp^\xcaaC\x88\xfd\xa2\x8e\xd8\"5\xfa\xa9\xaf\U0007082c\xd9\xf9\x98\x9d9/\x03Ɂ\xcfxAH8K\xf2KC\x05\xec\xf5\x9e\xbd\xe8P\xeb\a9n\xa4Q\xc7v9\x85\x9dp\U000fd937\x98\x95\x8d\x1a%\xccJ\x98\x950_!a\x8e\xdf\x1d\xf5\xf5\xd5?AS:7\x98\xae\xb6\xd0T\xe3\xb8\x11^[\xbb\xef\x943p\xea\xee\xc9x<ٌJ\x16\x89\xf8\x18$\xcd\x18\x1fw\xc8(Bc\x05\xf9\xc3\xe1\xf0\x1a\x9a¼בf́\xfc\xdd\xf9\xdc{h1\xe3\xa3w\x95π\\\fNs2\xf12\xc0\xca\xc6Tཔ\x04\x8dy\xaf\x91)\xf16\x05\xbeM\xee\x15Y\xe1m\x86\xd1\xd4\xfb\xee\xe4\x0f\xf6\xe3\xe0\xa2-<\xd6\x12\xa4\x15\xd2\xc9Ϟ\xae\x85\xbe\xcb\x13l\x87\x8e\x1bD\xdd\xe7\x02\xcf8\xf0މ\xa7\xa5\x1b뻍\xf5I\x0e&\xd8\x01z\xb4c\vbe\x1c|\xb9[\xcc\xf8\x9e/\x14\xb8+\x93\xe0\xc5\xc8\x13{\x1b|\x82*\x13\xa1[\x9f\x1br)\xd8ˀ=F\xf7\xb91\x8f\xee4V\t\x8e\xd8w\xde\x1eڔC\r\xff\xd0\xc5\xfb\xbeD^\xdbw\x1e\x16\xdff_G\xff2\x13\xd4\xf4CԜ\xfc\xf7#\xe4N\xff\xe2\xfbn\xcbDd\x9cWQ.v\x83v\xb0\xe6\"\x8a26P\x91B\f\nY\x14\xd1\x05\xeb1\x8a\xdf(\x8ft\xe8\t\r\xa1\x1c+\x9c \x1e\xf4P\x99\x0eG\xa40\xba\x05\xa5,\x14H\x17\xc3Ѻϕ>J\x02\xb6P\xbdײ\xba+\xab\xc5j\xc5\xdb[\x95\xe8\xb168\xfa\xe7خ!\xc0ż!u$\x02\xceV\xdb/\xb7e\xefWp\x81\x86\xf6s\x8b\xf0\x82o\xa6x\xcb;\xe9\xf1\xb5Ʉ\x94\x9b\xba\xde-\x97\xf1=2q\xc6#\xb9\xf5\xae{\xf7<\xeb\xf3\xc8\xdb@2\xa7{w\x0f8'\xb6p\xe2\xab\xefa\xb8\x9b*\xd9g\xb8\x05S;\xdb\xc0\xa0V\x8b\xd3g1\xc5\xfb\xd1TO~\xb9\x1c\xf8(\x1eG\x1a0\xf6*\xbfi\x1d\f|\xca[\xce\xdc\xc64\x9dq\x0f\x03\xcd\xe0p%%\xb7:p\x0e\x9c\x8d\x9b)\x86>\xd4\xc1\x0e\x03+|[,\x02\xc3\xc5\x1a&\xb0ܳ\xc1\xcck\x9fź1\xb6\xa7\x1d\xd2O\x8c\x11\x9cˈ\x94lL\xe3\xc8\xe8\xfev\x83I\x90:\x0fd\x97;\xce\xc7\xfd\x04ϣ&\x85\xf8\xd1\xe9\xbaC\v\x9d\xf3u\x880\xa0\xf3u\x896(F\x9dz'+\"\xf6\x131ٱ\xffc\x96\xcb\xfc\xb9\x1e\"\xc7\xe0'ٟ\x83\xf7\v\xfa\xde\xf4\xfb\xe7\xbfm\x80%\x84\x00\xf5m\x97\x11\xfaP\xad\xa1\xee\x9cmV_V\x8d?\xfe\xefϯPuC\x1fٳ\x93\x13w\xecog\x80߽\x96[\xa4W\xb4B.*3tRM\x14&\xb52\xf5s\xb4A\xe0km\xf1\x15,6\al\x95\x93\xd7p~e\x17\xd6{Q\xe9\x19\xc3k\xbb\xe8߽誁\xaf\x81\xaf\x81\xff\x93\x04>\xdb\xf0\v\xd8\xd5\xfa\x8c\xb61\xc8X\xcf<\xc7\xcb\xf9\xab\x14\x12\xf37\x1c\x8ct\xd9\xde-\xb3\x91\x17\x80\xbe\u07bd\x13Jsb^\xf2Ώ\x01\xbdz\xf4\x15\x025\xef\xb0R\x95Rc\xa5\xc6J\x8d\x95\x1a+5Vj\xac\xd4X\xa9\xf1xԘc\x92\xbe8\xf3\xffgQ\xb3ѦF\xbc\xa0\xf5\xb5\xaa\xbc\x83*\xef7'e\x0eL\xbb\x98o6\xffuyg\xa8\x87\x0e\xfdr\x99\xf1R\x84\xba@f\x96N\xa3@\x8d-V\x1eJ\xd4ڂ\x83\x91\xd6\xdc\x02\xab[t>\xbc\xb4.O\xf3\xe5\xeap\x81Z\xcc(\x05cx\x12\xbd\xbb]v\xdf{T\x8c\t\xdd*\xba\xbc\xe9\xdfbd\xb9@\x10\xa5B\xbfIp\x94|\xeb\x9d_m\x7f\xe7%o\xc1ȹ9`\xfev̳\x89\xe0\xf1\xe1e\xde\xefI\x98j\xbdg\xb4^r\xa0s镈\x9e\xaaΫ:\xaf꼪\xf32u^>\xad,G)\x85\xf8\x11\xb8\x90})\x9fB2\xa3M\xe0#.F\x04\x94\x919ZN\fsi\"k驮$\x1a\xc9:b\x93l\xcf\xd0\x16\xb3q\xaa\xa8j\x89\xaa%\xaa\x96\xa8Z\xa2j\x89\xaa%\xaa\x96\xa8Z\xa2j\x89\xaa%\xaa\x96\xa8Z\xa2j\x89\xaa%\xaa\x96\xa8Z\xa2j\x89\xaa%\xaa\x96x\xd5Z\"\xe1ǦK~\xe3\xbb&=pN)ǝ\xa2\xf5?\xbd>\x10\x98EC\x9bZ\xf8MX!\x7fI\x8e/\x06R\xa9 \xa8\xfcfc\x9a\xfaz'\x00\xcd\xf3H\x83\xe71@̑lb'\x1d\x99\xd5t\x96~\r\x9e(\x8b\xf3\xfcw\x88\xeb}\x87\xc0\xdfa;pR^i\xd3\"\xe2\xce\xf4/\x1f:\x85\fӺZH2v\x99\xb2*\xa1Ť\xaa'\xef\xdfV\x02\x8f\xfc^nE\xd0\xc1\xee\xebV\xc0\xba\x04\x1d\x9cNob\x8cHZ\x9e\x95\xc0\x8a\xa8\xfdY\x81\x05c\xb7B+b\xbb\xf7\xfe\x95!\xb5\x1f\xf6\x94@ݟC\xab\x05V\v\xec\xa5\x14Xօ\xf4ٝ<\x10\xb6ب\x18\x8f0\x14v=\xc9c̑\x82\x93\xd3\x03?5\xf0\xc2S\x10\x9a\xfc\xb0\xe4\x87$\v\xe59\xe1\xc2Ҿ.f\x13x\x06uB\xb9\xae\xfb\x14\xeb>A\xf2\x19lA%\xf2\xf9\xee\xee\xf7\x9ds\xfb\x83\xc9G\xb3\xe3\xec\x12\xaam\xe5\xd03ᠵ\xf51=\xe4\xe3\xbf\x17\xb3\xf1\xc3\x02^\x87\x8eG-g\x8b!\x04\n\n\x92h\xa1e`\x17E\xd6\xfe\xbfuJ\xedo\xa7\x9bȌ\xb14k\x1fӂ\xed\x1d\xa6s\xf3<\xff\x05\xa6F\x89\xebE1!q\x95\xa4\xa0\x14q\x1b[\x9b-:\x02>\xe7g0\xe4\xb7\x7f\xe7{\xb1\x98u-;$\xa5\xb9\x80\xd2\xf6\xb5\xe0B\xb5\x9eVI\xfa?\xa6\xd9\xde\x11\xb9Y\xbf8B`\xcd\xd9/\x84\x1d\x98e\xb5\x86\rL\xechn,\xe4o[C\x9aM\x84\xe1T\xb5\x0f\xbez\xfa\t\n\xcb\xcf\x06\xfbk\xc0\x1f\xeb\xb26\xc0C\xf2g\xfb-\x97\x02\xab\x92a%\xc3J\x86\x95\f+\x19V2\xacdXɰ\x92a%×D\x86ɗ8\xfb\f\r\xc4x\x1f\xfc#:\ap\xd0Me\xce\xdc\bb2f&3\x12`\x98W\x19\x19\x98\xf8\xf4ii\xac\xeb\x02|]\a\x88k\xefH\xce\xe1~\xb5\xc7\vN\x06\x8f\xe7\x02\x85\x93f\xd9\xcb-\xe0\xedLdr\xdd\"\xe5ꂈ\x10rt\x91en=`\xf3r.'g%\x00I\xc6\xe1\xf1p\xf6bp\x88\b\x8b\x84\x88\b\x88\x84|\xf0\xf86s\xb0\x1c\\\x939\x0e\v\x93\xf9\x8c\x1bk\xdc-8\xb3E7\xca\xfcQu\xa9\x85`}}\xf1Ì]UA\x8cWP\xe8YOVWQ\xea?z\x02\xbb܌\x92\xec\x06|\x97.<J\xc9S\xa3p12\"\xf2\xba\xa0]E\xa4\xa2\xbcp=~8pO\x0e$\xee2\xf2\x16\xb2\xcf,_\xeei\xa3dFm6u\r.\xa1?:0\x1d\xd2\x06\x9f|\xe5\xdd\x04\xc6\xe89o\xfe=\x8eg#&>*\xad\x0f`j\xab\x9a\x8fj>\xaa\xf9\xa8棚\x8fj>\xaa\xf9\xa8棚\x8fj>\xaa\xf9\xa8棚\xcfEh>\xfd\x87\xf8\xe8\x19q\xc2\xc4ٍŋ?eN\xb3c\u0097\x85\x8d\x8cZ\x88\x1fw\x86\xe4K\"T]\xb0i{\xe3\x9b\x04\xafiLl\x19\xe7\xfc\xcb}\xb0\xcf\xd6\xc1\n>\xc7\xca8C;X\x96\xfb\x8dkeZ\xf3h\x9d\xa5\xae\x1cg\x96\a\xc4L\xf4x\xc7D\x96\xe41\xa0\x0e\xbe\xfd\xa8\xb3cD\\F\xc9\x01\xd2\xf5\xf8Hn\x83\xaf\xfe\xcc\xe7\x17.f#\xbb1K\x82w\x8d\xdb\xfe\xdb\xfb\xf4\xabu\x10\xb71\xc1f\xfc\x19\x86\xae\xf9%\xfe\x16|\xd72+\xf7?\xff1r\xe5>\x8c\xf1/\xdfd\xdfL\xe4\x91\xffD\b\x97\xeb\x90\b\x7fئ{\xbd\u06dd]0I\x8au\xf0\f\xe43\x12\xd8\xf9$x\xfc\xd7\xfbbc\xbb\v\xa72\xd6\x11q%0\xc6J\xae/\xb6\xa9\xfdK\x9c\x10Y\xabM47\x01jh\x925\ue845j\"\xff\xbcg\x9asR\x1a\xdb\xfc1\xd1Lh\x95\x01\n\xf2%1\x9f\x8bѵ\xba\xb7\xa5{[\xba\xb7\xa5{[\xba\xb7\xa5{[\xba\xb7\xa5{[\xba\xb7\xa5{[\xba\xb7\xa5{[\xba\xb7\xf5\xc3\xf7\xb6b\xaa-z\x1f\x80\xae\xa2\xedn\x7f\xd7T0\x9e\x89\x04ac\x9b\xdd~Ɵ\x10c>Y\x92@)\xc8(\x7f\xc7ܸ\x87X\xa6D\xbc7\xc5y\xcf\xdeu\x1b\xb8\x85ܑ\a\rj\xe2C\x03/\xb1ֻ1\xd1\x1b\f\x90\xfd\xcb}&a\x19\xa2\xe7\xb7\xf9\x1bO\xccFzl`$D*\xdd\xdf\x03m\xb7\x81rY8\xdb\xf5\xa4\x9a\ff{k\xc1\xb7fEށ\xbdtl\x7f۹\xe2\x18\xa3d\xadCm\xe9\x1e'[\xb7\x83\xad\xdcx\xf4B\xb3\xc4\x11\xc7W\x9c$^|x\xb2\xcd\xea֢\x9dLt/űh\xf7\x90\x1c\x83wI\xddě\xddq\xfb\x8bY\xd9̗\xa7\x15!<\xa3\xe5BR\x1e&\x03\x9e\x06\x12Oَ\"\r\x9cS>\xa8\x99\x95\xec\x1c\x96jɰB\x0enڪE0\xa1Z\xc3\x18\xcb6*\xde\bn\xa9\x9b\x88=͞0dḥ\x83C\xcb\xcc?l\xf3\x84\xf0\v\xa5\x9eB\x9b\x8f?\b\xc6\xdd\xf4\x1f\xc8!\f\xa0\xd7G\x9bfj\xd3Lm\x9a\xa9M3\xb5i\xa66\xcdԦ\x99\xda4S\x9bfj\xd3Lm\x9a\xa9M3\xb5i\xa66\xcdԦ\x99\xda4S\x9bfj\xd3Lm\x9a\xa9M3\xb5i\xa66\xcdԦ\x99\xda4\xb3`\xd3\xcc\xff\xb2w\x7f=\x91\xdb\\\x1c\xc7\xefy/\xdc<\xcf\x1dw\x14\xda\x15j\xb7BLy\x01&\xe3a,2Idg\x96n_}\x95\xa1\x03\xac*U\xfe\x1d\xc7\x01v\xbf\xda[&\x7f\xecsl'>\xd9\xcf,\x1dW\xb6\xe67\xac\x90\xe7\xa8\xe1/J\xc9ұ\xc0V\xcf_\xdcQ\x96\xb2XsilqylY\x89lI\x9d\x7faC[sA\xae\xd0-\x8aaS\xf5\xf7\x87\x9cX~\xb4\xb0\xff\b\xf1\a\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\xf9\x1fh\xa6\x8b\xf7~|F_\x94'\xe89\xc4La\xf9\x02\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\xf9~\x80\xce\xe9\x19\xe6\xbc\r.\xe5\x8cg\xd9C\xa5:HNW1\xb5C\xadAې\x1fjT\x85\xa1R4U\xec\xf8\xab닳\x939\aΩ\x1f\x7f\xf7\xe3D\xe0\xce\x7f\xe0\xeb\xab\xcb\xf9\x0f\x9a7k\t]\xf6\xfc?ܯ\x0el\xc2[f\x952#\xbf\x8f\xa0\f]xy\x9e{˦\x03\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\x04\xf1\xfc\x00\x88'\xb0&\xb0&\xb0&\xb0&\xb0&\xb0&\xb0&\xb0\xe6w\x02kv\xfd\xda\xe7m\a\b\x8d0\x1dt\xe5[ߌ9\x16\x8b\xb5\xfeF\xea\x15\xa1\xf9\xfa/>n\xbd[\x7f\xbc+\x1f\xa2\xf7\xbb\xc3NZ\xeejQ\xb8\x92!\x86~\xaa$:;\x99\xf7QDy\x009^\xc3E\xebR\x9a=l\x9f\xbf\x92\xfa\xe4Ɯ\x8e\xcc^9\xa8k\x86\xa6\xef\x9eR\xe2\x0fa\xab\xb8\xea \xf5\xcd\x15e\xfcB\bZe\xb4\x8a\xfe\xb0\xd7W!\xba\xe3\xbe\x1b\xc3\xce\xd7\t\xac\xe9u\xe9z\xdf\xfa8\xff\x91\xd5\xf2>-\x147J\xa5\x8e^\x92\xa2\xbe{Pk\x87\x16\xba\"\xa9RH]\x87\x8a\xd5A\xf5o\xd9R\v\xa4\x0e\x80r\xfd\x8f\x902\xb6\x9a\x1f\xc3\t\xb4:\x1f\xc3\t\xb4\xda\x1e\xf9\x04\xd2\x18>=\xbc\fC\xebw\xbe\x1b]{\x1852\xfbZz\xfe\xd6\xc3[\x0fpeB\x9a\xfe\xa5\xaf\xa9\x19\xdb\x1aw\xab\xa7\x8d\xfe\x18n\x88;\xc3\x16\xb7\xe1,\xca\x02E~\xd0Զ\xae\xc5LP\x03\xc8R\x03\xa7\x87FIݛ\xa1\xfbJk\xdd,\x11c\xabo\xab;0\n\x7f\x9c|\x9c\xde\xc1\x9e7M^\x05\xb8p\xe1\xdf\x1e:\xaf}\x94\xc3o]\xf4ױ\x9fvn\xa7\x83\xa7\xc1\xe5l\xc6(k\xa1\xb4\xbf[\xf7;\x17\xbaY/\xfc\xd5>˧\xe8\x1a\x7f\xadm\x92kӑ2\x11\x8d}\xeb\xa3\xcb\x1c\x10\xb2'\x15u\xd4\xf0\x9b\x8do2\x17\xb6R\xbb\xcb\x16\xab|\xec\xe9F]\xd6\xfb'\xe3\t^zHܭ]f\r#M\xd1\xe2\xcd\x1b\x06\xc0\x9c\xb9p쇾\xed￮\x86\xe9M\xccEߥ1\xbaЍo\x99\x01\xad\xbb\xf3m\xfe\xbbL\xdbI\xa6\x7f;76\x877\xff>\xa5\xfce\x80x\xf7e\x97X\x00(\xcb\xd9eO\xe4YNz\xc8\x1e\xb9iL=Q|\xadJ\x8a\x95-\xb1\xad4\xf3\xe9s'J?\x93\x86\x99\xb2\xd68d\xdfoS\xaeK\xbdg\xdd\x00(\xeewC\xd3\xc8?ٹ?W\x0f\xfe\xd10\xad\xfd\xff\x7fզ\xb5\xe3\x1c\xf1kť\xc3\xe3\xd6w\xb7]rcH\x9b\xe0\xeeZ_\xe9LJ\xfa\x9d\x1e\xbb#\xebo_\xb5R\xd6\xdf\xff\xeb\x8eOf\x8e\xa7\xfc\x9c|\xaa\x15\xcaȢ\xec\xa1V\x9d\xea\xdcc\xfa\xb9ui\f\xcdOm\xdf<\xac\xc6>fG\x80eZ\xdd$e\x9b\xc7\x10g\xff\\\x9a\x8bcP\xabA,ImKlke\x87\xfa>\xfdu\x94]]Vnuu\x82=}\xbe\xb0Z\x83\xba\xfbk\x1f\xfdeH\x0f5#\xbaq\xcd6t\xf7\x9f\xfbu\xfd\xb0^\x87\xf4\xa0\xbd\xef)8\xd1\xed\xcdU\xf5\xf3,4\x1c<\x84n]\xfd$K%\xb4\x9egǰ\x91~p{sU5/\x7f\t\xad\xaf\x99\x97ˍ\xaf\xc97\xd1g\xbeg,\x8c\xb1û\xc7\x05Τ\xc7\xd8K#\xe4\xff\xe4x3\xb5\xe2\xac\xf1\xc3v\x93j\x06ٮ\xef\xc2\xd8k\x1f\x89\x1a\x1e\x9bM\xb1\xa2,D\xed\x1f\x0f\xbe\xeb\xa1\xf2\x98\x9b\xcaXc\xbe\xa9\xa7S\xddx\xe9\xc3 K\xd0\xd9\n\x90\x8dweH;}\xbb~\xa11\ua62d'\x95n\xbc\t\xdd\xdaǚ\xe3\xcdB륥\xf3\x93\xa49$\xcd\x0f\xfb\xa0\xd6\xf4\xdd&\xdc\x7fvC\xcd\xdcY\xfb\x8d۷\xa3\xfa\xa0\xb6\xec[\x01yq`XM|\x9c퐝\xd8We=V\xd2o\xb6\xc5Sa\x03\xa9\x89l\xdf\xd2\x18\x94\xaf`LC\x80}Ū\x8e\xea\xa6\xe6\xee\x0f\xf5J\xae\xad=#\xeaCg\ng'\xf5\xf2~\x1d\xc3\x17\x1f\xbf\x97\xf7@ӷ:\xd7\xfb\xbb6\xa4튅\xc7\xcb\xc2c\xb9\xf5\xde\xd3\x12\xe7|\x1cc\xb8\xdbg}x\xf2\xbe6 \xfff\xefJ\x96\x1bǑ\xe8\x9d_\xa1\x1fСcn\xbaU\x8c\xbbf\x1c\xdd\xd3\xed(\xbb\xab\xcf0\x99\x920E\x12,\x00\xb4\xad\xf9\xfa\t\x88\xa2\xe4E$\x91\x89E\x8bQ\xac\x93L0\x13\xb9>$6\x9d\x85\t\xcd\xf3\x9d\xa7e\x81\xb4\\\x88\xe7\xfa\x99\xc9\xe2\xcb\xddm\xd0p\x910\x96+\xc6Zr(\vd\\r\xa5i\x1e\xd6\xf0\xef \x15z\a\xb5\xa3o\xf5϶\xdb\xf8\xcd\xc7^\xa8\xd3p\x94y\xe6\a\xbe\xd1mI\x91:\xe1b\v\\|\xb8_\xe0뉜i\x7f9\x1e\xb6V\xef\xa5\xf7\xe6\x7f\xc1\x9f\xb8\x12\xf2$\xb4{\xe9_\x98+\xf7l\xc7\xf3d\x1a\xb7\xe7<\x1cC\x132{\xb87\x88\xe3\t(~YA\xc1[\xc2q\xefH\x1bT\xfc\x7f\xf0\xbb\xb9s$0%\xb4\x8c\x97yH\xe9F\x1a\xbb\x95\xed'_\xcc\xd3-\xe6\xd1L\xae@\xff\xfd\xf7\x1f\xa1\x11,II\x94\x901\x9b=?\xf3\xe2j\xba\x83\xf7\xce\x12^\xbeo\x87\xc5!\xbd\xf4\xba\n9\x02\xb3}\xf0\xf2\xaa\ai\x06\xee$3p\xe7V\xb4Y\x9a%\xb9 \x83\x86\x05\xa6\x99\x8a\xb4xiG믿\x82\xcfp\xa2%\xbd\xda\xee\x86T\\i\xa8u\xe8\x15\xa3\x91b\xe4\x05\xac\x81n\x8a(\x86\x17+\x98\xe2\xe3G'\x80`V\xcd\xf57hDH[.\xb8ܞ|\xb6\x89\xa0\xc4F(\x1e\x89\xd4\x13\xc7\x16B\t\x84\xf0\xe6r\x90A0\x93)[\xa5A\x86]5\tu\xd1\b\xbb\xbd\xaeN\x02\xc6\x17\x1e\xaf,\xbc\xec\x05\x9d\x05\xa8,\xa1m\xcb\x1c뎩\xf0SL+\x8a±\x97\x9aE\x89\r!5\xc7U\xe0e\x06\xf9\x9a5_Z\xbd\xbe\xe1*7GR\x06\xf7\xa5\x03\xc9\xfbn\x0fzx\x82\x91`_wO\x87\x16ȃa\xa8\xd4~\x86Β;뻭MZb9\x04'wօFs\xdd\x0e+\xaf\xa6X\x96\n\x1d')t\xf4e仭5-\xb2\xa0<\xe2S\x19\xffi\xab\xd5\xf9\xacl\xed\xdf}\xdd\xe9,\x90p1\x8aG\v\xb3^\xaa\x8b\aO1\x1d^\x86/y\a\x05j\xf3]\x1fB\x19k\xb3\xafyu\x13\x10\xff,\x19\xafBZXn\b|\xf2\x9a\xcf^\x06\xc1\xb4\xba\x16Z\xd4\xd7W\xcf,\xcepgLS\x84\xdb\x15c\xb0\u07b3\x90\x11&\a#i0^\xe8\xff\xb4;\xa9\x1a)̻P\x844\x97\x8bX\xe5ۭ\xe5\n=R\xa2\x88\x8f\xb8\xe7\xcd\aQb/=4u\xe5\xdaa\x03\x9a\x93\x8b\xfbZ|\xeb\xee\x06~\xdc\xc2\x15\xf3\a\x10)6\x8a\xbaoh#\xc2q\xe7\xe8\xec\xa3`B\x1bjzU\x1ae3\x9ckF\xf7 s\xc2\x1e\x18_\xd1\xeb\x92c.}C\x8a_>|lR\xf1\xe6\x02\xde6\xaex\xe7\xc85\x9a\xbank\xf1\xe2\xaa)\xdb\x06˶>6\xc7\xf8wl\x0f\x1bf<K\xc9y\x13M\x00~z\xcd]U\xa8\xe9;u\xdaH\xe3ڗ\xcb\x05\x92\x0eĻ)\xb5E\x167j\xa4\x01l\x1a\xc0\xa6\x01l\x1a\xc0~\xba\x01\xecۋ\x8d\x1e\xc4\x0f\xa8c\xc7^\xd6\x16\x1c\xea\xfc4j\x83\x97\x86\x93n\xa1\x19\x8c_\xd6w\xd2\xf8\x8d\\\xf4\x98\xe5$Az\x9c\"F\x19\xb2\xad\x13\x1b\xe2\xc3\x11V$\xf3~^!\vԑ\x9f\xadx\xdchk\xff\xa2x\xf3\xca\xfeRY\a\xa3\x8b7\xd9'aŕ\x8e\xb0/@C\xcdl\xee\xaas$\x13\xe1\xec\xd4~\x8640\x19\xbcw\xf5\xba\xb4n\xd0\xf5#\x947\xca\xc7\"\xa4'F\x9aw\xe7\x15[\x85\xa7\xf2\x036\x86\xab\xe0t\xae\xf0\xccm!\xca\xe0bK\vmO\xb2\xd0\xf6,\x0f\xc2\xde\x06\x84\xb396[嬄\xdb?\x17Y8K\x89\x14hWL\xc33\v\x0fD\x1a)4\xe4f tcyM\xac#\xc1\x14<N\x12<\x94*\x7f\xadͥl\xc5\x02M\n-x-$[\x01vQ\x17I\x1c;Zw1\xf2\x9e\xda(\r\xe1\x0f\x7f\xeap\xe8\x1f\xec\f1\xf5.*Y\xbf\xbfwA\xfb\x16[\x19g\x81<\x017\xcbp\xb5\v\x1a\xd1\x18\x96\x00z\xa9\x81\x948\xb1A\xf24\x97I\f\xaa\xc6\\\xf4\xe6R\xf2#\v\b\x1b%\xa8\x13\x11\x84\xc2 )\x13\xd2\x06V\x94:\xbf\vl\x89\x10\xfe\xf1ѳ˵B\x85\f\xa0\x91\xf0u\x82\xa1'\x81\xa1Ѱ\xcdkR\xaaa\xf9\xd99ӓj\xd6 \xe1jv\xe4\xec\x81x\xc9\xf3\xcd\xedM\\z\x11\r\n\xbb>3\n.?\xb0\x16\xc6\\1\x1cͷA(\xf3̃mζ\xe3t~X%\xa82g\x1e-^Һ\xdcM4\x7fYj\x90_y\xcd\xd5z\x8cK;x9\r#\xc7\xe51\x9fi\xa8\x9a\x92i\xc8\xd0\x1d\x1b\xf9\xe3\xfb\xde2^\xb6\xc7.\x03\x1f\xef\xe4X\xe7\xac\xe5I'\xf1\xc4\xca\xf6X\x9c\x1d\x1c\vM\x05g\xa6\x8d\xb0\xb5\xfa\x06\xa6\xd062\xbf1\xc6\xd5n\xf9\x82\x94\xc3kI'#\xce\x18H\x98l\xbc\x15\v\xb1\xf5\x981\x0e\x86\x8dyG2#\x99\xe7ǀ1\xd0Hi\xa6\xdbw\x8a\x1bV(S\x8a\xaf\xea\n\x8e\x1e<6\"\x84\\TM\tf$\xf1\xc0+\x18\xb6ւi\x98k^\x01\xee\xe3uw.\xadO\xa357\xfa\xdfI\xf1\b\xc7\x19\xb6e{\x92\xf9\x03\xb5\a\xc9jŇe\xe4\x99d\x05J\x8d\xcciN\xb6\x97\xc0\x94\xa8\xc9͏\xd9\x1d\xa2\xf9\xd8\xf9aN\xce\xf8F\xef\x83o\xbc\xd5\xd5\xd1\u05fa\x0e\x1e\xfd\x93aЗ[\x9b\xb3\n\x98:\"\x8b\x11)(ͤ>nd\xd3\xe65\xf2ݡ\xcc1\xd8\xe4\xb8*\xe6\xaf\xc3̻\xbfl;\xfb\uedcel6)\xca\x0f?\x9aŀP,fZ\xee\xc2\xec\x0e[/fKV*\xf3S\x17\x9c\x17\xb3\xa7_\x1eA\xb3_\xba\x97\xf25T\xacgY4P\x7f\xb9\xbb\xfd\xfe\x8f\xfb7?\x0f\x05\x98\xa1\xddW\x03\"\xfax\xeb\xfe\xc0\x8b\x15hf\x0e\a^L\x8ba6S\r\xbc\xbb\xce`8\x1a\xb2\xa6\x91\xe2\x85WL÷\xb6\xd6G\x8df\x80\xa7ɄA\x0e\xce\xe1\x129\xab7\x7f\x0e\x16,\xe6\xd3\x1f?\xbc4\fc\xce\x01\r\xccf\xf0Ҁ\xe4F3GK4\xe3*\x98\xdaD8!\xa7\x89\r\x7f\x13\xad?:\x85e\xc3a\xb3\xb1h8RL\x99h\xdd\xef\xceq\x91X\xcb)]\x1e1\x8d\xee\x8c\xc3\xf2\x06J\xb6ٍ(\x86\xb3\x01~\x10\xf1_\xf1\xf8\xb0\x1baam\xebx(\xb3\xe8\xd2\xf1\xd0fG\xd4<,\xd7\xfc\tn\x80\x15%\xafaP(G\x843\xb2\x06z*\x18\x98\xe7\x91\xe5?\xc4r9q\xf1\u037820\xf4\x0eh\\\xc5 W\xb1\xbae\xe5=\x94\xdbc\xae\x17\x99K\x99\xb9a\x92\x95%\x94\\U1XW\x93LO\x9b\x95y*\xa6\xf3\xf5\xaf/\x8d\xecNJ\x1d}w$/\xe2\t\xa3f\x16'b\xd0\xdb\xc7Pg\xa3\xa2!~x\bE\x12\xe5D\xe0`,mb\x929v^p\xbe\x17\xeaċ\x13A\x10ۏ\xadu\xfe\xce\x1ea\xeaTT\xca\x050֒\xb7\xea\x94\xc5K}eo\x91\xb9\xb9\xcex\x1eB04\x9e\x97pL\x11\xf2ԑ\xa8h\xb1g\xc7&:\xf6\xff\xd8ri\xe0\x84E\x8c\xb1\xefd\x7f\xd7\xf4\x17\xebo\xe3\xbfo\x9eF\xc2\x12\xa4\x84\xe2\xa65\x16jFqE[\xf2zu\xbb\xaa\xc5\xfe\xe7__ oq\x17\x81 \x82\x13\x95\xf7\xd7=\xa0\xec(\xa3R\xc4g4O\"\xf2\xc3:*':\x065?\xf93\x18\x13\xf6\xb9ֻ\x06\xbd\xf5\xc16˹\xe7pzfw\xcc\xf7N\xa9'\x84Զ\u07bf=\xfc$9~r\xfc\xe4\xf8\x9f\xc4\xf1Ʉ\x9f\x81\xaf\xd6\xd6돏 ֑q\xbc;~u5\x89\xf9+\f\x86j։%\v\xac\x00\xbc\xbe{!\xf8\xc6Ĵ\xe0m\x86\x01}\xf5\xe8\x01$6\xee\x90BU\x82\xc6\t\x1a'h\x9c\xa0q\x82\xc6\t\x1a'h\x9c\xa0q8hL!\x89W\xce\xfc#\x8aʂu\r٠\x11E\xaa\xf2NVy\x0fB2\x18\x18טN\xd6<\xa5\x99\x19\xeaM\a\xdf܍\xb8/@\xed!2\xbbv\xc3C\x8e\xf5\x96\x1e|\xe4Z\x8f̸\xe6\\\x0f\xda\xf5\xda\x1fZXw\x0f\xf3\xfe\xf2\xb0\x87\\LH\x05!$i=\xbb\xedw\xde;\xa8\x8d9\x8aթ\xf9~1\x1cI\x04\x0e^\xea(7\x17;Ң\x11\xa5Xm~\xa3\x05o\aΩ1`\xfe\x9a\xe7,\x92y\\}\x99\xf7-\bK\xb5ޑZ/\xdaѩ\xf0\xca\t\x9e\xa6:o\xaa\xf3\xa6:o\xaa\xf3\x12\xeb\xbctX\xe9\x0fR:ڏ\x83\b\xc9M\xe9\x10\x92\xe8m\x0e2\xa2ڈ\x03d$rK\xf1a*L$\xa9\x1e+J$\x11SG\xac5\xef\x11\xda\"\v\x93ES-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-1\xd5\x12S-\xf1\xa2k\x89\x88\x97Y\xabE%\xdaZ\xdfSn\x16Ŝ\xac}8\xb9u\x91y3ml\xe2gre\xf9&ڿ\b\x96\x8a5\x82\\T\x15\x1b:<\xea\x12:\x00\xf5S \xe6i\bp\xec 6oB\xda#\xabx\x94\xbeJ\x81,\x8b\xd3\xe4\xb7\xf3\xeb%_\xfd\x875\xbf\xc1\x06yZ\xbe+i'\xe0N\x94/\xddt<\x11>\xe5\xcd\xd5thL\x83Ũ\xac\xf7\xf6ٞ\xd1w\x02{\x9c:Z0\xb0uL\x1cM\x18\x94\xba\x8bu\xec\xf9\x8ei#\xfdɆ_Od+{H\x84\xbd\a\xc1\x93\xc2f\xb3\x82?q%\xe4Ih\xf7ҿ0K\xedَi\xa8\xdd]4)\xc1\xa6\x04{.\t\x96\xd4\x10\u07fb\xc1\xf3\x84\xbdqE\x18\xc2`\xd0u\x94a\xcc\x1e\x82\xa3\xc3\x03=4\xd0\xdc\xd3\xc15\xe9nIwI\x92\x95\x9b\x80\vK\xfe\xb2\xc8\"H\x86tKY\xd2{\b\xbdG\b>\xa8k\xfc\xd12\xdf~\xfd\xae-\xcb\xeer\xae`tJ\xbe\x84|\x93\x97\xd6=\xa1Xk#\x94\xbe7\x17E,\xb2\xf0n\x01/Sǣ\xfa\xa3E(\x04:$$'E\xbb\x19\xbb\x93gu\xff\xd7Z7\xff\xb2\xbf8؇j\xd6B\xe9\x05Y:D\xe1\x9a~\xfe\x1bXaU\\\xf7j\x13.\xa2rI(^\xc4F\xae\xcdz倎\xf9\t\b\xd9\xeeN\x8e\x80.\xe9\x1a\v\xa8\xf7(;*\xaa\x11\xb8Lbu!\xcb\xd8cuY\x8b\xcd\a\xf0\v\xc2v\xc8\xd2\\\b\x04\x91\x05M\xf5\x05\xb3\xb7U\xea,\x92\r뼹\x17\xf9\x8fO\x90X>\x9b\xd9_\x82\xfd\x91\x9a5\x12\xee\xb5h\x16YxcM`8\x81\xe1\x04\x86\x13\x18N`8\x81\xe1\x04\x86\x13\x18N`8\x81\xe1s\x02\xc3\xe8&%\x7f\x82\x1a\x94\xdaާ\xbd\xc8\xc2Y7\x169S=\x88\x88\x98\x89\xc8\xc8\xc1\x86i\x99\x91`\x13\xb3ْ\xf1\xb2\x95𰖠֢D\t\x87\xbak\x8f\xe6\x9c\x04\x1cO5\x14J\x98%\xab\xdb\x01\xb7\x13-\x93*\x16W\xac\xee\xe0\x11\x8e\x18݉25\x1f\x90q9\x15\x93\x93\x02\x80Kġ\xe1p\xb22(@\x84\x04B\x9c\x00\x88\v\xf8\xa0\xe1m\"\xb3\x14\xbbFc\x1c\x92MZ\xddr}.y\xc9\xdc\xc7.\x8a\xb3gS\xb5y\x0eJ]@\xa2'\x8d\xac.\"\xd5_{\x00;߈\xa2y\x05\xa2\xd5g\xee\xa5\xe8\xaea\xb0\x18\xda\"\x8c^\xacE\x85\x84\xa24w\xddo\x1c\xb8C;\x12U\x8d4E\xf6\x91\xe5\xf6\x0e\xc7%\xd1k\r\xa9K\x10\t~\xe8@\x14H#\x85\x16\xb9(#\x10\xc3Ǽ\xf9[;\xce\x02\x06>,\xac\x97\xc0\n\x9ej>\xa9\xe6\x93j>\xa9\xe6\x93j>\xa9\xe6\x93j>\xa9\xe6\x93j>\xa9\xe6\x93j>\x9f\xbc\xe6\xf3\x7f\xf6\xeeg\xa7q\x1f\b\xe0\xf8\xbd\xef\xf2\xbb\xfd\xb4\an\xa8쮐\xf8\xa7E\xfb\x00\xa1\x19u-\\;\xb2\x93\x16\xde~\xe5P\xca\x1e8xL\x1cJ\xf5}\x00\xead<\x19'\x19\xe2\xcf\x17\xac('\xfa\xce\xe7\xf5C\xfc\xec3*\xb9#\xb6fc\xf2_\xfeL\xb3\x9b]\xe1\xfa[\x94\x1bi\x1d\x94x\xbag\xa8\xfe\x93(\xab!\x98\xfey\xe9]/O}\xcd\xdcj\xac\xf5\xbb\xbb`\xb6\xc6\xcaZ\xbe\xc7Uc\x1b\xddƲ\xa5߸\xae\x9a\xaey0\xd6hg\xae\xe4,\xf7\x193\xd3\xe3]af}\xe41\xa0\r\xbe;ճ+\xb8\xe2R\x96\xecS\xba\xad\x9f\xc9]\xf0\xab\xeb\xb4\x7f\xe1٢r\x18\xd3+\xc1[g\x9f\x7fy\xdf\xff0V\xe2s\xeceS\xff\f\xc3\xe0\xce\xe3\xcf\xe0\x87\xaep\xe5\xfe\xf6\x7f\xe5\x95{\x7f\x8c7ޥ\xd8\xcc\x14\x91\xdfQ\xc2\xf1\x06$ʕq\xc3\xd3\xed\xb8w\xc1,%\xd6\xcaV\xd4{$\x14ד\xe0\xf3\xbf\xde\xff\xf0`\xe3\x1f\xce5ؠ̫\x0f\fVT\\wƵ~\x17g̬\xf5&6\xcb \xad\xb8\xde4\xf6\xbe\x93\xd5L\xf1yo蒝Ҋ\x87?\x14\x9a\x19G-H\n\xf5\x9fĴ/\xc6\xd0\xd1ۢ\xb7Eo\x8b\xde\x16\xbd-z[\xf4\xb6\xe8m\xd1ۢ\xb7Eo\x8b\xde\x16\xbd\xadO\xefmž5\xd9}\x00\xfd[\xb4\xf1\xe7o\xddJ\xea\r\xd1K\xd8\x187\xf63\xae%ƴ\xb3\xa4\xe2\x96B\x9d\xe5\xef\fWw\x13˾W\xfe\xb6&x[o\x87\x8d\\H\x12y\xb2\x93Z\xf9\xd0PVX\xdb\xf1\x98\xf4\xc0\x80:\xbe\xb3\xfe\xa3\xb4\xbe\xbe\xfd\xf7O$\x16\x95\x1e\x1b\n\n\xa2\xf6v\xff%\xd1\xc6\x06\xcaq\xe5\xd9hR͖f/\xa3\x05\xdf5ku\a\xf6\xd8s\xfb\xadsU2\x98\xa6j\xedז\xe1a\xb6yۏ\x95\xe0\xd1#\xad\x12\x87<\xfe\xc2Eb\xe7ãq\xeb\v\x93\x1ddex5\x81\xcd\x0e\x8f*0\xf9!i]\\\x8e\xdb\xed\x9f-\xa6\xad|鴢\x84m\xf6\xebBU\x1dV'\xbc.I\xbc\xa6\x1d\xa5:\xf0\x92\xe5C[Y\xd5\xc1)zkY0\x8a\xfa\xe2\xd6\xcdZ\x94&\xac\xfeH\x8di\xab\x9ao\x8a\xb0\xb4.\xe6\xeef\xaf8dq̓\x95=\x99ye\xdccF\\4\xeb\xa9ti\xfb\x83\xd0\xd8\xe5\xeb\ar\x19\x03d\xcf\x0fh&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h&h\xe6\t\xa2\x99MXK\x7f@_4O\xd0S\x88\x99\x8aۗ)\x86\x03\xe8\x04\xe8\x04\xe8\x04\xe8\x04\xe8\x04\xe8\x04\xe8\x04\xe8\x9c\x06\xe8L\xcf0\xe7\xd641\xa7\x9ee\x97Jm\x91LG\x91\xe2P\xabh\x17\\\x1fڬ2]\xa5l\xaa8\xf1\x97w˳Ŕ\x853\xcd\xe3\x8d\xf4\x89\xc0\x9d\xfe\x87\xef./\xa6\xffѼUK1e\x87\x1d\xee\xefG6\xe13\xaf*͊|\x1cIi\x9cy{\x9e\xfb\xccЁx\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x82x\x1e\t\xe2\xf9\x97\xbd\xa7Yn\x1b\xf7\xfd\xee\xa7\xc8\v\xf8\xf2\xff\xdfr\xcb&m'\xb3\xd9\xddL\xd2lό\x04ۜТJRqݧ\xff\x8d$\x7f\xa4\xb31\t\x80\xa4l\xa7\x9a\xf4V\x99\x00\xf1M\x00$\xc6!\x9e\xe3\x10\xcfq\x88\xe78\xc4\xd37\xc4s\x1c\xac9\x0e\xd6\x1c\ak\x8e\x835\xc7\xc1\x9a\xe3`\xcdq\xb0\xe68X\xf3\x83\f֬t\t\xb8\x89\xe4\x04\"\xb4\x8b>\x82\x82\xc2i\x04\x8d\xb9\xfd7$\xae\x10ȧ_\xc1,@\x94\xe7\x87ym\x00\x96]%\r\x1b-\x120\xa9\x8d\xd4m'\xd1\xe5$\xedQ\x84r\x00\xd9\xe2p\xad\x84\xb5\xc9\xc5vwK\xea\x8bp\x18F\xa2#\aj\xccP\xe8\xaaW\x89\xaf\x84RqV#\xf5\vF\x88_\x10\x84\x96b\xad\ft\xb5\xbe\f\xd2m\x9a\xaa={\xe7\x11\xac6]Z6\nL\xfa\x95\xa9\xed}4Q\x9cQ:u\xe8=:\xd4\xdc\x03\xb5wh \x8cH\x9dB\xd48tW\xb4?\x95-sz\x81\xa8\x06\x90\xdc\xffCP\x19^\xcf\x0f\x03\x80[\xd7y\x01\xd0z{\xc8\x00H6\xbc=\xbcԵ\x82%TN\xa8\xcej yM:\x7f\xd3ś.\xe0\x14\x87\xd4\xfeٵ-\x9cʱ[\xba\xdaЏ\xe1\f\xb9c̘`@\xa1\x04(\xe4\x83&\xadtM\xd4\x04\xaa\x00qz\xe0\xe8\xa2\x11\xd3\xf7\xc6`\xdf\x7f\xc1႟\b\x90\xcc\xfe\xb6\xbc\x86\x91\xf0\xb1\x05\xd3\xe6`\xaf\x8a\x02\xd7\x01N@\xfcץq\xf4\xa1,\xbf\x10\x06\xee\x8dn+\xb7\xed\xe2\xb6\x16\x98b\f%\x16\xb2\xcds\xa9\x97BVI\x11\x7fSg\xf9bD\x01\xf7\xb4\"9\xcd\x1dQ\x1c\x91\xd3\n\x8c@\x1a\x04\xb4S\xa1Z\r\x98͠@\x06\xb6$\xba\x93\x87\x9d\x93\xd7n7*P\xf9'&\x80=\x87\x88\xd5\xdaab\x18\x92\x8b&n\x9ea\x001\xbe\xd0\xe9Z+=_?\xd6m&\xe6ZW\xd6\x19!+wL\rP\xe2\x19\x14>\x97\xc9\x03\xd2\xfe-\x85+\xba\xcc?X\x8b\x0f\x03\x88\xbb\x8fC\x91\xac\xb4l\x01\x8bQ\xe4$@;\xed!\x93\x86ŉh\\)*\x16\x17bsG3OwL$\xfd\x8cdf\xe2\xa8\xd1i\xdf]\xab\xeb$\xeeq\v\x00\xd1|g\x90\x86\xfc\x93\xa5\xf8\xf1\xf8\x02+\x86[\xfb\xff\xff\xcb\xe6ֶ>\xe2ό\xa1\xc3j\x01\xd5Se\x85\x93v&ų\x82L\x90(\xea7ݲ\x03\xf5\xed\x1b*\xa1\xbe\xffώ'\x89\xe5\t\xaf\x93}\xaf\x10B\x8bЦ\x96\xea\xea\xc4\xca~R\xc2:Y\xfc\xa1t\xf1\xf2\xe8\xb4AK\x00ǭ\xce,\xa5\xccÐ\xb3\rj\xc28I\xed\x06\xe1(5O\xb1\xb9\x9d\x1d\xd4|\xfa[)\xbb\xbd\xc9Lu\xaa\x83\x9d\xee\x10\xcbe\xd4\xc5\xcf\xc6\xc0\x8d\xb4/9%\xba\x10\xc5BV\xf3\xbft\x99_\xacKi_h\xf9\x9e\b@O\x0f\xb7\xd9\xe1\fd\x0e^dUf\a2\x94B\xd3\xf5l+6\xa4\x1f<=\xdcf\xd5\xcb\xcfRAN\xbd\x1cξZ(\f \xf3\x8c\x912\xd6\xe5\x1e\a\x80D\x97\xb1=\x11\xf0?\xd9n&\x97\x9c\x15P/f6\xa7\x90-u%\x9d\xa6Mng\x1c\x9bY\xb2B\tD\xf9\x13\xbaO\xdaTnu\x93bk؛\xeaA=\x00\xe9b\x10G\xe8x\r\xc8\xcc]1Ԏ^\xae\x1f\xc8Fm\xb5u\x92iㅬJ09\xed\xcd@\xf1\xd2\xd0\xfa9*M\xa74\xbf\xedA\xad\xd0\xd5L\xce\xff\x12uN\xdd)a&\x1a\xe5\xa8\a\xb5a\xb3\x02\xe4\xe0\x80\x11M\x9cO9dI\xe4U\x1c\xc7b\xf8\xc6\v\x9e\"\tDUd~I\xa3\xa6܂a\x99\x00~\xc4J\xb5\xea,r\xeb\xae_I\xa8\xdc\x1e\x91n:\xad\xbc\x9c\xe4\xd3\xfb\xd2\xc8W0\x1f%\x0f\xd4\xdeչo\x9e\x95\xb4\x8b\xc71\xf0\xd8\a\x1e\xc3\xc5{}\x88s圑\xcf\r\xea\xe2\xc9y\x16 \xe91V\xafi\x93L\x18\x95zU\xad\x84)\xaf\xeeo\xb3\x9a\x8b1Ɗ\x8d\xb1f\x12TI\xb4K\xb10\xdb?Q\xcb\x7f\xc1X\xf2\r\xeaH\xdd\xda\xfeuۦ_>N\x02\x9d\x17G\xb5\x7f\xd3=\xde\xe4\xdf2\xac\xca\x18\x17\xa3\xe2\xe2\xfd|\x81\xcfGR\xa6\xddp<j\xae>\xc9\xee\xdb\x7f\xa5|\x95V\x9b\xa3\xc0\xdeR\xff\xccTy\x8b\xf6p\x9a\xcc\xc3\xf6\x94\x8fcd@\xed\x1d\xee5\xe1y\x02\x8e^.\xa1\x94\r\xe3\xb9w\xa2\fZ\xf9\x13\xeeڙ#\x99!\x91i<+rRw\xa0\xb3\x9bj~\xf3f\x9e\xbe\x99\xc7\t3\a\xf7\xed\xdb߹#X\x16\x938&\xe3\xe2b\xb5\x92\xe5\x87\xd9\x0e];\x15\xfc\xf8\xb7;\x16\xe7\xd4ҏ\x95\xc8є\xeb\x83\xe7\x97=\x18+pG\xa9\xc0\x9dZ\xd2fֶ\xe4\x82\xc9j\x16\x84\x13v\xa0\xe6\xa5\r\xac\xa7\xa7\xec\x15N2\xa5\xe7\xddmH+\xad\x83\xca\xe5\xee\x18\x1d\xc8F\x9eA\x0ft]\x0e\"xC\x19S\xba\xfd\xe8\t\x90M\xaa\xa5{\x80Z\xe7\x94\xe5R\x9a\xee\xe5\xb3\xf5\x00L\xac\xb5\x95\x03\x81z\x95\xd4D(\x03\x10]\\\xf64\xc8&2\xaa\xb1\x0eLޮI\xa8\xcaZ\xe3\xee\xbaF\x11\x98\x9ex\xfc`\xe6eG\xe8I\x86\xcc\x12Y\xb6ڑŔ\f?G\xb4\x06a8\xed\xb1\xa3\x81lCN\xceI\x9b\xb9͠X\x88\xfa\xaaq\x8b\x1bi\x8b\xf6I\xca캴\a\xf9\xd8\xdfA\xcf\x0fp\xa0\xb0\xaf\x9f\xd3\xe14\xb9\xf4\xc0\x83\xf6=\xb7\x97\xdcH\xdfmպ%Q@vp'\x9dhl\xe72\b\xf5a\x92ec\xa2\xe3(\x89\x8em\x1a\xf9\xbe\x93\xa6\xcbIV\x1c\xe9\xaeL~\xc7ruz\xa1\x1a\xfc\xb7o7=\xc9D\\\n\xe3\xc9Ĭf\xf6샧!\x15\xde\xe4Oyg\rԦ\x9b=\xe4\x12\xd6z\x97\xf3\xea\v\x10\xd7J\xc8eN\t+Z\x00\xbfy\xcegG\x83l\\]h\xa7\xab\x8f\x97\xcf,O\xf0fL]\xe6\xbb\x15\xd3\xc6z+m\x06(\x0e\x0e\xc4\xc1\xe1L\xffo{\x93\xaa6\xba\xfd\x16ʜ\xe2r\x16]\xbe}/W\xee\x93\x12\x87|\xcc;o)\x802w\x99ৱXG\\@\x8bR\xf1Tͷ\xf1j\x90F-bc\xfe\f$\xa5Z\xd1\xf8\vm\xccp<\xda:\xa7H\x98\xf0\x8e\x9aI\x99ƹ\f\x17\xeb\xd1\x13Мq\a&\x95\xf5:g\x9b˿\x90\x92\x16\x8f\x14\x97T\x92\xa9@\xb2\x8b+\xc91\x8a\xb5\xa6\xb1\xd7Z\x92\xa8\xea\xe8m\xb3y\xdb\x14\x97c\xd2+v\x82\v3\x89\xa9\x14}\x89&\x03>[\xce}(S\xb3\xdd\xd4q-M\xec^\xce7\x90\x8c\x00ޗ\xd4.'\xc3Z\x8d\xf1\x00;\x1e`\xc7\x03\xecx\x80\xfd\xed\x0e\xb0\xbf\x0e6\xfa\xaa_\xa0\x1a\xda\xf6\x8a\xa6\x94P\x15\xc7a\x1b\xfc\xa8%k\n\xcdA\xfb\x85\x9eI\x93\xd6r\xf1mV\x14\x05\xf9v\x8aieز\xce\xfc!\xdd\x1cQI2\xdd\xd6\x15&\x996\xf2\xbd\xd1\xcfk\x87\xd6/\x8e6\xcf\xf1Ce#\x84n\xb8b\x9f\x81\xb9\xb4n\x80{\x01\x0e*\x81\x99U\x17\tf\x80\xb7S\xb7\x15\xd2\xcc`\xe8ڵ\xe5%\xfa\a\xfd>ri\xa3y.sj\xe2@uw\xb9\x14\xf3\xfcP^`\xddb\x95\x1d\xce\a|s[k\x95\x9dlc\xa3\xedQ\x1amO\xf2!\xec\xce \x9c̳ٶ\x10\nn\xff\xb9\x9c䓔\x81\f\xed\\8X\x89\xfc\x81Hm\xb4\x83\xa2=\b\xdd \xc7\xc4F\x02\x1c\x8d\xc7Q\x8c\x87\xb5\xeaS\xd5\x0ee+/ɠȄwڈ9P\x9b\xbaX\xe4\xd8\xc0\xba\x1f\xc2\xefٵu\x90\xff\xf1\xa7>\x0e\xfd[\x9c`L\xbd\xb1J\xe8\xefw*\x88\xffEG\xe3I&M\xa0U\x19>lC#9\x86e\x04\xbd\\C\xca,l\xb04-\xa6\x88\xc1\xe5X\f\xdfbR~l\x02Q\xad\x04\xb7\x10\xc1H\f\xb2<!\xef`\xc5\xc9\xf3Ǆ-\x03\x98\x7f\xba\xf5\xec}\xad\xb69\r\xe8@\xf1\xf5\x18\x86\x1e%\f\x1d,\xb6y\v\xca֢89ez\xb5\xf5\x02\f|\x98\x1b9\xbb@\\\xc9b}{3,\xbc\x01\x05\x8aڟ9H\\\xbeG-\x8f\xb8R0\x9avFh\x92\x18\a\xac\xcf\xc6a:\xddw\t\xdaI4\x8e\x88\x8f\x9cS\x9bB\xf3\xd5́\xf9,+i\x17>,q\xe1e8\x8c\xf4\xd3cz\xe1`Y+\xe1`Bޘ\xe7?\x95\x9e?@{_\xf4\xdd\xeej\xbf\x11\v\\f\n\xf9\xe0\x1d_/'\fmtB\xaa;Y\x1d2\xaf\xe1r\xbf\x9f!\x1e\x92\xb5\xf1H\v\xd8~\x11\xef\xce29x\x02\v\xb9\x84p\x9f{\xd0B\xb5rۍ@o\x1d\xc9\x010\xc13b\x10JX\xc7gB\xaa\xc6\xc0ׅ\x01\xbb\xd0\xea\xa0\xf6`t'\xac9\xfd\xeb7\xea\x06\x94X\a\x9aD\xd2\x00\xf4\x8d\x96\x0eR\xcf\x17\xf8\x05\x7f\\\x83\x91\xba\x1cd\x93\x16T\xf7\xca\xdf!(!qn\xff\x96\xc2\x15\x8bO?jӿ\xb7\xe4\xf92(\x95X\x90\x84\xccD\x90\xda\xfb\xbf\x16\xae\xf0\x10\x83\xb5\xe8\xabPMx+(ʐa\x87u\x18\xe7\x97\xf0Y\x84鎈\xde\xcf<\x96\x97\x8a}'}w\xe2\x19\xfco'\xd1\x1f\x89F\xd2\x19\xb1\x95\xc0'\xde\xff>L\x02\x03\xb6Qｴ\xe8W O\xcb@`Ǉ\x13]\xde\x1fz\xb6g\xc0\x99u\x7fd\xa0nC\x1d\x1e\xc7\x10\xb6\x8c!\xbbh@\u0603\x86̫\xa8\x01\x12\xfa\xf8\xe9\xa5\xd4a;\xed'\x13\xce6{\xb7\xe4\a\x80\xb2\xc4A\xaa`\xad/j\xa1\xb0\xc5\xf5\xee\x97\x00\xc9\xcfN\x9ce\xf5\xd9Ԡ5\xf5H\f\x06\xbf\xa0\xed\xa4X\xcd ż\xc8z\xfeӂk\xea\x9b\xfee\x8a\xeb\xfe\x15\xdb\a\xfd\xde\xd8w\x0f\x06o\xd7xh\xd4{\xbb8(\x14!\x05\x10\xb5\xfc\xd26^\x1e\xf8\uf838\x05\t\x17bc;\xfe\xb2z\xd8\\\xb6zz\xb8;\"\"\xdb+_m\x06\xe8\x04\xd08\"\n\xaf`\x9e\x8f\x06\xdegt\xa6=j\x13\x96\x82\xbe\a\xb0ӭ;9\x83b]p\xd4\xf2\xf1\x97\xcb\x0f\xef\xe7\x0eCk|\x15\xf6\xe5\x1d\x82\xf2u\xdȁƼ6k\xb2\\\x8a\xaa<\x1e\x02%(ppuT\",@-\xaf\x17\xc2\x1c\xec\x0f\b\xc2ح\x10\x9bqi\x17\xfa\xd7\x1bU\x04\xc8\x11\x12\xb7]\xe0Z\xc0\xa3\v\xf7\xdab+_\xe1\xf2Sp\xeboB*\xffJ\xa2Z\xff\x13\xa8\xa9M\xb1\xe0\xf6\x9f\xfar\x17oP\xfblt\xa0\x11\b\xc7\x01ګ\x18\xf85q\x99?\x16k\x98\xafU\x90V\x0fŰ\xbc\xf7%\xbc\xee\xe6\u05ffZ\x18\xb1\x04\a&\x03_JPN`>\xc4$\x9c\xa9'M\xbaƲ\x18\xd8=\bZ@\xe5Ne\x9f]g\xf0\x91%\x15U\x17#\bi\xdfY\xf0'\xac3\b)*\xd5I\xa6VFy\xa35\xe8`\x1d\x1a\x9dɡ\x84%\x89Ǩ\x0f\xc3\xd8\x05D\x0f\x01\x05\x13;\xf5\x01\x8b\xcf3&\tZ\x90\xaf\x1d\xe2\xc5\x1d'\x92hqD\xf1l\xab\xbc缇\x04b\xe3\xbdN\x16\xc4\xf6\xa5\xb1N/\xe5O\xfe\nKQ\xc9\x19XgG\x89\rKlc\x02\xc6\x15\x050\x81\xd4\xf8\xb6\x1f\xc4\xc1\xbe\xc8\xfaڀ8|1:\xec\x1b\xda5n\xba\x13+\x7f\x8d\xbee\xe7/\xddT\x99\xa5oق\b\xc7\xe9(\xe6mW3\xba\x16s\x11\x9e\b\x88\\4,\xcfȅp]\x9da\xe6l\xd8\xdc<'\xa3\xdbf\xad\xb6j\x9d`=\x8c\xcb\xdf\xf1\xfdha\x81\x0f˃\xb0\xbdP\x0f\xc3\xebr\x82}3\xe5;\xdapP\x91B*$V\xf6\x93\x12\xd6\xc9\xe2\x8fv\x82\xea\xa3\xd3栤b\xd41܌\x89\xe2?j g\xb8,I9JaT\v\xa7X\x98\x17\xd5\x11T\b\xe9@\xf0\x85\xf4\xa0|\x8b\x9f\x8d\x01\xdf\xc8\x03\f\xc7\vQ,d5\x0f]\bB\xb1\xbd\x94\xf6%\xd4\xea\x8a^\xe8\xe9\xe16z\x9dD\xe2\xec\xeb|B/\x92J@\xc3r\xb5e\x83\xf7\x83\xa7\x87\xdb(\xb9\xfb,\x15\xc4\xc8]:}\xc5]HA\xf1\xc8.\x84\x81\x04+\x85y\xb4G\xfa\xf0'[d\xb8|*\xa0^\xccl\f\x930\x0fP\x04\x82@$\xc5\xfc\xde\x13\xd3ss\x04U\xdcʞO\x17\xd0H\xa1\xee\xe1`\x98\x86\x8bX\x91X!\xc4\f\xf3\xf6C\x12\x9d\t\xbc\xce\x10\xd6\aY\x95`b\xf4!\x91?I-\x7fg*4g\x13h\x05\x13$\x18\n#o^\xa7\x8d\x8a\x83\xc6\x19a\xbd\xb1\xe2\x83L\xca#%\b\x7f\xf3\x19K1\n\xddpΆ\xb8\xa1\x90\xa0bS\xf4\x88\x9b\xc8(\xfd\xc3yܐU@m\x1fS\xfd\xc0X\xbc\xb0\xaaZ\x19\xa5\xa4F\x06\x06)\x0ey\xee\xa8t\t\xf7ͳ\x92v\xf1xΆ>\x9d\xbf\xeb]ƕsF>7\xef^D\xe2\xf5j\xa6\xdes\xd8'\xf5\x926aB@\fx\x19}R\xe7\x93\xc6\xee\x95\xd3\xeb^\xf9`~\x9d3g\x84&l\xbb[\xa3\xa1\xdc\x04\v{\xf2\xfc\x0f\xe2\xda[\xea\x1cY\x94\xb7h\xa4\x93d\x1c\xf4!õ\xe0B\xb0\xac\xdd\xfaF\x1ed4F.\x97P\xca\xc6\xdb^\x88⡕?\xe1\xee\xf0\xad%\xf4J\xc1=ϊ\x98\xdd&\x8a\xe5Ts\x96Ő~\x90\xfc\xb7o\x7f\xc7zl\x14\x910\"~q\xb1Z\xc9\xf2d\xd0\tK\x9f\x82\xc0\\c\x8c\x14\x9e\xd6\xc1\xa4?Νl\xf4=fغ\f[\xeeCȬ-9\x83\x89\x12k\xe1\x84MT\xbc٬\xf5\xf4\x14\x9dQ\f\xee|^\x00n\xe8\xfc\x80\x0e\xe6\b5\xf7\xbaL¸T\xca\x1a\x96\xf7\x1ea6ץ{\x80Z\xc7\U0003a526{^b\x9d\x80h\xb5\xb62\xd1R\xaf2t\x90E,\x14&\xff\x1eg6\v\xfa\xeb\xaeqUM\xa8\xcaZKO_\x1br\xc3\xe1\x83䉉\xffn\xe3\x13\xc6I%ț\x85\xb6\xde>>\fk\x92\x10\xd4ś\xd30-c(%md\x9a\xbaX\x88\xfa\xaaq\x8b\x1bi\v\xfd\n&Zv\xf6K>\xf6\x8f\xd6\xc4/\x98ȭ\xf5\xcf\x1c9\x1d\x1c\x97\x8a[\xed{\xac\x95\xdbp\xef\xb6r`f\xa2\x88GiЃa\xad\x8d\x13\xead\x0eOc\xa0\xde\x05\xea\xdbc\xf6}ǝ\xcbI\x14̰\xe9\x92\xdf\x0fQqz\xa1\x9a\xc3\xff\xf7\x16\xc9\ts\xb3>\xc2\x067W\xcd\xecѝKJ\x815\xf1G\xfa(G5\xdd\xe0\xc0ef\xbd;\x03\xf5\t\x8ek%\xe42\x86CE\xbb\xc0\x99\x9d)v8\xb3\xa9\xb8\xd0NW\xa7w\x9e,\a\xe8̩K~WN\xeb\xcbV\xda$H\xae%\xa2X:\xd3p6\x9dQ\xb5\xd1\xed\xffA\x19C\xfe\xa3T\xa1\x03\xef\xe1 #!\xcc\xf6\x90=d\x9cE\x91X2>\xa5bAh\x00#\x890\xb7xL\x17\x1b\x9e\x18Qc\x8c\x04$\bi5\xbd\xa1\f\x19.\x90\xad\x03\xe7@\x80\v\x151D\xfc\x1f{G\xd7\xdb6\x8e|ׯ \xfa.;鵇\x9e\x80>\xb4N\xae(pm\x834\xbd{8\xec\x03-\x8d\x1d\xae)QKJn\xb2\x8b\xfd\xef\vRԧ%\x91\xfa\xb0\xeb.\x8c\xbc\xc4\x1493\x1c\xce\f\x87\xa3\x11\xa7\xf3\x9fc~\x8a?\x80'\x169<c\xb5\xf1\x9cl\x82}\x02\xce4<c\x92rF\x8b\xd0\xe8D\x9d\xc9\x18\x87j\xff\xd04\x9eQ\xa2|\xb1΅u\x1e\x93\f4]\xf0G$\bM\x9c\xe5ड\x19\xf0\xe5\x9c=kUˉ<\xae\xa6\r\xa5\xed|6\xf6\x01\xc0m\xcb\xe1\xfd\xbc\x9b\xe3\xc5a\xbe8\xcc\x17\x87\xd9\xd2a\x16\xb5{Q\x1f\xd8\x0e\xa2\xb9m\x03N\x03\x02\x91\x7f\x1c6\xc2SL\xb8\xba\x95\xc4PʢS\x1f\x8ft\xf9\x9c\xbd\x0e\x0e\x9a\xb1\xbd\xdeYj\x91\xb5\xacXv4\xab\x97i\nn\x1eGrF\x12\xf2[\xca\xd6\xcf\xdd\xf7\xf4\xd8H\xefV\u07b5\xed\x19\xa7y\xb2\xe0%\x87-\x11\xc9\fy)\tD8J&\x83\x99\xe1\xdb\xe4<\";\x11\x8cY\x9ar\xdeuv\xc8\xe8\x18+m|\x1dL\x91\xb4\x99\xe2佷\xaaYC\xd9\xc1\xb3\xe9b`+8gxǁ\xa1X\xf8\x89\xf5\xf9r1\x81\xe9b\x02%\xd0G\xbb\xb6@]\r\xfb\xf1\x8b\xe7\x8c\xe7\xfcL\x8a\xab˫O\x86\x13s\x96\x80/\x1d\xa1\x1b\x16b\x12M\x06x\x11v%\xecB\xd0\xdb\b\xafi\xb7\xac\x0e`DV\xbf\xd4\xf4RΊ\xfc\xa2\x16\xea\fv-+\xc0?\x19\x8c]\xa1\xdfY\xac\x83֚\xce\xe7\x85\xc8u\xf7PsvFJF\x7f\x14\xe5l_\xd0\x1a\xf7\\\x8bM\xd9VQ-\x031V\x925$\xe8b˱!|\x1br\xa4\xb3\x9e\x90I\xcam\x03'\x16\a=+Kg\xe7H\xd9\xc4-\x86l\v3\x98\v\xb3\xb6\x9a\x8a؟p\xbf\xbfl\xabj[\x9dm\xaf\x18T\xf4}\x0ea\xb2*\xe2~B\x81\x1aP\x84}8\xbc\x19\x17\xc8\xf4>w\x16\xbf\xc0X\x14ݰ\xbc'\xbd\xbe6\xc1<y !|\xd9lD\x9b3\xd1Ñfm\xf1\xacJ\xb2\xe7\f\xdb\f\xfb6?\xeb\xea\xe5\xe3Qt\xd5P\xectBLZ\x85\x13Y\xda<\x11\xf7 O@=\x81\x8c>\xaat\\\x99\xf3\ue5f0FQ\xed\xb3\xa6\xc6\xc1\xbdu\x90\f\xa3Gɯ\x9b\xa1\x9cK\xb2;\x06\x89\x04'ic\xe1\xba\x17\x14\vA\xb6Q\b\xad_\x94\xf50\xc1gaLA\xba(R\xb3\xba\xa55\xc0\t\xb8\t\ta\x18p]\x14}N\xa1\x95WM\xdfq\xb6\x86v\x82m\xc96\x12_b{\xe08\x12\xa4\x9bG3\xa3\fA\x88)%\x1f\xb2B\xb9\xa3\x87\xb7\xc9݀\xe1}\x1f\xbeMR\xc6ںw\xf6\xa8\xafUk\xb7l\x82\xad\x8f$\x81s\xa95B\x94m\x1f0i\xf1\xfc{\xf8\x10\xcb\xea\xaf_T\xb9\xee\x99\xf5fFc/\xbfr7\xa9\x81|\xa34N\f4\x13\xfa\x9c=#\x04\xce(]c\x7fwsdJ\xb3ϑz\xce\x14\xa6U\xb1\xcf\x184\xd2b\x9d\tx\xba\xfb\xc6M\xa7$k s\x9cT\xcaܭ\xb9\xb8\x9d\x92\xa9,\xea\xb5,&\x8b(հ\xf5A\xa1?\xadO\v\x99u\x06\xd2\xd3m\xe9\xe2G,Z\x96\xa7\x87\x01\xf2XO\"\x10b\xf5\b\xfe\xee\x87ع~G\xdc\xd6\x16\x16>\x8e\xb4W\x1d$\xf5\xcc\xc6\xc8(3\xf35\x06\xf5\x8d2\xbd\x01\x8a\x9f\xf5A\xe4\xb8\x13\x97\xfb\xb1Z\xbc\x938E1p\u0082\x93L\xac\xbb\xac\xbf\x9d\x00ڗ\xf8\xb7\x92\x8d\x1f\x1bC\xcek\xdd\xcf\n\xb4\xeb ;\x823\x83q\x9b5\xc9\xc6\xf8\x0e\x8b7\xe7L\xec\xedf\xd8\n\x86P\xaf\xa4\xef?x\r\xf4l\xaf\xc72v\xb9xV\x17\xcf\xea\x87yVGp\x91\x8a\xe8\xa5\xe7tm[ݛd\xcf|\xbbli\xe7\x90\xf6\x99\xbb\xd5\bR\xe3\x89\xf2\xee\x1am\x19Z\xc7Ș\x83F\x99@\v\x81\x87\x12\xae#h:~\xae[\xca\xe8\x03\xf6}\x88\x13P\xb7e\xe9\xf9)\xcdA/^\xa8\x1f1M9\xa6\xfag%҄\xfe\xff\x8b#\x83ŌC\xa0\xc51kt]ש\x18\x04\xc4\xd7\xd8_\xe04yd\x9c\xfc\xaeRb\x17\xbb7bA\xd8r\x7f\xedd\xa8V\xd9UO\xf7\x8c\x82\x13B\x82\xf3\x83\xaf/\x8b#\xea\x18\x83Hp\x18{(J)u\xa4WTZ]\x1cǋ]\xba\x06\x1eA\x02\n\xb0T8\x0fq\b\xc4\xee\x99\xc52\x1b\xa7\xda\xe2\x868\xc2[\xe0.\x97\xf8xJ庺҈}\x90镊\tn6\xdf\\\xdft[\xf6ao\x88\x15\xc8=\xf0u\xde.\xe9\x94lvQ\xa0j1\xaa\x7f\xb7 \xd7\xc7E\x94\x88\xec\x9f4\x96\xa2g\x87\n\xf6ZB\xdaѨ\xa3\x87\x1d\xa4\xc2\xfa4\xa0)\xb2\xec \xb0\xa09x\v\x96cc\x16\x8c\xc6+\xc7.)ێD\xadsȭ\xd0\xe3\x98\xc0S\x02\x91\x14Y\xa1\xe5\xf3\x10\xa2\xaf\xaa\xbc\xe6\x8d\x01lԍE,\x12\x16\x14Nİ,\xc2i\x15D\x99\x14\xf4\x89ֺ\xe8Q\"?\xc4\xfa+[w\x8bځ\x18\x7fo\x97\xbdB\xdd\x16\x01\xec\x0fq\xc0\x93<[\xe4v\xaf\x82\xaaKMƣJ8\xc1t\x92\x8aVpO\xb2f\xefI\x14Ƚ\xb3j\xd4f3]k\r\\\x9a1\xed\xc0\xe5|\xea\xa1\xd3A\xe8\x80\xcc^\x03)R\xb5\xd5(\x1b\x99\x8d\xfcZ\xfb8\xa3\x18\xacS\x87\xf4oer\n\x88:\xad\xa9\xb97\x14<\xd3 \xe7c\xd4wX?2\xb6s\xb5\x11\xe8#JĠn\x8d\x96\x97\x8chi\x91\xffz\xe8ի\x7f8\xa5\x8f,/C\xf2п\xb2\xc6\xfa\xe1Ն:\xb5y&\x9cQ7\xa68\x02/\xffI\x81\xe7\xfc>`\x0f\x8ecQ\xca\xd5\rĔ=K\r\x9a\xc4&\x1bB\x1a\xcc\xec\xec\xd1\xcbO\x0e1%>\x16\x1e\xba>\xe0X\xcb\xd1Ɏv;\xeae\b\x9e\x16u\x95\xab\xdcB\xa8α!\x98\xedp#\x943 \x1f\xa1\xbe\xd6-\xf0\xb9\xc8ga\x88\xab\x87\x13\x17-\xab\xe3\xcb\xc4\xf9\n\x06O\xceG\x94\x1e_FbsX!\xc3%.\x8d\xbf&\xbe\xf9_\x06\xa4\xaa+\x15X*\x1e\x920\x9fQ\x0f=\xac\xee\x8a\xf6\x9a\xb5\xcd\xff\xa8\xbcݽւ\x90\x1f\xa7\x1e\xba\xbe\xba\nk\xad!\x84\xf2&S\xf4\xf2\xf5\xd5'Ry\"\x1df\x10S`\b\xf0SN\x92\xe7\x15\x8b\x12x\xaae.`J\xd9\xf7;N\xf6\x84\xc2\x16ne\x9e\xb52\xde\x1e\xda`Z\xf3\xba\xf3D\xa8{\xc6T\xb1?\x9d\x84Z:Һ[\x1a\xbd\x13\xda\xd6\xfe\xf3\xf5\xebZ\x1cL=\xfb\xcc\"\t\xa2}\xe07\x99\xaa\xde\x18\xd7^\x85\xbbR<\xd9C\xcb$\x8c\x97\xbb7\xa2f݀/\xe5\u0091h\xeb\xfa\xc0k\xa7\x8alyek\xcb\x04k\x94%\xc0C\x12)\x96|\xe0X]y\\\x89\x03\xa2\xeb+\xddo_/k춡8LB\xad&\x95\xa2W/s`e\xe7\xcf-r\xa8fs`\x12ec\xaelRS\xf7טƏ\xf8\xa5\xb6\x91+\x19^\xd9\x10_\xee\xf5S\x8cd\xb5ŭ\xb2\xd7\xc6\xf0\x05\x91(NS\xb9\xb3R[2\xe2â6t!\xf6\xfe\xa0\xce\v?;2-(\xf3\xd5\r}D\x88\x14x\x11\xc7ɸ\xf1Q5:\xa5(\xe4p\x80n\x04\xd9F\x10\xb8$\xef2\xf3Bh\xd43\xae\xc1!\xc9ƅ\x10@7_\xd5<=\xf4ǟ\aS\xc0AHT\xdcX\x7f\x8a\xd5p\xe7\u0590\xe0|\xef\xfd\x94&8!\xd1\xf6\x7f\x19gV\xea \x98fo\x8fk\x82\x86\xa3\x88%\u0557\xcaM>\x91HzS\xae\x8f\xdd\rga\x83\xf2e\xbb\xc4\x1d\xeb\x1c\xac'U\x88\x9b_\x9b\x96nU\x8e\x9fO\tDI6m=/\xfc>\x8d\x02\n\x1eZm߾-b\x0e\xa4\x8cr\xd5p5$\xbaҥu\x05\xf5\xfe\x93Y=E'\xb8\xc5<\xdc\x00\xf6\xae^\x1f\xb7<W8\bm\xb2\x84\xb3,I\xd0C\xffƄ\x16\x93\x0e˞\x8b\x83\x03D\x16\x04@\xa8q\xd4h;lTÝE\xa7\\Z\xcaX~\xf5\xe9\xea\xfe\xf6\xddí\xfe\xf1\xed\xee&\xff\xd1\xd8I뇤sf\xba:a\x99\xf8\xad:\x9d-\xab\xf5!q\x82M\xf8/\xa6$\xf8\xfbY\x85}1\xads\xb7\v\x9a\xd2ɖa\x7f\xb1\fC,\x83\x81\xed6\xb6a\xffS؆\xbf\x06\x00\x8d3j\xd6JN\f\x00")}